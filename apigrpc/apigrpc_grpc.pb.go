@@ -38,10 +38,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
+	Nakama_AcceptGroupInvite_FullMethodName                 = "/nakama.api.Nakama/AcceptGroupInvite"
 	Nakama_AddFriends_FullMethodName                        = "/nakama.api.Nakama/AddFriends"
+	Nakama_AddPushDevice_FullMethodName                     = "/nakama.api.Nakama/AddPushDevice"
 	Nakama_AddGroupUsers_FullMethodName                     = "/nakama.api.Nakama/AddGroupUsers"
 	Nakama_SessionRefresh_FullMethodName                    = "/nakama.api.Nakama/SessionRefresh"
 	Nakama_SessionLogout_FullMethodName                     = "/nakama.api.Nakama/SessionLogout"
+	Nakama_ListSessions_FullMethodName                      = "/nakama.api.Nakama/ListSessions"
+	Nakama_RevokeSession_FullMethodName                     = "/nakama.api.Nakama/RevokeSession"
 	Nakama_AuthenticateApple_FullMethodName                 = "/nakama.api.Nakama/AuthenticateApple"
 	Nakama_AuthenticateCustom_FullMethodName                = "/nakama.api.Nakama/AuthenticateCustom"
 	Nakama_AuthenticateDevice_FullMethodName                = "/nakama.api.Nakama/AuthenticateDevice"
@@ -50,25 +54,34 @@ const (
 	Nakama_AuthenticateFacebookInstantGame_FullMethodName   = "/nakama.api.Nakama/AuthenticateFacebookInstantGame"
 	Nakama_AuthenticateGameCenter_FullMethodName            = "/nakama.api.Nakama/AuthenticateGameCenter"
 	Nakama_AuthenticateGoogle_FullMethodName                = "/nakama.api.Nakama/AuthenticateGoogle"
+	Nakama_AuthenticateOIDC_FullMethodName                  = "/nakama.api.Nakama/AuthenticateOIDC"
+	Nakama_AuthenticateMFA_FullMethodName                   = "/nakama.api.Nakama/AuthenticateMFA"
 	Nakama_AuthenticateSteam_FullMethodName                 = "/nakama.api.Nakama/AuthenticateSteam"
 	Nakama_BanGroupUsers_FullMethodName                     = "/nakama.api.Nakama/BanGroupUsers"
 	Nakama_BlockFriends_FullMethodName                      = "/nakama.api.Nakama/BlockFriends"
+	Nakama_ConfirmMFA_FullMethodName                        = "/nakama.api.Nakama/ConfirmMFA"
 	Nakama_CreateGroup_FullMethodName                       = "/nakama.api.Nakama/CreateGroup"
 	Nakama_DeleteAccount_FullMethodName                     = "/nakama.api.Nakama/DeleteAccount"
+	Nakama_DeclineGroupInvite_FullMethodName                = "/nakama.api.Nakama/DeclineGroupInvite"
 	Nakama_DeleteFriends_FullMethodName                     = "/nakama.api.Nakama/DeleteFriends"
 	Nakama_DeleteGroup_FullMethodName                       = "/nakama.api.Nakama/DeleteGroup"
 	Nakama_DeleteLeaderboardRecord_FullMethodName           = "/nakama.api.Nakama/DeleteLeaderboardRecord"
 	Nakama_DeleteNotifications_FullMethodName               = "/nakama.api.Nakama/DeleteNotifications"
+	Nakama_DeletePushDevice_FullMethodName                  = "/nakama.api.Nakama/DeletePushDevice"
 	Nakama_DeleteTournamentRecord_FullMethodName            = "/nakama.api.Nakama/DeleteTournamentRecord"
 	Nakama_DeleteStorageObjects_FullMethodName              = "/nakama.api.Nakama/DeleteStorageObjects"
+	Nakama_EnrollMFA_FullMethodName                         = "/nakama.api.Nakama/EnrollMFA"
 	Nakama_Event_FullMethodName                             = "/nakama.api.Nakama/Event"
 	Nakama_GetAccount_FullMethodName                        = "/nakama.api.Nakama/GetAccount"
 	Nakama_GetUsers_FullMethodName                          = "/nakama.api.Nakama/GetUsers"
 	Nakama_GetSubscription_FullMethodName                   = "/nakama.api.Nakama/GetSubscription"
 	Nakama_GetMatchmakerStats_FullMethodName                = "/nakama.api.Nakama/GetMatchmakerStats"
+	Nakama_GetPushPreferences_FullMethodName                = "/nakama.api.Nakama/GetPushPreferences"
 	Nakama_Healthcheck_FullMethodName                       = "/nakama.api.Nakama/Healthcheck"
 	Nakama_ImportFacebookFriends_FullMethodName             = "/nakama.api.Nakama/ImportFacebookFriends"
 	Nakama_ImportSteamFriends_FullMethodName                = "/nakama.api.Nakama/ImportSteamFriends"
+	Nakama_InviteFriends_FullMethodName                     = "/nakama.api.Nakama/InviteFriends"
+	Nakama_InviteGroupUsers_FullMethodName                  = "/nakama.api.Nakama/InviteGroupUsers"
 	Nakama_JoinGroup_FullMethodName                         = "/nakama.api.Nakama/JoinGroup"
 	Nakama_JoinTournament_FullMethodName                    = "/nakama.api.Nakama/JoinTournament"
 	Nakama_KickGroupUsers_FullMethodName                    = "/nakama.api.Nakama/KickGroupUsers"
@@ -81,16 +94,25 @@ const (
 	Nakama_LinkFacebookInstantGame_FullMethodName           = "/nakama.api.Nakama/LinkFacebookInstantGame"
 	Nakama_LinkGameCenter_FullMethodName                    = "/nakama.api.Nakama/LinkGameCenter"
 	Nakama_LinkGoogle_FullMethodName                        = "/nakama.api.Nakama/LinkGoogle"
+	Nakama_LinkOIDC_FullMethodName                          = "/nakama.api.Nakama/LinkOIDC"
 	Nakama_LinkSteam_FullMethodName                         = "/nakama.api.Nakama/LinkSteam"
 	Nakama_ListChannelMessages_FullMethodName               = "/nakama.api.Nakama/ListChannelMessages"
+	Nakama_ListChannelMessageReactions_FullMethodName       = "/nakama.api.Nakama/ListChannelMessageReactions"
+	Nakama_ListChannelMessageReplies_FullMethodName         = "/nakama.api.Nakama/ListChannelMessageReplies"
 	Nakama_ListFriends_FullMethodName                       = "/nakama.api.Nakama/ListFriends"
+	Nakama_ListFriendRecommendations_FullMethodName         = "/nakama.api.Nakama/ListFriendRecommendations"
 	Nakama_ListFriendsOfFriends_FullMethodName              = "/nakama.api.Nakama/ListFriendsOfFriends"
+	Nakama_ListGroupEvents_FullMethodName                   = "/nakama.api.Nakama/ListGroupEvents"
+	Nakama_ListGroupInvites_FullMethodName                  = "/nakama.api.Nakama/ListGroupInvites"
 	Nakama_ListGroups_FullMethodName                        = "/nakama.api.Nakama/ListGroups"
 	Nakama_ListGroupUsers_FullMethodName                    = "/nakama.api.Nakama/ListGroupUsers"
+	Nakama_ListGroupUserInvites_FullMethodName              = "/nakama.api.Nakama/ListGroupUserInvites"
 	Nakama_ListLeaderboardRecords_FullMethodName            = "/nakama.api.Nakama/ListLeaderboardRecords"
 	Nakama_ListLeaderboardRecordsAroundOwner_FullMethodName = "/nakama.api.Nakama/ListLeaderboardRecordsAroundOwner"
 	Nakama_ListMatches_FullMethodName                       = "/nakama.api.Nakama/ListMatches"
 	Nakama_ListNotifications_FullMethodName                 = "/nakama.api.Nakama/ListNotifications"
+	Nakama_ListNotificationInbox_FullMethodName             = "/nakama.api.Nakama/ListNotificationInbox"
+	Nakama_ListPushDevices_FullMethodName                   = "/nakama.api.Nakama/ListPushDevices"
 	Nakama_ListStorageObjects_FullMethodName                = "/nakama.api.Nakama/ListStorageObjects"
 	Nakama_ListSubscriptions_FullMethodName                 = "/nakama.api.Nakama/ListSubscriptions"
 	Nakama_ListTournaments_FullMethodName                   = "/nakama.api.Nakama/ListTournaments"
@@ -99,8 +121,17 @@ const (
 	Nakama_ListUserGroups_FullMethodName                    = "/nakama.api.Nakama/ListUserGroups"
 	Nakama_PromoteGroupUsers_FullMethodName                 = "/nakama.api.Nakama/PromoteGroupUsers"
 	Nakama_DemoteGroupUsers_FullMethodName                  = "/nakama.api.Nakama/DemoteGroupUsers"
+	Nakama_ReactChannelMessage_FullMethodName               = "/nakama.api.Nakama/ReactChannelMessage"
+	Nakama_ReadNotifications_FullMethodName                 = "/nakama.api.Nakama/ReadNotifications"
 	Nakama_ReadStorageObjects_FullMethodName                = "/nakama.api.Nakama/ReadStorageObjects"
+	Nakama_RequestEmailVerification_FullMethodName          = "/nakama.api.Nakama/RequestEmailVerification"
+	Nakama_RequestPasswordReset_FullMethodName              = "/nakama.api.Nakama/RequestPasswordReset"
+	Nakama_ResetPassword_FullMethodName                     = "/nakama.api.Nakama/ResetPassword"
+	Nakama_RevokeGroupInvites_FullMethodName                = "/nakama.api.Nakama/RevokeGroupInvites"
 	Nakama_RpcFunc_FullMethodName                           = "/nakama.api.Nakama/RpcFunc"
+	Nakama_SearchChannelMessages_FullMethodName             = "/nakama.api.Nakama/SearchChannelMessages"
+	Nakama_SearchGroups_FullMethodName                      = "/nakama.api.Nakama/SearchGroups"
+	Nakama_SendChannelMessageReply_FullMethodName           = "/nakama.api.Nakama/SendChannelMessageReply"
 	Nakama_UnlinkApple_FullMethodName                       = "/nakama.api.Nakama/UnlinkApple"
 	Nakama_UnlinkCustom_FullMethodName                      = "/nakama.api.Nakama/UnlinkCustom"
 	Nakama_UnlinkDevice_FullMethodName                      = "/nakama.api.Nakama/UnlinkDevice"
@@ -109,15 +140,18 @@ const (
 	Nakama_UnlinkFacebookInstantGame_FullMethodName         = "/nakama.api.Nakama/UnlinkFacebookInstantGame"
 	Nakama_UnlinkGameCenter_FullMethodName                  = "/nakama.api.Nakama/UnlinkGameCenter"
 	Nakama_UnlinkGoogle_FullMethodName                      = "/nakama.api.Nakama/UnlinkGoogle"
+	Nakama_UnlinkOIDC_FullMethodName                        = "/nakama.api.Nakama/UnlinkOIDC"
 	Nakama_UnlinkSteam_FullMethodName                       = "/nakama.api.Nakama/UnlinkSteam"
 	Nakama_UpdateAccount_FullMethodName                     = "/nakama.api.Nakama/UpdateAccount"
 	Nakama_UpdateGroup_FullMethodName                       = "/nakama.api.Nakama/UpdateGroup"
+	Nakama_UpdatePushPreferences_FullMethodName             = "/nakama.api.Nakama/UpdatePushPreferences"
 	Nakama_ValidatePurchaseApple_FullMethodName             = "/nakama.api.Nakama/ValidatePurchaseApple"
 	Nakama_ValidateSubscriptionApple_FullMethodName         = "/nakama.api.Nakama/ValidateSubscriptionApple"
 	Nakama_ValidatePurchaseGoogle_FullMethodName            = "/nakama.api.Nakama/ValidatePurchaseGoogle"
 	Nakama_ValidateSubscriptionGoogle_FullMethodName        = "/nakama.api.Nakama/ValidateSubscriptionGoogle"
 	Nakama_ValidatePurchaseHuawei_FullMethodName            = "/nakama.api.Nakama/ValidatePurchaseHuawei"
 	Nakama_ValidatePurchaseFacebookInstant_FullMethodName   = "/nakama.api.Nakama/ValidatePurchaseFacebookInstant"
+	Nakama_VerifyEmail_FullMethodName                       = "/nakama.api.Nakama/VerifyEmail"
 	Nakama_WriteLeaderboardRecord_FullMethodName            = "/nakama.api.Nakama/WriteLeaderboardRecord"
 	Nakama_WriteStorageObjects_FullMethodName               = "/nakama.api.Nakama/WriteStorageObjects"
 	Nakama_WriteTournamentRecord_FullMethodName             = "/nakama.api.Nakama/WriteTournamentRecord"
@@ -127,14 +161,22 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NakamaClient interface {
+	// Accept an invite to join a group, adding the current user as a member.
+	AcceptGroupInvite(ctx context.Context, in *GroupInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Add friends by ID or username to a user's account.
 	AddFriends(ctx context.Context, in *api.AddFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Register a device token to receive push notifications for the current user.
+	AddPushDevice(ctx context.Context, in *AddPushDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Add users to a group.
 	AddGroupUsers(ctx context.Context, in *api.AddGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Refresh a user's session using a refresh token retrieved from a previous authentication request.
 	SessionRefresh(ctx context.Context, in *api.SessionRefreshRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
 	SessionLogout(ctx context.Context, in *api.SessionLogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the current user's active sessions, one for each client they are logged in from.
+	ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActiveSessionList, error)
+	// Log out one of the current user's active sessions, invalidating both its session and refresh tokens.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Authenticate a user with an Apple ID against the server.
 	AuthenticateApple(ctx context.Context, in *api.AuthenticateAppleRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with a custom id against the server.
@@ -151,16 +193,25 @@ type NakamaClient interface {
 	AuthenticateGameCenter(ctx context.Context, in *api.AuthenticateGameCenterRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with Google against the server.
 	AuthenticateGoogle(ctx context.Context, in *api.AuthenticateGoogleRequest, opts ...grpc.CallOption) (*api.Session, error)
+	// Authenticate a user with an ID token from a configured OpenID Connect identity provider against the server.
+	AuthenticateOIDC(ctx context.Context, in *AuthenticateOIDCRequest, opts ...grpc.CallOption) (*api.Session, error)
+	// Complete authentication of a user with MFA enabled, with the challenge token returned by their first
+	// authentication step and a code from their authenticator app or one of their recovery codes.
+	AuthenticateMFA(ctx context.Context, in *AuthenticateMFARequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with Steam against the server.
 	AuthenticateSteam(ctx context.Context, in *api.AuthenticateSteamRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Ban a set of users from a group.
 	BanGroupUsers(ctx context.Context, in *api.BanGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Block one or more users by ID or username.
 	BlockFriends(ctx context.Context, in *api.BlockFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Complete MFA enrollment for the current user with a code from their authenticator app.
+	ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error)
 	// Create a new group with the current user as the owner.
 	CreateGroup(ctx context.Context, in *api.CreateGroupRequest, opts ...grpc.CallOption) (*api.Group, error)
 	// Delete the current user's account.
 	DeleteAccount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Decline an invite to join a group.
+	DeclineGroupInvite(ctx context.Context, in *GroupInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete one or more users by ID or username.
 	DeleteFriends(ctx context.Context, in *api.DeleteFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete a group by ID.
@@ -169,10 +220,14 @@ type NakamaClient interface {
 	DeleteLeaderboardRecord(ctx context.Context, in *api.DeleteLeaderboardRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete one or more notifications for the current user.
 	DeleteNotifications(ctx context.Context, in *api.DeleteNotificationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Remove a push notification device token from the current user.
+	DeletePushDevice(ctx context.Context, in *DeletePushDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete a tournament record.
 	DeleteTournamentRecord(ctx context.Context, in *api.DeleteTournamentRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete one or more objects by ID or username.
 	DeleteStorageObjects(ctx context.Context, in *api.DeleteStorageObjectsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Start MFA enrollment for the current user, returning a secret to add to their authenticator app.
+	EnrollMFA(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MFAEnrollment, error)
 	// Submit an event for processing in the server's registered runtime custom events handler.
 	Event(ctx context.Context, in *api.Event, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Fetch the current user's account.
@@ -183,12 +238,18 @@ type NakamaClient interface {
 	GetSubscription(ctx context.Context, in *api.GetSubscriptionRequest, opts ...grpc.CallOption) (*api.ValidatedSubscription, error)
 	// Get matchmaker stats.
 	GetMatchmakerStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*api.MatchmakerStats, error)
+	// Fetch the current user's push notification preferences.
+	GetPushPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PushPreferences, error)
 	// A healthcheck which load balancers can use to check the service.
 	Healthcheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Import Facebook friends and add them to a user's account.
 	ImportFacebookFriends(ctx context.Context, in *api.ImportFacebookFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Import Steam friends and add them to a user's account.
 	ImportSteamFriends(ctx context.Context, in *api.ImportSteamFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Add friends by ID or username, attaching a message to any new friend invites sent.
+	InviteFriends(ctx context.Context, in *InviteFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Invite users to join a group. Invited users become members once they accept the invite.
+	InviteGroupUsers(ctx context.Context, in *InviteGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Immediately join an open group, or request to join a closed one.
 	JoinGroup(ctx context.Context, in *api.JoinGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Attempt to join an open and running tournament.
@@ -213,18 +274,32 @@ type NakamaClient interface {
 	LinkGameCenter(ctx context.Context, in *api.AccountGameCenter, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Add Google to the social profiles on the current user's account.
 	LinkGoogle(ctx context.Context, in *api.AccountGoogle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Add an OpenID Connect identity to the social profiles on the current user's account.
+	LinkOIDC(ctx context.Context, in *AccountOIDC, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Add Steam to the social profiles on the current user's account.
 	LinkSteam(ctx context.Context, in *api.LinkSteamRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// List a channel's message history.
 	ListChannelMessages(ctx context.Context, in *api.ListChannelMessagesRequest, opts ...grpc.CallOption) (*api.ChannelMessageList, error)
+	// List reaction counts for messages in a channel.
+	ListChannelMessageReactions(ctx context.Context, in *ListChannelMessageReactionsRequest, opts ...grpc.CallOption) (*ChannelMessageReactionsList, error)
+	// List replies to a message in a channel.
+	ListChannelMessageReplies(ctx context.Context, in *ListChannelMessageRepliesRequest, opts ...grpc.CallOption) (*api.ChannelMessageList, error)
 	// List all friends for the current user.
 	ListFriends(ctx context.Context, in *api.ListFriendsRequest, opts ...grpc.CallOption) (*api.FriendList, error)
+	// List users recommended as friends for the current user, best matches first.
+	ListFriendRecommendations(ctx context.Context, in *ListFriendRecommendationsRequest, opts ...grpc.CallOption) (*FriendRecommendationList, error)
 	// List friends of friends for the current user.
 	ListFriendsOfFriends(ctx context.Context, in *api.ListFriendsOfFriendsRequest, opts ...grpc.CallOption) (*api.FriendsOfFriendsList, error)
+	// List the activity feed of a group, newest first.
+	ListGroupEvents(ctx context.Context, in *ListGroupEventsRequest, opts ...grpc.CallOption) (*GroupEventList, error)
+	// List pending invites to join groups received by the current user.
+	ListGroupInvites(ctx context.Context, in *ListGroupInvitesRequest, opts ...grpc.CallOption) (*GroupInviteList, error)
 	// List groups based on given filters.
 	ListGroups(ctx context.Context, in *api.ListGroupsRequest, opts ...grpc.CallOption) (*api.GroupList, error)
 	// List all users that are part of a group.
 	ListGroupUsers(ctx context.Context, in *api.ListGroupUsersRequest, opts ...grpc.CallOption) (*api.GroupUserList, error)
+	// List pending invites sent by a group.
+	ListGroupUserInvites(ctx context.Context, in *ListGroupUserInvitesRequest, opts ...grpc.CallOption) (*GroupInviteList, error)
 	// List leaderboard records.
 	ListLeaderboardRecords(ctx context.Context, in *api.ListLeaderboardRecordsRequest, opts ...grpc.CallOption) (*api.LeaderboardRecordList, error)
 	// List leaderboard records that belong to a user.
@@ -233,6 +308,10 @@ type NakamaClient interface {
 	ListMatches(ctx context.Context, in *api.ListMatchesRequest, opts ...grpc.CallOption) (*api.MatchList, error)
 	// Fetch list of notifications.
 	ListNotifications(ctx context.Context, in *api.ListNotificationsRequest, opts ...grpc.CallOption) (*api.NotificationList, error)
+	// Fetch notifications for the current user with their read state, optionally filtered.
+	ListNotificationInbox(ctx context.Context, in *ListNotificationInboxRequest, opts ...grpc.CallOption) (*NotificationInbox, error)
+	// List device tokens registered to receive push notifications for the current user.
+	ListPushDevices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PushDeviceList, error)
 	// List publicly readable storage objects in a given collection.
 	ListStorageObjects(ctx context.Context, in *api.ListStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjectList, error)
 	// List user's subscriptions.
@@ -249,10 +328,28 @@ type NakamaClient interface {
 	PromoteGroupUsers(ctx context.Context, in *api.PromoteGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Demote a set of users in a group to the next role down.
 	DemoteGroupUsers(ctx context.Context, in *api.DemoteGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Add or remove a reaction from the current user on a message in a channel.
+	ReactChannelMessage(ctx context.Context, in *ReactChannelMessageRequest, opts ...grpc.CallOption) (*ChannelMessageReactions, error)
+	// Mark one or more notifications as read for the current user.
+	ReadNotifications(ctx context.Context, in *ReadNotificationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get storage objects.
 	ReadStorageObjects(ctx context.Context, in *api.ReadStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjects, error)
+	// Send a code to the current user's email address that verifies they own it.
+	RequestEmailVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Send a password reset code to the email address of an account, if one exists.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Set a new password on an account with a code sent by a password reset request.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Revoke pending invites sent by a group.
+	RevokeGroupInvites(ctx context.Context, in *RevokeGroupInvitesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Execute a Lua function on the server.
 	RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.CallOption) (*api.Rpc, error)
+	// Search a channel's message history by text, sender and time range.
+	SearchChannelMessages(ctx context.Context, in *SearchChannelMessagesRequest, opts ...grpc.CallOption) (*api.ChannelMessageList, error)
+	// Search groups with a query over group fields and metadata.
+	SearchGroups(ctx context.Context, in *SearchGroupsRequest, opts ...grpc.CallOption) (*api.GroupList, error)
+	// Send a reply to a message in a channel.
+	SendChannelMessageReply(ctx context.Context, in *SendChannelMessageReplyRequest, opts ...grpc.CallOption) (*api.ChannelMessage, error)
 	// Remove the Apple ID from the social profiles on the current user's account.
 	UnlinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove the custom ID from the social profiles on the current user's account.
@@ -269,12 +366,16 @@ type NakamaClient interface {
 	UnlinkGameCenter(ctx context.Context, in *api.AccountGameCenter, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove Google from the social profiles on the current user's account.
 	UnlinkGoogle(ctx context.Context, in *api.AccountGoogle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Remove an OpenID Connect identity from the social profiles on the current user's account.
+	UnlinkOIDC(ctx context.Context, in *AccountOIDC, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove Steam from the social profiles on the current user's account.
 	UnlinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Update fields in the current user's account.
 	UpdateAccount(ctx context.Context, in *api.UpdateAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Update fields in a given group.
 	UpdateGroup(ctx context.Context, in *api.UpdateGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Update the current user's push notification preferences.
+	UpdatePushPreferences(ctx context.Context, in *PushPreferences, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Validate Apple IAP Receipt
 	ValidatePurchaseApple(ctx context.Context, in *api.ValidatePurchaseAppleRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error)
 	// Validate Apple Subscription Receipt
@@ -287,6 +388,8 @@ type NakamaClient interface {
 	ValidatePurchaseHuawei(ctx context.Context, in *api.ValidatePurchaseHuaweiRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error)
 	// Validate FB Instant IAP Receipt
 	ValidatePurchaseFacebookInstant(ctx context.Context, in *api.ValidatePurchaseFacebookInstantRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error)
+	// Mark an email address as verified with a code sent by an email verification request.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Write a record to a leaderboard.
 	WriteLeaderboardRecord(ctx context.Context, in *api.WriteLeaderboardRecordRequest, opts ...grpc.CallOption) (*api.LeaderboardRecord, error)
 	// Write objects into the storage engine.
@@ -303,6 +406,15 @@ func NewNakamaClient(cc grpc.ClientConnInterface) NakamaClient {
 	return &nakamaClient{cc}
 }
 
+func (c *nakamaClient) AcceptGroupInvite(ctx context.Context, in *GroupInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_AcceptGroupInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) AddFriends(ctx context.Context, in *api.AddFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_AddFriends_FullMethodName, in, out, opts...)
@@ -312,6 +424,15 @@ func (c *nakamaClient) AddFriends(ctx context.Context, in *api.AddFriendsRequest
 	return out, nil
 }
 
+func (c *nakamaClient) AddPushDevice(ctx context.Context, in *AddPushDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_AddPushDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) AddGroupUsers(ctx context.Context, in *api.AddGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_AddGroupUsers_FullMethodName, in, out, opts...)
@@ -339,6 +460,24 @@ func (c *nakamaClient) SessionLogout(ctx context.Context, in *api.SessionLogoutR
 	return out, nil
 }
 
+func (c *nakamaClient) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActiveSessionList, error) {
+	out := new(ActiveSessionList)
+	err := c.cc.Invoke(ctx, Nakama_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) AuthenticateApple(ctx context.Context, in *api.AuthenticateAppleRequest, opts ...grpc.CallOption) (*api.Session, error) {
 	out := new(api.Session)
 	err := c.cc.Invoke(ctx, Nakama_AuthenticateApple_FullMethodName, in, out, opts...)
@@ -411,6 +550,24 @@ func (c *nakamaClient) AuthenticateGoogle(ctx context.Context, in *api.Authentic
 	return out, nil
 }
 
+func (c *nakamaClient) AuthenticateOIDC(ctx context.Context, in *AuthenticateOIDCRequest, opts ...grpc.CallOption) (*api.Session, error) {
+	out := new(api.Session)
+	err := c.cc.Invoke(ctx, Nakama_AuthenticateOIDC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) AuthenticateMFA(ctx context.Context, in *AuthenticateMFARequest, opts ...grpc.CallOption) (*api.Session, error) {
+	out := new(api.Session)
+	err := c.cc.Invoke(ctx, Nakama_AuthenticateMFA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) AuthenticateSteam(ctx context.Context, in *api.AuthenticateSteamRequest, opts ...grpc.CallOption) (*api.Session, error) {
 	out := new(api.Session)
 	err := c.cc.Invoke(ctx, Nakama_AuthenticateSteam_FullMethodName, in, out, opts...)
@@ -438,6 +595,15 @@ func (c *nakamaClient) BlockFriends(ctx context.Context, in *api.BlockFriendsReq
 	return out, nil
 }
 
+func (c *nakamaClient) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error) {
+	out := new(MFARecoveryCodes)
+	err := c.cc.Invoke(ctx, Nakama_ConfirmMFA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) CreateGroup(ctx context.Context, in *api.CreateGroupRequest, opts ...grpc.CallOption) (*api.Group, error) {
 	out := new(api.Group)
 	err := c.cc.Invoke(ctx, Nakama_CreateGroup_FullMethodName, in, out, opts...)
@@ -456,6 +622,15 @@ func (c *nakamaClient) DeleteAccount(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *nakamaClient) DeclineGroupInvite(ctx context.Context, in *GroupInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_DeclineGroupInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) DeleteFriends(ctx context.Context, in *api.DeleteFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_DeleteFriends_FullMethodName, in, out, opts...)
@@ -492,6 +667,15 @@ func (c *nakamaClient) DeleteNotifications(ctx context.Context, in *api.DeleteNo
 	return out, nil
 }
 
+func (c *nakamaClient) DeletePushDevice(ctx context.Context, in *DeletePushDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_DeletePushDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) DeleteTournamentRecord(ctx context.Context, in *api.DeleteTournamentRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_DeleteTournamentRecord_FullMethodName, in, out, opts...)
@@ -510,6 +694,15 @@ func (c *nakamaClient) DeleteStorageObjects(ctx context.Context, in *api.DeleteS
 	return out, nil
 }
 
+func (c *nakamaClient) EnrollMFA(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MFAEnrollment, error) {
+	out := new(MFAEnrollment)
+	err := c.cc.Invoke(ctx, Nakama_EnrollMFA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) Event(ctx context.Context, in *api.Event, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_Event_FullMethodName, in, out, opts...)
@@ -555,6 +748,15 @@ func (c *nakamaClient) GetMatchmakerStats(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
+func (c *nakamaClient) GetPushPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PushPreferences, error) {
+	out := new(PushPreferences)
+	err := c.cc.Invoke(ctx, Nakama_GetPushPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) Healthcheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_Healthcheck_FullMethodName, in, out, opts...)
@@ -582,6 +784,24 @@ func (c *nakamaClient) ImportSteamFriends(ctx context.Context, in *api.ImportSte
 	return out, nil
 }
 
+func (c *nakamaClient) InviteFriends(ctx context.Context, in *InviteFriendsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_InviteFriends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) InviteGroupUsers(ctx context.Context, in *InviteGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_InviteGroupUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) JoinGroup(ctx context.Context, in *api.JoinGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_JoinGroup_FullMethodName, in, out, opts...)
@@ -690,6 +910,15 @@ func (c *nakamaClient) LinkGoogle(ctx context.Context, in *api.AccountGoogle, op
 	return out, nil
 }
 
+func (c *nakamaClient) LinkOIDC(ctx context.Context, in *AccountOIDC, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_LinkOIDC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) LinkSteam(ctx context.Context, in *api.LinkSteamRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_LinkSteam_FullMethodName, in, out, opts...)
@@ -708,6 +937,24 @@ func (c *nakamaClient) ListChannelMessages(ctx context.Context, in *api.ListChan
 	return out, nil
 }
 
+func (c *nakamaClient) ListChannelMessageReactions(ctx context.Context, in *ListChannelMessageReactionsRequest, opts ...grpc.CallOption) (*ChannelMessageReactionsList, error) {
+	out := new(ChannelMessageReactionsList)
+	err := c.cc.Invoke(ctx, Nakama_ListChannelMessageReactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ListChannelMessageReplies(ctx context.Context, in *ListChannelMessageRepliesRequest, opts ...grpc.CallOption) (*api.ChannelMessageList, error) {
+	out := new(api.ChannelMessageList)
+	err := c.cc.Invoke(ctx, Nakama_ListChannelMessageReplies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListFriends(ctx context.Context, in *api.ListFriendsRequest, opts ...grpc.CallOption) (*api.FriendList, error) {
 	out := new(api.FriendList)
 	err := c.cc.Invoke(ctx, Nakama_ListFriends_FullMethodName, in, out, opts...)
@@ -717,6 +964,15 @@ func (c *nakamaClient) ListFriends(ctx context.Context, in *api.ListFriendsReque
 	return out, nil
 }
 
+func (c *nakamaClient) ListFriendRecommendations(ctx context.Context, in *ListFriendRecommendationsRequest, opts ...grpc.CallOption) (*FriendRecommendationList, error) {
+	out := new(FriendRecommendationList)
+	err := c.cc.Invoke(ctx, Nakama_ListFriendRecommendations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListFriendsOfFriends(ctx context.Context, in *api.ListFriendsOfFriendsRequest, opts ...grpc.CallOption) (*api.FriendsOfFriendsList, error) {
 	out := new(api.FriendsOfFriendsList)
 	err := c.cc.Invoke(ctx, Nakama_ListFriendsOfFriends_FullMethodName, in, out, opts...)
@@ -726,6 +982,24 @@ func (c *nakamaClient) ListFriendsOfFriends(ctx context.Context, in *api.ListFri
 	return out, nil
 }
 
+func (c *nakamaClient) ListGroupEvents(ctx context.Context, in *ListGroupEventsRequest, opts ...grpc.CallOption) (*GroupEventList, error) {
+	out := new(GroupEventList)
+	err := c.cc.Invoke(ctx, Nakama_ListGroupEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ListGroupInvites(ctx context.Context, in *ListGroupInvitesRequest, opts ...grpc.CallOption) (*GroupInviteList, error) {
+	out := new(GroupInviteList)
+	err := c.cc.Invoke(ctx, Nakama_ListGroupInvites_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListGroups(ctx context.Context, in *api.ListGroupsRequest, opts ...grpc.CallOption) (*api.GroupList, error) {
 	out := new(api.GroupList)
 	err := c.cc.Invoke(ctx, Nakama_ListGroups_FullMethodName, in, out, opts...)
@@ -744,6 +1018,15 @@ func (c *nakamaClient) ListGroupUsers(ctx context.Context, in *api.ListGroupUser
 	return out, nil
 }
 
+func (c *nakamaClient) ListGroupUserInvites(ctx context.Context, in *ListGroupUserInvitesRequest, opts ...grpc.CallOption) (*GroupInviteList, error) {
+	out := new(GroupInviteList)
+	err := c.cc.Invoke(ctx, Nakama_ListGroupUserInvites_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListLeaderboardRecords(ctx context.Context, in *api.ListLeaderboardRecordsRequest, opts ...grpc.CallOption) (*api.LeaderboardRecordList, error) {
 	out := new(api.LeaderboardRecordList)
 	err := c.cc.Invoke(ctx, Nakama_ListLeaderboardRecords_FullMethodName, in, out, opts...)
@@ -780,6 +1063,24 @@ func (c *nakamaClient) ListNotifications(ctx context.Context, in *api.ListNotifi
 	return out, nil
 }
 
+func (c *nakamaClient) ListNotificationInbox(ctx context.Context, in *ListNotificationInboxRequest, opts ...grpc.CallOption) (*NotificationInbox, error) {
+	out := new(NotificationInbox)
+	err := c.cc.Invoke(ctx, Nakama_ListNotificationInbox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ListPushDevices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PushDeviceList, error) {
+	out := new(PushDeviceList)
+	err := c.cc.Invoke(ctx, Nakama_ListPushDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListStorageObjects(ctx context.Context, in *api.ListStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjectList, error) {
 	out := new(api.StorageObjectList)
 	err := c.cc.Invoke(ctx, Nakama_ListStorageObjects_FullMethodName, in, out, opts...)
@@ -852,6 +1153,24 @@ func (c *nakamaClient) DemoteGroupUsers(ctx context.Context, in *api.DemoteGroup
 	return out, nil
 }
 
+func (c *nakamaClient) ReactChannelMessage(ctx context.Context, in *ReactChannelMessageRequest, opts ...grpc.CallOption) (*ChannelMessageReactions, error) {
+	out := new(ChannelMessageReactions)
+	err := c.cc.Invoke(ctx, Nakama_ReactChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ReadNotifications(ctx context.Context, in *ReadNotificationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_ReadNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ReadStorageObjects(ctx context.Context, in *api.ReadStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjects, error) {
 	out := new(api.StorageObjects)
 	err := c.cc.Invoke(ctx, Nakama_ReadStorageObjects_FullMethodName, in, out, opts...)
@@ -861,6 +1180,42 @@ func (c *nakamaClient) ReadStorageObjects(ctx context.Context, in *api.ReadStora
 	return out, nil
 }
 
+func (c *nakamaClient) RequestEmailVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_RequestEmailVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_RequestPasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_ResetPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) RevokeGroupInvites(ctx context.Context, in *RevokeGroupInvitesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_RevokeGroupInvites_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.CallOption) (*api.Rpc, error) {
 	out := new(api.Rpc)
 	err := c.cc.Invoke(ctx, Nakama_RpcFunc_FullMethodName, in, out, opts...)
@@ -870,6 +1225,33 @@ func (c *nakamaClient) RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *nakamaClient) SearchChannelMessages(ctx context.Context, in *SearchChannelMessagesRequest, opts ...grpc.CallOption) (*api.ChannelMessageList, error) {
+	out := new(api.ChannelMessageList)
+	err := c.cc.Invoke(ctx, Nakama_SearchChannelMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) SearchGroups(ctx context.Context, in *SearchGroupsRequest, opts ...grpc.CallOption) (*api.GroupList, error) {
+	out := new(api.GroupList)
+	err := c.cc.Invoke(ctx, Nakama_SearchGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) SendChannelMessageReply(ctx context.Context, in *SendChannelMessageReplyRequest, opts ...grpc.CallOption) (*api.ChannelMessage, error) {
+	out := new(api.ChannelMessage)
+	err := c.cc.Invoke(ctx, Nakama_SendChannelMessageReply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) UnlinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_UnlinkApple_FullMethodName, in, out, opts...)
@@ -942,6 +1324,15 @@ func (c *nakamaClient) UnlinkGoogle(ctx context.Context, in *api.AccountGoogle,
 	return out, nil
 }
 
+func (c *nakamaClient) UnlinkOIDC(ctx context.Context, in *AccountOIDC, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_UnlinkOIDC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) UnlinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Nakama_UnlinkSteam_FullMethodName, in, out, opts...)
@@ -969,6 +1360,15 @@ func (c *nakamaClient) UpdateGroup(ctx context.Context, in *api.UpdateGroupReque
 	return out, nil
 }
 
+func (c *nakamaClient) UpdatePushPreferences(ctx context.Context, in *PushPreferences, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_UpdatePushPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ValidatePurchaseApple(ctx context.Context, in *api.ValidatePurchaseAppleRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error) {
 	out := new(api.ValidatePurchaseResponse)
 	err := c.cc.Invoke(ctx, Nakama_ValidatePurchaseApple_FullMethodName, in, out, opts...)
@@ -1023,6 +1423,15 @@ func (c *nakamaClient) ValidatePurchaseFacebookInstant(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *nakamaClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Nakama_VerifyEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) WriteLeaderboardRecord(ctx context.Context, in *api.WriteLeaderboardRecordRequest, opts ...grpc.CallOption) (*api.LeaderboardRecord, error) {
 	out := new(api.LeaderboardRecord)
 	err := c.cc.Invoke(ctx, Nakama_WriteLeaderboardRecord_FullMethodName, in, out, opts...)
@@ -1054,14 +1463,22 @@ func (c *nakamaClient) WriteTournamentRecord(ctx context.Context, in *api.WriteT
 // All implementations must embed UnimplementedNakamaServer
 // for forward compatibility
 type NakamaServer interface {
+	// Accept an invite to join a group, adding the current user as a member.
+	AcceptGroupInvite(context.Context, *GroupInviteRequest) (*emptypb.Empty, error)
 	// Add friends by ID or username to a user's account.
 	AddFriends(context.Context, *api.AddFriendsRequest) (*emptypb.Empty, error)
+	// Register a device token to receive push notifications for the current user.
+	AddPushDevice(context.Context, *AddPushDeviceRequest) (*emptypb.Empty, error)
 	// Add users to a group.
 	AddGroupUsers(context.Context, *api.AddGroupUsersRequest) (*emptypb.Empty, error)
 	// Refresh a user's session using a refresh token retrieved from a previous authentication request.
 	SessionRefresh(context.Context, *api.SessionRefreshRequest) (*api.Session, error)
 	// Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
 	SessionLogout(context.Context, *api.SessionLogoutRequest) (*emptypb.Empty, error)
+	// List the current user's active sessions, one for each client they are logged in from.
+	ListSessions(context.Context, *emptypb.Empty) (*ActiveSessionList, error)
+	// Log out one of the current user's active sessions, invalidating both its session and refresh tokens.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
 	// Authenticate a user with an Apple ID against the server.
 	AuthenticateApple(context.Context, *api.AuthenticateAppleRequest) (*api.Session, error)
 	// Authenticate a user with a custom id against the server.
@@ -1078,16 +1495,25 @@ type NakamaServer interface {
 	AuthenticateGameCenter(context.Context, *api.AuthenticateGameCenterRequest) (*api.Session, error)
 	// Authenticate a user with Google against the server.
 	AuthenticateGoogle(context.Context, *api.AuthenticateGoogleRequest) (*api.Session, error)
+	// Authenticate a user with an ID token from a configured OpenID Connect identity provider against the server.
+	AuthenticateOIDC(context.Context, *AuthenticateOIDCRequest) (*api.Session, error)
+	// Complete authentication of a user with MFA enabled, with the challenge token returned by their first
+	// authentication step and a code from their authenticator app or one of their recovery codes.
+	AuthenticateMFA(context.Context, *AuthenticateMFARequest) (*api.Session, error)
 	// Authenticate a user with Steam against the server.
 	AuthenticateSteam(context.Context, *api.AuthenticateSteamRequest) (*api.Session, error)
 	// Ban a set of users from a group.
 	BanGroupUsers(context.Context, *api.BanGroupUsersRequest) (*emptypb.Empty, error)
 	// Block one or more users by ID or username.
 	BlockFriends(context.Context, *api.BlockFriendsRequest) (*emptypb.Empty, error)
+	// Complete MFA enrollment for the current user with a code from their authenticator app.
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error)
 	// Create a new group with the current user as the owner.
 	CreateGroup(context.Context, *api.CreateGroupRequest) (*api.Group, error)
 	// Delete the current user's account.
 	DeleteAccount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Decline an invite to join a group.
+	DeclineGroupInvite(context.Context, *GroupInviteRequest) (*emptypb.Empty, error)
 	// Delete one or more users by ID or username.
 	DeleteFriends(context.Context, *api.DeleteFriendsRequest) (*emptypb.Empty, error)
 	// Delete a group by ID.
@@ -1096,10 +1522,14 @@ type NakamaServer interface {
 	DeleteLeaderboardRecord(context.Context, *api.DeleteLeaderboardRecordRequest) (*emptypb.Empty, error)
 	// Delete one or more notifications for the current user.
 	DeleteNotifications(context.Context, *api.DeleteNotificationsRequest) (*emptypb.Empty, error)
+	// Remove a push notification device token from the current user.
+	DeletePushDevice(context.Context, *DeletePushDeviceRequest) (*emptypb.Empty, error)
 	// Delete a tournament record.
 	DeleteTournamentRecord(context.Context, *api.DeleteTournamentRecordRequest) (*emptypb.Empty, error)
 	// Delete one or more objects by ID or username.
 	DeleteStorageObjects(context.Context, *api.DeleteStorageObjectsRequest) (*emptypb.Empty, error)
+	// Start MFA enrollment for the current user, returning a secret to add to their authenticator app.
+	EnrollMFA(context.Context, *emptypb.Empty) (*MFAEnrollment, error)
 	// Submit an event for processing in the server's registered runtime custom events handler.
 	Event(context.Context, *api.Event) (*emptypb.Empty, error)
 	// Fetch the current user's account.
@@ -1110,12 +1540,18 @@ type NakamaServer interface {
 	GetSubscription(context.Context, *api.GetSubscriptionRequest) (*api.ValidatedSubscription, error)
 	// Get matchmaker stats.
 	GetMatchmakerStats(context.Context, *emptypb.Empty) (*api.MatchmakerStats, error)
+	// Fetch the current user's push notification preferences.
+	GetPushPreferences(context.Context, *emptypb.Empty) (*PushPreferences, error)
 	// A healthcheck which load balancers can use to check the service.
 	Healthcheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// Import Facebook friends and add them to a user's account.
 	ImportFacebookFriends(context.Context, *api.ImportFacebookFriendsRequest) (*emptypb.Empty, error)
 	// Import Steam friends and add them to a user's account.
 	ImportSteamFriends(context.Context, *api.ImportSteamFriendsRequest) (*emptypb.Empty, error)
+	// Add friends by ID or username, attaching a message to any new friend invites sent.
+	InviteFriends(context.Context, *InviteFriendsRequest) (*emptypb.Empty, error)
+	// Invite users to join a group. Invited users become members once they accept the invite.
+	InviteGroupUsers(context.Context, *InviteGroupUsersRequest) (*emptypb.Empty, error)
 	// Immediately join an open group, or request to join a closed one.
 	JoinGroup(context.Context, *api.JoinGroupRequest) (*emptypb.Empty, error)
 	// Attempt to join an open and running tournament.
@@ -1140,18 +1576,32 @@ type NakamaServer interface {
 	LinkGameCenter(context.Context, *api.AccountGameCenter) (*emptypb.Empty, error)
 	// Add Google to the social profiles on the current user's account.
 	LinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error)
+	// Add an OpenID Connect identity to the social profiles on the current user's account.
+	LinkOIDC(context.Context, *AccountOIDC) (*emptypb.Empty, error)
 	// Add Steam to the social profiles on the current user's account.
 	LinkSteam(context.Context, *api.LinkSteamRequest) (*emptypb.Empty, error)
 	// List a channel's message history.
 	ListChannelMessages(context.Context, *api.ListChannelMessagesRequest) (*api.ChannelMessageList, error)
+	// List reaction counts for messages in a channel.
+	ListChannelMessageReactions(context.Context, *ListChannelMessageReactionsRequest) (*ChannelMessageReactionsList, error)
+	// List replies to a message in a channel.
+	ListChannelMessageReplies(context.Context, *ListChannelMessageRepliesRequest) (*api.ChannelMessageList, error)
 	// List all friends for the current user.
 	ListFriends(context.Context, *api.ListFriendsRequest) (*api.FriendList, error)
+	// List users recommended as friends for the current user, best matches first.
+	ListFriendRecommendations(context.Context, *ListFriendRecommendationsRequest) (*FriendRecommendationList, error)
 	// List friends of friends for the current user.
 	ListFriendsOfFriends(context.Context, *api.ListFriendsOfFriendsRequest) (*api.FriendsOfFriendsList, error)
+	// List the activity feed of a group, newest first.
+	ListGroupEvents(context.Context, *ListGroupEventsRequest) (*GroupEventList, error)
+	// List pending invites to join groups received by the current user.
+	ListGroupInvites(context.Context, *ListGroupInvitesRequest) (*GroupInviteList, error)
 	// List groups based on given filters.
 	ListGroups(context.Context, *api.ListGroupsRequest) (*api.GroupList, error)
 	// List all users that are part of a group.
 	ListGroupUsers(context.Context, *api.ListGroupUsersRequest) (*api.GroupUserList, error)
+	// List pending invites sent by a group.
+	ListGroupUserInvites(context.Context, *ListGroupUserInvitesRequest) (*GroupInviteList, error)
 	// List leaderboard records.
 	ListLeaderboardRecords(context.Context, *api.ListLeaderboardRecordsRequest) (*api.LeaderboardRecordList, error)
 	// List leaderboard records that belong to a user.
@@ -1160,6 +1610,10 @@ type NakamaServer interface {
 	ListMatches(context.Context, *api.ListMatchesRequest) (*api.MatchList, error)
 	// Fetch list of notifications.
 	ListNotifications(context.Context, *api.ListNotificationsRequest) (*api.NotificationList, error)
+	// Fetch notifications for the current user with their read state, optionally filtered.
+	ListNotificationInbox(context.Context, *ListNotificationInboxRequest) (*NotificationInbox, error)
+	// List device tokens registered to receive push notifications for the current user.
+	ListPushDevices(context.Context, *emptypb.Empty) (*PushDeviceList, error)
 	// List publicly readable storage objects in a given collection.
 	ListStorageObjects(context.Context, *api.ListStorageObjectsRequest) (*api.StorageObjectList, error)
 	// List user's subscriptions.
@@ -1176,10 +1630,28 @@ type NakamaServer interface {
 	PromoteGroupUsers(context.Context, *api.PromoteGroupUsersRequest) (*emptypb.Empty, error)
 	// Demote a set of users in a group to the next role down.
 	DemoteGroupUsers(context.Context, *api.DemoteGroupUsersRequest) (*emptypb.Empty, error)
+	// Add or remove a reaction from the current user on a message in a channel.
+	ReactChannelMessage(context.Context, *ReactChannelMessageRequest) (*ChannelMessageReactions, error)
+	// Mark one or more notifications as read for the current user.
+	ReadNotifications(context.Context, *ReadNotificationsRequest) (*emptypb.Empty, error)
 	// Get storage objects.
 	ReadStorageObjects(context.Context, *api.ReadStorageObjectsRequest) (*api.StorageObjects, error)
+	// Send a code to the current user's email address that verifies they own it.
+	RequestEmailVerification(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Send a password reset code to the email address of an account, if one exists.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// Set a new password on an account with a code sent by a password reset request.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
+	// Revoke pending invites sent by a group.
+	RevokeGroupInvites(context.Context, *RevokeGroupInvitesRequest) (*emptypb.Empty, error)
 	// Execute a Lua function on the server.
 	RpcFunc(context.Context, *api.Rpc) (*api.Rpc, error)
+	// Search a channel's message history by text, sender and time range.
+	SearchChannelMessages(context.Context, *SearchChannelMessagesRequest) (*api.ChannelMessageList, error)
+	// Search groups with a query over group fields and metadata.
+	SearchGroups(context.Context, *SearchGroupsRequest) (*api.GroupList, error)
+	// Send a reply to a message in a channel.
+	SendChannelMessageReply(context.Context, *SendChannelMessageReplyRequest) (*api.ChannelMessage, error)
 	// Remove the Apple ID from the social profiles on the current user's account.
 	UnlinkApple(context.Context, *api.AccountApple) (*emptypb.Empty, error)
 	// Remove the custom ID from the social profiles on the current user's account.
@@ -1196,12 +1668,16 @@ type NakamaServer interface {
 	UnlinkGameCenter(context.Context, *api.AccountGameCenter) (*emptypb.Empty, error)
 	// Remove Google from the social profiles on the current user's account.
 	UnlinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error)
+	// Remove an OpenID Connect identity from the social profiles on the current user's account.
+	UnlinkOIDC(context.Context, *AccountOIDC) (*emptypb.Empty, error)
 	// Remove Steam from the social profiles on the current user's account.
 	UnlinkSteam(context.Context, *api.AccountSteam) (*emptypb.Empty, error)
 	// Update fields in the current user's account.
 	UpdateAccount(context.Context, *api.UpdateAccountRequest) (*emptypb.Empty, error)
 	// Update fields in a given group.
 	UpdateGroup(context.Context, *api.UpdateGroupRequest) (*emptypb.Empty, error)
+	// Update the current user's push notification preferences.
+	UpdatePushPreferences(context.Context, *PushPreferences) (*emptypb.Empty, error)
 	// Validate Apple IAP Receipt
 	ValidatePurchaseApple(context.Context, *api.ValidatePurchaseAppleRequest) (*api.ValidatePurchaseResponse, error)
 	// Validate Apple Subscription Receipt
@@ -1214,6 +1690,8 @@ type NakamaServer interface {
 	ValidatePurchaseHuawei(context.Context, *api.ValidatePurchaseHuaweiRequest) (*api.ValidatePurchaseResponse, error)
 	// Validate FB Instant IAP Receipt
 	ValidatePurchaseFacebookInstant(context.Context, *api.ValidatePurchaseFacebookInstantRequest) (*api.ValidatePurchaseResponse, error)
+	// Mark an email address as verified with a code sent by an email verification request.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error)
 	// Write a record to a leaderboard.
 	WriteLeaderboardRecord(context.Context, *api.WriteLeaderboardRecordRequest) (*api.LeaderboardRecord, error)
 	// Write objects into the storage engine.
@@ -1227,9 +1705,15 @@ type NakamaServer interface {
 type UnimplementedNakamaServer struct {
 }
 
+func (UnimplementedNakamaServer) AcceptGroupInvite(context.Context, *GroupInviteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptGroupInvite not implemented")
+}
 func (UnimplementedNakamaServer) AddFriends(context.Context, *api.AddFriendsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFriends not implemented")
 }
+func (UnimplementedNakamaServer) AddPushDevice(context.Context, *AddPushDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPushDevice not implemented")
+}
 func (UnimplementedNakamaServer) AddGroupUsers(context.Context, *api.AddGroupUsersRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddGroupUsers not implemented")
 }
@@ -1239,6 +1723,12 @@ func (UnimplementedNakamaServer) SessionRefresh(context.Context, *api.SessionRef
 func (UnimplementedNakamaServer) SessionLogout(context.Context, *api.SessionLogoutRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SessionLogout not implemented")
 }
+func (UnimplementedNakamaServer) ListSessions(context.Context, *emptypb.Empty) (*ActiveSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedNakamaServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
 func (UnimplementedNakamaServer) AuthenticateApple(context.Context, *api.AuthenticateAppleRequest) (*api.Session, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateApple not implemented")
 }
@@ -1263,6 +1753,12 @@ func (UnimplementedNakamaServer) AuthenticateGameCenter(context.Context, *api.Au
 func (UnimplementedNakamaServer) AuthenticateGoogle(context.Context, *api.AuthenticateGoogleRequest) (*api.Session, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateGoogle not implemented")
 }
+func (UnimplementedNakamaServer) AuthenticateOIDC(context.Context, *AuthenticateOIDCRequest) (*api.Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateOIDC not implemented")
+}
+func (UnimplementedNakamaServer) AuthenticateMFA(context.Context, *AuthenticateMFARequest) (*api.Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateMFA not implemented")
+}
 func (UnimplementedNakamaServer) AuthenticateSteam(context.Context, *api.AuthenticateSteamRequest) (*api.Session, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateSteam not implemented")
 }
@@ -1272,12 +1768,18 @@ func (UnimplementedNakamaServer) BanGroupUsers(context.Context, *api.BanGroupUse
 func (UnimplementedNakamaServer) BlockFriends(context.Context, *api.BlockFriendsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockFriends not implemented")
 }
+func (UnimplementedNakamaServer) ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmMFA not implemented")
+}
 func (UnimplementedNakamaServer) CreateGroup(context.Context, *api.CreateGroupRequest) (*api.Group, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
 func (UnimplementedNakamaServer) DeleteAccount(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
+func (UnimplementedNakamaServer) DeclineGroupInvite(context.Context, *GroupInviteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineGroupInvite not implemented")
+}
 func (UnimplementedNakamaServer) DeleteFriends(context.Context, *api.DeleteFriendsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriends not implemented")
 }
@@ -1290,12 +1792,18 @@ func (UnimplementedNakamaServer) DeleteLeaderboardRecord(context.Context, *api.D
 func (UnimplementedNakamaServer) DeleteNotifications(context.Context, *api.DeleteNotificationsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotifications not implemented")
 }
+func (UnimplementedNakamaServer) DeletePushDevice(context.Context, *DeletePushDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePushDevice not implemented")
+}
 func (UnimplementedNakamaServer) DeleteTournamentRecord(context.Context, *api.DeleteTournamentRecordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTournamentRecord not implemented")
 }
 func (UnimplementedNakamaServer) DeleteStorageObjects(context.Context, *api.DeleteStorageObjectsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStorageObjects not implemented")
 }
+func (UnimplementedNakamaServer) EnrollMFA(context.Context, *emptypb.Empty) (*MFAEnrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollMFA not implemented")
+}
 func (UnimplementedNakamaServer) Event(context.Context, *api.Event) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Event not implemented")
 }
@@ -1311,6 +1819,9 @@ func (UnimplementedNakamaServer) GetSubscription(context.Context, *api.GetSubscr
 func (UnimplementedNakamaServer) GetMatchmakerStats(context.Context, *emptypb.Empty) (*api.MatchmakerStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMatchmakerStats not implemented")
 }
+func (UnimplementedNakamaServer) GetPushPreferences(context.Context, *emptypb.Empty) (*PushPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushPreferences not implemented")
+}
 func (UnimplementedNakamaServer) Healthcheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Healthcheck not implemented")
 }
@@ -1320,6 +1831,12 @@ func (UnimplementedNakamaServer) ImportFacebookFriends(context.Context, *api.Imp
 func (UnimplementedNakamaServer) ImportSteamFriends(context.Context, *api.ImportSteamFriendsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportSteamFriends not implemented")
 }
+func (UnimplementedNakamaServer) InviteFriends(context.Context, *InviteFriendsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteFriends not implemented")
+}
+func (UnimplementedNakamaServer) InviteGroupUsers(context.Context, *InviteGroupUsersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteGroupUsers not implemented")
+}
 func (UnimplementedNakamaServer) JoinGroup(context.Context, *api.JoinGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinGroup not implemented")
 }
@@ -1356,24 +1873,45 @@ func (UnimplementedNakamaServer) LinkGameCenter(context.Context, *api.AccountGam
 func (UnimplementedNakamaServer) LinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkGoogle not implemented")
 }
+func (UnimplementedNakamaServer) LinkOIDC(context.Context, *AccountOIDC) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOIDC not implemented")
+}
 func (UnimplementedNakamaServer) LinkSteam(context.Context, *api.LinkSteamRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkSteam not implemented")
 }
 func (UnimplementedNakamaServer) ListChannelMessages(context.Context, *api.ListChannelMessagesRequest) (*api.ChannelMessageList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChannelMessages not implemented")
 }
+func (UnimplementedNakamaServer) ListChannelMessageReactions(context.Context, *ListChannelMessageReactionsRequest) (*ChannelMessageReactionsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelMessageReactions not implemented")
+}
+func (UnimplementedNakamaServer) ListChannelMessageReplies(context.Context, *ListChannelMessageRepliesRequest) (*api.ChannelMessageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelMessageReplies not implemented")
+}
 func (UnimplementedNakamaServer) ListFriends(context.Context, *api.ListFriendsRequest) (*api.FriendList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
 }
+func (UnimplementedNakamaServer) ListFriendRecommendations(context.Context, *ListFriendRecommendationsRequest) (*FriendRecommendationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriendRecommendations not implemented")
+}
 func (UnimplementedNakamaServer) ListFriendsOfFriends(context.Context, *api.ListFriendsOfFriendsRequest) (*api.FriendsOfFriendsList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriendsOfFriends not implemented")
 }
+func (UnimplementedNakamaServer) ListGroupEvents(context.Context, *ListGroupEventsRequest) (*GroupEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupEvents not implemented")
+}
+func (UnimplementedNakamaServer) ListGroupInvites(context.Context, *ListGroupInvitesRequest) (*GroupInviteList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupInvites not implemented")
+}
 func (UnimplementedNakamaServer) ListGroups(context.Context, *api.ListGroupsRequest) (*api.GroupList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
 }
 func (UnimplementedNakamaServer) ListGroupUsers(context.Context, *api.ListGroupUsersRequest) (*api.GroupUserList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroupUsers not implemented")
 }
+func (UnimplementedNakamaServer) ListGroupUserInvites(context.Context, *ListGroupUserInvitesRequest) (*GroupInviteList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupUserInvites not implemented")
+}
 func (UnimplementedNakamaServer) ListLeaderboardRecords(context.Context, *api.ListLeaderboardRecordsRequest) (*api.LeaderboardRecordList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLeaderboardRecords not implemented")
 }
@@ -1386,6 +1924,12 @@ func (UnimplementedNakamaServer) ListMatches(context.Context, *api.ListMatchesRe
 func (UnimplementedNakamaServer) ListNotifications(context.Context, *api.ListNotificationsRequest) (*api.NotificationList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
 }
+func (UnimplementedNakamaServer) ListNotificationInbox(context.Context, *ListNotificationInboxRequest) (*NotificationInbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationInbox not implemented")
+}
+func (UnimplementedNakamaServer) ListPushDevices(context.Context, *emptypb.Empty) (*PushDeviceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPushDevices not implemented")
+}
 func (UnimplementedNakamaServer) ListStorageObjects(context.Context, *api.ListStorageObjectsRequest) (*api.StorageObjectList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStorageObjects not implemented")
 }
@@ -1410,12 +1954,39 @@ func (UnimplementedNakamaServer) PromoteGroupUsers(context.Context, *api.Promote
 func (UnimplementedNakamaServer) DemoteGroupUsers(context.Context, *api.DemoteGroupUsersRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DemoteGroupUsers not implemented")
 }
+func (UnimplementedNakamaServer) ReactChannelMessage(context.Context, *ReactChannelMessageRequest) (*ChannelMessageReactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactChannelMessage not implemented")
+}
+func (UnimplementedNakamaServer) ReadNotifications(context.Context, *ReadNotificationsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadNotifications not implemented")
+}
 func (UnimplementedNakamaServer) ReadStorageObjects(context.Context, *api.ReadStorageObjectsRequest) (*api.StorageObjects, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadStorageObjects not implemented")
 }
+func (UnimplementedNakamaServer) RequestEmailVerification(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailVerification not implemented")
+}
+func (UnimplementedNakamaServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedNakamaServer) ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedNakamaServer) RevokeGroupInvites(context.Context, *RevokeGroupInvitesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupInvites not implemented")
+}
 func (UnimplementedNakamaServer) RpcFunc(context.Context, *api.Rpc) (*api.Rpc, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcFunc not implemented")
 }
+func (UnimplementedNakamaServer) SearchChannelMessages(context.Context, *SearchChannelMessagesRequest) (*api.ChannelMessageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchChannelMessages not implemented")
+}
+func (UnimplementedNakamaServer) SearchGroups(context.Context, *SearchGroupsRequest) (*api.GroupList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGroups not implemented")
+}
+func (UnimplementedNakamaServer) SendChannelMessageReply(context.Context, *SendChannelMessageReplyRequest) (*api.ChannelMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChannelMessageReply not implemented")
+}
 func (UnimplementedNakamaServer) UnlinkApple(context.Context, *api.AccountApple) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlinkApple not implemented")
 }
@@ -1440,6 +2011,9 @@ func (UnimplementedNakamaServer) UnlinkGameCenter(context.Context, *api.AccountG
 func (UnimplementedNakamaServer) UnlinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlinkGoogle not implemented")
 }
+func (UnimplementedNakamaServer) UnlinkOIDC(context.Context, *AccountOIDC) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkOIDC not implemented")
+}
 func (UnimplementedNakamaServer) UnlinkSteam(context.Context, *api.AccountSteam) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlinkSteam not implemented")
 }
@@ -1449,6 +2023,9 @@ func (UnimplementedNakamaServer) UpdateAccount(context.Context, *api.UpdateAccou
 func (UnimplementedNakamaServer) UpdateGroup(context.Context, *api.UpdateGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
 }
+func (UnimplementedNakamaServer) UpdatePushPreferences(context.Context, *PushPreferences) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePushPreferences not implemented")
+}
 func (UnimplementedNakamaServer) ValidatePurchaseApple(context.Context, *api.ValidatePurchaseAppleRequest) (*api.ValidatePurchaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatePurchaseApple not implemented")
 }
@@ -1467,6 +2044,9 @@ func (UnimplementedNakamaServer) ValidatePurchaseHuawei(context.Context, *api.Va
 func (UnimplementedNakamaServer) ValidatePurchaseFacebookInstant(context.Context, *api.ValidatePurchaseFacebookInstantRequest) (*api.ValidatePurchaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatePurchaseFacebookInstant not implemented")
 }
+func (UnimplementedNakamaServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
 func (UnimplementedNakamaServer) WriteLeaderboardRecord(context.Context, *api.WriteLeaderboardRecordRequest) (*api.LeaderboardRecord, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteLeaderboardRecord not implemented")
 }
@@ -1489,6 +2069,24 @@ func RegisterNakamaServer(s grpc.ServiceRegistrar, srv NakamaServer) {
 	s.RegisterService(&Nakama_ServiceDesc, srv)
 }
 
+func _Nakama_AcceptGroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).AcceptGroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_AcceptGroupInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).AcceptGroupInvite(ctx, req.(*GroupInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_AddFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AddFriendsRequest)
 	if err := dec(in); err != nil {
@@ -1507,6 +2105,24 @@ func _Nakama_AddFriends_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_AddPushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPushDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).AddPushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_AddPushDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).AddPushDevice(ctx, req.(*AddPushDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_AddGroupUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AddGroupUsersRequest)
 	if err := dec(in); err != nil {
@@ -1561,6 +2177,42 @@ func _Nakama_SessionLogout_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_AuthenticateApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AuthenticateAppleRequest)
 	if err := dec(in); err != nil {
@@ -1705,6 +2357,42 @@ func _Nakama_AuthenticateGoogle_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_AuthenticateOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateOIDCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).AuthenticateOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_AuthenticateOIDC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).AuthenticateOIDC(ctx, req.(*AuthenticateOIDCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_AuthenticateMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).AuthenticateMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_AuthenticateMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).AuthenticateMFA(ctx, req.(*AuthenticateMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_AuthenticateSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AuthenticateSteamRequest)
 	if err := dec(in); err != nil {
@@ -1759,6 +2447,24 @@ func _Nakama_BlockFriends_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ConfirmMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ConfirmMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ConfirmMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ConfirmMFA(ctx, req.(*ConfirmMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.CreateGroupRequest)
 	if err := dec(in); err != nil {
@@ -1795,6 +2501,24 @@ func _Nakama_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_DeclineGroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).DeclineGroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_DeclineGroupInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).DeclineGroupInvite(ctx, req.(*GroupInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_DeleteFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.DeleteFriendsRequest)
 	if err := dec(in); err != nil {
@@ -1867,6 +2591,24 @@ func _Nakama_DeleteNotifications_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_DeletePushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePushDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).DeletePushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_DeletePushDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).DeletePushDevice(ctx, req.(*DeletePushDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_DeleteTournamentRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.DeleteTournamentRecordRequest)
 	if err := dec(in); err != nil {
@@ -1903,6 +2645,24 @@ func _Nakama_DeleteStorageObjects_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_EnrollMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).EnrollMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_EnrollMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).EnrollMFA(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_Event_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.Event)
 	if err := dec(in); err != nil {
@@ -1993,6 +2753,24 @@ func _Nakama_GetMatchmakerStats_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_GetPushPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).GetPushPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_GetPushPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).GetPushPreferences(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_Healthcheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2047,6 +2825,42 @@ func _Nakama_ImportSteamFriends_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_InviteFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).InviteFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_InviteFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).InviteFriends(ctx, req.(*InviteFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_InviteGroupUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteGroupUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).InviteGroupUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_InviteGroupUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).InviteGroupUsers(ctx, req.(*InviteGroupUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.JoinGroupRequest)
 	if err := dec(in); err != nil {
@@ -2263,6 +3077,24 @@ func _Nakama_LinkGoogle_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_LinkOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountOIDC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).LinkOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_LinkOIDC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).LinkOIDC(ctx, req.(*AccountOIDC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_LinkSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.LinkSteamRequest)
 	if err := dec(in); err != nil {
@@ -2299,175 +3131,319 @@ func _Nakama_ListChannelMessages_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListFriendsRequest)
+func _Nakama_ListChannelMessageReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelMessageReactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListFriends(ctx, in)
+		return srv.(NakamaServer).ListChannelMessageReactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListFriends_FullMethodName,
+		FullMethod: Nakama_ListChannelMessageReactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListFriends(ctx, req.(*api.ListFriendsRequest))
+		return srv.(NakamaServer).ListChannelMessageReactions(ctx, req.(*ListChannelMessageReactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListFriendsOfFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListFriendsOfFriendsRequest)
+func _Nakama_ListChannelMessageReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelMessageRepliesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListFriendsOfFriends(ctx, in)
+		return srv.(NakamaServer).ListChannelMessageReplies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListFriendsOfFriends_FullMethodName,
+		FullMethod: Nakama_ListChannelMessageReplies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListFriendsOfFriends(ctx, req.(*api.ListFriendsOfFriendsRequest))
+		return srv.(NakamaServer).ListChannelMessageReplies(ctx, req.(*ListChannelMessageRepliesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListGroupsRequest)
+func _Nakama_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListFriendsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListGroups(ctx, in)
+		return srv.(NakamaServer).ListFriends(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListGroups_FullMethodName,
+		FullMethod: Nakama_ListFriends_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListGroups(ctx, req.(*api.ListGroupsRequest))
+		return srv.(NakamaServer).ListFriends(ctx, req.(*api.ListFriendsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListGroupUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListGroupUsersRequest)
+func _Nakama_ListFriendRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendRecommendationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListGroupUsers(ctx, in)
+		return srv.(NakamaServer).ListFriendRecommendations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListGroupUsers_FullMethodName,
+		FullMethod: Nakama_ListFriendRecommendations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListGroupUsers(ctx, req.(*api.ListGroupUsersRequest))
+		return srv.(NakamaServer).ListFriendRecommendations(ctx, req.(*ListFriendRecommendationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListLeaderboardRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListLeaderboardRecordsRequest)
+func _Nakama_ListFriendsOfFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListFriendsOfFriendsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListLeaderboardRecords(ctx, in)
+		return srv.(NakamaServer).ListFriendsOfFriends(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListLeaderboardRecords_FullMethodName,
+		FullMethod: Nakama_ListFriendsOfFriends_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListLeaderboardRecords(ctx, req.(*api.ListLeaderboardRecordsRequest))
+		return srv.(NakamaServer).ListFriendsOfFriends(ctx, req.(*api.ListFriendsOfFriendsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListLeaderboardRecordsAroundOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListLeaderboardRecordsAroundOwnerRequest)
+func _Nakama_ListGroupEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListLeaderboardRecordsAroundOwner(ctx, in)
+		return srv.(NakamaServer).ListGroupEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListLeaderboardRecordsAroundOwner_FullMethodName,
+		FullMethod: Nakama_ListGroupEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListLeaderboardRecordsAroundOwner(ctx, req.(*api.ListLeaderboardRecordsAroundOwnerRequest))
+		return srv.(NakamaServer).ListGroupEvents(ctx, req.(*ListGroupEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListMatchesRequest)
+func _Nakama_ListGroupInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupInvitesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListMatches(ctx, in)
+		return srv.(NakamaServer).ListGroupInvites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListMatches_FullMethodName,
+		FullMethod: Nakama_ListGroupInvites_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListMatches(ctx, req.(*api.ListMatchesRequest))
+		return srv.(NakamaServer).ListGroupInvites(ctx, req.(*ListGroupInvitesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListNotificationsRequest)
+func _Nakama_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListNotifications(ctx, in)
+		return srv.(NakamaServer).ListGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListNotifications_FullMethodName,
+		FullMethod: Nakama_ListGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListNotifications(ctx, req.(*api.ListNotificationsRequest))
+		return srv.(NakamaServer).ListGroups(ctx, req.(*api.ListGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListStorageObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListStorageObjectsRequest)
+func _Nakama_ListGroupUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListGroupUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListStorageObjects(ctx, in)
+		return srv.(NakamaServer).ListGroupUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Nakama_ListStorageObjects_FullMethodName,
+		FullMethod: Nakama_ListGroupUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListStorageObjects(ctx, req.(*api.ListStorageObjectsRequest))
+		return srv.(NakamaServer).ListGroupUsers(ctx, req.(*api.ListGroupUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nakama_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.ListSubscriptionsRequest)
+func _Nakama_ListGroupUserInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupUserInvitesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NakamaServer).ListSubscriptions(ctx, in)
+		return srv.(NakamaServer).ListGroupUserInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListGroupUserInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListGroupUserInvites(ctx, req.(*ListGroupUserInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListLeaderboardRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListLeaderboardRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListLeaderboardRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListLeaderboardRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListLeaderboardRecords(ctx, req.(*api.ListLeaderboardRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListLeaderboardRecordsAroundOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListLeaderboardRecordsAroundOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListLeaderboardRecordsAroundOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListLeaderboardRecordsAroundOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListLeaderboardRecordsAroundOwner(ctx, req.(*api.ListLeaderboardRecordsAroundOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListMatches(ctx, req.(*api.ListMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListNotifications(ctx, req.(*api.ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListNotificationInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationInboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListNotificationInbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListNotificationInbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListNotificationInbox(ctx, req.(*ListNotificationInboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListPushDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListPushDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListPushDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListPushDevices(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListStorageObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListStorageObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListStorageObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ListStorageObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListStorageObjects(ctx, req.(*api.ListStorageObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -2587,6 +3563,42 @@ func _Nakama_DemoteGroupUsers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ReactChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactChannelMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ReactChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ReactChannelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ReactChannelMessage(ctx, req.(*ReactChannelMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ReadNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ReadNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ReadNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ReadNotifications(ctx, req.(*ReadNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_ReadStorageObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.ReadStorageObjectsRequest)
 	if err := dec(in); err != nil {
@@ -2605,6 +3617,78 @@ func _Nakama_ReadStorageObjects_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_RequestEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).RequestEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_RequestEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).RequestEmailVerification(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_RevokeGroupInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).RevokeGroupInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_RevokeGroupInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).RevokeGroupInvites(ctx, req.(*RevokeGroupInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_RpcFunc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.Rpc)
 	if err := dec(in); err != nil {
@@ -2623,6 +3707,60 @@ func _Nakama_RpcFunc_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_SearchChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchChannelMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).SearchChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_SearchChannelMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).SearchChannelMessages(ctx, req.(*SearchChannelMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_SearchGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).SearchGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_SearchGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).SearchGroups(ctx, req.(*SearchGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_SendChannelMessageReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChannelMessageReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).SendChannelMessageReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_SendChannelMessageReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).SendChannelMessageReply(ctx, req.(*SendChannelMessageReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_UnlinkApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountApple)
 	if err := dec(in); err != nil {
@@ -2767,6 +3905,24 @@ func _Nakama_UnlinkGoogle_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_UnlinkOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountOIDC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).UnlinkOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_UnlinkOIDC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).UnlinkOIDC(ctx, req.(*AccountOIDC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_UnlinkSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountSteam)
 	if err := dec(in); err != nil {
@@ -2821,6 +3977,24 @@ func _Nakama_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_UpdatePushPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushPreferences)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).UpdatePushPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_UpdatePushPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).UpdatePushPreferences(ctx, req.(*PushPreferences))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_ValidatePurchaseApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.ValidatePurchaseAppleRequest)
 	if err := dec(in); err != nil {
@@ -2929,6 +4103,24 @@ func _Nakama_ValidatePurchaseFacebookInstant_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nakama_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_WriteLeaderboardRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.WriteLeaderboardRecordRequest)
 	if err := dec(in); err != nil {
@@ -2990,10 +4182,18 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "nakama.api.Nakama",
 	HandlerType: (*NakamaServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcceptGroupInvite",
+			Handler:    _Nakama_AcceptGroupInvite_Handler,
+		},
 		{
 			MethodName: "AddFriends",
 			Handler:    _Nakama_AddFriends_Handler,
 		},
+		{
+			MethodName: "AddPushDevice",
+			Handler:    _Nakama_AddPushDevice_Handler,
+		},
 		{
 			MethodName: "AddGroupUsers",
 			Handler:    _Nakama_AddGroupUsers_Handler,
@@ -3006,6 +4206,14 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SessionLogout",
 			Handler:    _Nakama_SessionLogout_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Nakama_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Nakama_RevokeSession_Handler,
+		},
 		{
 			MethodName: "AuthenticateApple",
 			Handler:    _Nakama_AuthenticateApple_Handler,
@@ -3038,6 +4246,14 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticateGoogle",
 			Handler:    _Nakama_AuthenticateGoogle_Handler,
 		},
+		{
+			MethodName: "AuthenticateOIDC",
+			Handler:    _Nakama_AuthenticateOIDC_Handler,
+		},
+		{
+			MethodName: "AuthenticateMFA",
+			Handler:    _Nakama_AuthenticateMFA_Handler,
+		},
 		{
 			MethodName: "AuthenticateSteam",
 			Handler:    _Nakama_AuthenticateSteam_Handler,
@@ -3050,6 +4266,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockFriends",
 			Handler:    _Nakama_BlockFriends_Handler,
 		},
+		{
+			MethodName: "ConfirmMFA",
+			Handler:    _Nakama_ConfirmMFA_Handler,
+		},
 		{
 			MethodName: "CreateGroup",
 			Handler:    _Nakama_CreateGroup_Handler,
@@ -3058,6 +4278,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _Nakama_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "DeclineGroupInvite",
+			Handler:    _Nakama_DeclineGroupInvite_Handler,
+		},
 		{
 			MethodName: "DeleteFriends",
 			Handler:    _Nakama_DeleteFriends_Handler,
@@ -3074,6 +4298,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNotifications",
 			Handler:    _Nakama_DeleteNotifications_Handler,
 		},
+		{
+			MethodName: "DeletePushDevice",
+			Handler:    _Nakama_DeletePushDevice_Handler,
+		},
 		{
 			MethodName: "DeleteTournamentRecord",
 			Handler:    _Nakama_DeleteTournamentRecord_Handler,
@@ -3082,6 +4310,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStorageObjects",
 			Handler:    _Nakama_DeleteStorageObjects_Handler,
 		},
+		{
+			MethodName: "EnrollMFA",
+			Handler:    _Nakama_EnrollMFA_Handler,
+		},
 		{
 			MethodName: "Event",
 			Handler:    _Nakama_Event_Handler,
@@ -3102,6 +4334,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMatchmakerStats",
 			Handler:    _Nakama_GetMatchmakerStats_Handler,
 		},
+		{
+			MethodName: "GetPushPreferences",
+			Handler:    _Nakama_GetPushPreferences_Handler,
+		},
 		{
 			MethodName: "Healthcheck",
 			Handler:    _Nakama_Healthcheck_Handler,
@@ -3114,6 +4350,14 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportSteamFriends",
 			Handler:    _Nakama_ImportSteamFriends_Handler,
 		},
+		{
+			MethodName: "InviteFriends",
+			Handler:    _Nakama_InviteFriends_Handler,
+		},
+		{
+			MethodName: "InviteGroupUsers",
+			Handler:    _Nakama_InviteGroupUsers_Handler,
+		},
 		{
 			MethodName: "JoinGroup",
 			Handler:    _Nakama_JoinGroup_Handler,
@@ -3162,6 +4406,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LinkGoogle",
 			Handler:    _Nakama_LinkGoogle_Handler,
 		},
+		{
+			MethodName: "LinkOIDC",
+			Handler:    _Nakama_LinkOIDC_Handler,
+		},
 		{
 			MethodName: "LinkSteam",
 			Handler:    _Nakama_LinkSteam_Handler,
@@ -3170,14 +4418,34 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListChannelMessages",
 			Handler:    _Nakama_ListChannelMessages_Handler,
 		},
+		{
+			MethodName: "ListChannelMessageReactions",
+			Handler:    _Nakama_ListChannelMessageReactions_Handler,
+		},
+		{
+			MethodName: "ListChannelMessageReplies",
+			Handler:    _Nakama_ListChannelMessageReplies_Handler,
+		},
 		{
 			MethodName: "ListFriends",
 			Handler:    _Nakama_ListFriends_Handler,
 		},
+		{
+			MethodName: "ListFriendRecommendations",
+			Handler:    _Nakama_ListFriendRecommendations_Handler,
+		},
 		{
 			MethodName: "ListFriendsOfFriends",
 			Handler:    _Nakama_ListFriendsOfFriends_Handler,
 		},
+		{
+			MethodName: "ListGroupEvents",
+			Handler:    _Nakama_ListGroupEvents_Handler,
+		},
+		{
+			MethodName: "ListGroupInvites",
+			Handler:    _Nakama_ListGroupInvites_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _Nakama_ListGroups_Handler,
@@ -3186,6 +4454,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGroupUsers",
 			Handler:    _Nakama_ListGroupUsers_Handler,
 		},
+		{
+			MethodName: "ListGroupUserInvites",
+			Handler:    _Nakama_ListGroupUserInvites_Handler,
+		},
 		{
 			MethodName: "ListLeaderboardRecords",
 			Handler:    _Nakama_ListLeaderboardRecords_Handler,
@@ -3202,6 +4474,14 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListNotifications",
 			Handler:    _Nakama_ListNotifications_Handler,
 		},
+		{
+			MethodName: "ListNotificationInbox",
+			Handler:    _Nakama_ListNotificationInbox_Handler,
+		},
+		{
+			MethodName: "ListPushDevices",
+			Handler:    _Nakama_ListPushDevices_Handler,
+		},
 		{
 			MethodName: "ListStorageObjects",
 			Handler:    _Nakama_ListStorageObjects_Handler,
@@ -3234,14 +4514,50 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DemoteGroupUsers",
 			Handler:    _Nakama_DemoteGroupUsers_Handler,
 		},
+		{
+			MethodName: "ReactChannelMessage",
+			Handler:    _Nakama_ReactChannelMessage_Handler,
+		},
+		{
+			MethodName: "ReadNotifications",
+			Handler:    _Nakama_ReadNotifications_Handler,
+		},
 		{
 			MethodName: "ReadStorageObjects",
 			Handler:    _Nakama_ReadStorageObjects_Handler,
 		},
+		{
+			MethodName: "RequestEmailVerification",
+			Handler:    _Nakama_RequestEmailVerification_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _Nakama_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _Nakama_ResetPassword_Handler,
+		},
+		{
+			MethodName: "RevokeGroupInvites",
+			Handler:    _Nakama_RevokeGroupInvites_Handler,
+		},
 		{
 			MethodName: "RpcFunc",
 			Handler:    _Nakama_RpcFunc_Handler,
 		},
+		{
+			MethodName: "SearchChannelMessages",
+			Handler:    _Nakama_SearchChannelMessages_Handler,
+		},
+		{
+			MethodName: "SearchGroups",
+			Handler:    _Nakama_SearchGroups_Handler,
+		},
+		{
+			MethodName: "SendChannelMessageReply",
+			Handler:    _Nakama_SendChannelMessageReply_Handler,
+		},
 		{
 			MethodName: "UnlinkApple",
 			Handler:    _Nakama_UnlinkApple_Handler,
@@ -3274,6 +4590,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlinkGoogle",
 			Handler:    _Nakama_UnlinkGoogle_Handler,
 		},
+		{
+			MethodName: "UnlinkOIDC",
+			Handler:    _Nakama_UnlinkOIDC_Handler,
+		},
 		{
 			MethodName: "UnlinkSteam",
 			Handler:    _Nakama_UnlinkSteam_Handler,
@@ -3286,6 +4606,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroup",
 			Handler:    _Nakama_UpdateGroup_Handler,
 		},
+		{
+			MethodName: "UpdatePushPreferences",
+			Handler:    _Nakama_UpdatePushPreferences_Handler,
+		},
 		{
 			MethodName: "ValidatePurchaseApple",
 			Handler:    _Nakama_ValidatePurchaseApple_Handler,
@@ -3310,6 +4634,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatePurchaseFacebookInstant",
 			Handler:    _Nakama_ValidatePurchaseFacebookInstant_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _Nakama_VerifyEmail_Handler,
+		},
 		{
 			MethodName: "WriteLeaderboardRecord",
 			Handler:    _Nakama_WriteLeaderboardRecord_Handler,
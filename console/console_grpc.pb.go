@@ -38,75 +38,89 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Console_Authenticate_FullMethodName              = "/nakama.console.Console/Authenticate"
-	Console_AuthenticateLogout_FullMethodName        = "/nakama.console.Console/AuthenticateLogout"
-	Console_AuthenticateMFASetup_FullMethodName      = "/nakama.console.Console/AuthenticateMFASetup"
-	Console_AddUser_FullMethodName                   = "/nakama.console.Console/AddUser"
-	Console_AddGroupUsers_FullMethodName             = "/nakama.console.Console/AddGroupUsers"
-	Console_BanAccount_FullMethodName                = "/nakama.console.Console/BanAccount"
-	Console_CallApiEndpoint_FullMethodName           = "/nakama.console.Console/CallApiEndpoint"
-	Console_CallRpcEndpoint_FullMethodName           = "/nakama.console.Console/CallRpcEndpoint"
-	Console_DeleteAllData_FullMethodName             = "/nakama.console.Console/DeleteAllData"
-	Console_DeleteAccount_FullMethodName             = "/nakama.console.Console/DeleteAccount"
-	Console_DeleteChannelMessages_FullMethodName     = "/nakama.console.Console/DeleteChannelMessages"
-	Console_DeleteFriend_FullMethodName              = "/nakama.console.Console/DeleteFriend"
-	Console_DeleteGroup_FullMethodName               = "/nakama.console.Console/DeleteGroup"
-	Console_DeleteGroupUser_FullMethodName           = "/nakama.console.Console/DeleteGroupUser"
-	Console_DeleteStorage_FullMethodName             = "/nakama.console.Console/DeleteStorage"
-	Console_DeleteStorageObject_FullMethodName       = "/nakama.console.Console/DeleteStorageObject"
-	Console_DeleteAccounts_FullMethodName            = "/nakama.console.Console/DeleteAccounts"
-	Console_DeleteLeaderboard_FullMethodName         = "/nakama.console.Console/DeleteLeaderboard"
-	Console_DeleteLeaderboardRecord_FullMethodName   = "/nakama.console.Console/DeleteLeaderboardRecord"
-	Console_DeleteNotification_FullMethodName        = "/nakama.console.Console/DeleteNotification"
-	Console_DeleteUser_FullMethodName                = "/nakama.console.Console/DeleteUser"
-	Console_DeleteWalletLedger_FullMethodName        = "/nakama.console.Console/DeleteWalletLedger"
-	Console_DemoteGroupMember_FullMethodName         = "/nakama.console.Console/DemoteGroupMember"
-	Console_ExportAccount_FullMethodName             = "/nakama.console.Console/ExportAccount"
-	Console_ExportGroup_FullMethodName               = "/nakama.console.Console/ExportGroup"
-	Console_GetAccount_FullMethodName                = "/nakama.console.Console/GetAccount"
-	Console_GetConfig_FullMethodName                 = "/nakama.console.Console/GetConfig"
-	Console_GetFriends_FullMethodName                = "/nakama.console.Console/GetFriends"
-	Console_GetGroup_FullMethodName                  = "/nakama.console.Console/GetGroup"
-	Console_GetMembers_FullMethodName                = "/nakama.console.Console/GetMembers"
-	Console_GetGroups_FullMethodName                 = "/nakama.console.Console/GetGroups"
-	Console_GetLeaderboard_FullMethodName            = "/nakama.console.Console/GetLeaderboard"
-	Console_GetMatchState_FullMethodName             = "/nakama.console.Console/GetMatchState"
-	Console_GetRuntime_FullMethodName                = "/nakama.console.Console/GetRuntime"
-	Console_GetStatus_FullMethodName                 = "/nakama.console.Console/GetStatus"
-	Console_GetStorage_FullMethodName                = "/nakama.console.Console/GetStorage"
-	Console_GetWalletLedger_FullMethodName           = "/nakama.console.Console/GetWalletLedger"
-	Console_GetNotification_FullMethodName           = "/nakama.console.Console/GetNotification"
-	Console_GetPurchase_FullMethodName               = "/nakama.console.Console/GetPurchase"
-	Console_GetSubscription_FullMethodName           = "/nakama.console.Console/GetSubscription"
-	Console_ListApiEndpoints_FullMethodName          = "/nakama.console.Console/ListApiEndpoints"
-	Console_ListLeaderboardRecords_FullMethodName    = "/nakama.console.Console/ListLeaderboardRecords"
-	Console_ListLeaderboards_FullMethodName          = "/nakama.console.Console/ListLeaderboards"
-	Console_ListStorage_FullMethodName               = "/nakama.console.Console/ListStorage"
-	Console_ListStorageCollections_FullMethodName    = "/nakama.console.Console/ListStorageCollections"
-	Console_ListAccounts_FullMethodName              = "/nakama.console.Console/ListAccounts"
-	Console_ListChannelMessages_FullMethodName       = "/nakama.console.Console/ListChannelMessages"
-	Console_ListGroups_FullMethodName                = "/nakama.console.Console/ListGroups"
-	Console_ListNotifications_FullMethodName         = "/nakama.console.Console/ListNotifications"
-	Console_ListMatches_FullMethodName               = "/nakama.console.Console/ListMatches"
-	Console_ListPurchases_FullMethodName             = "/nakama.console.Console/ListPurchases"
-	Console_ListSubscriptions_FullMethodName         = "/nakama.console.Console/ListSubscriptions"
-	Console_ListUsers_FullMethodName                 = "/nakama.console.Console/ListUsers"
-	Console_PromoteGroupMember_FullMethodName        = "/nakama.console.Console/PromoteGroupMember"
-	Console_RequireUserMfa_FullMethodName            = "/nakama.console.Console/RequireUserMfa"
-	Console_ResetUserMfa_FullMethodName              = "/nakama.console.Console/ResetUserMfa"
-	Console_UnbanAccount_FullMethodName              = "/nakama.console.Console/UnbanAccount"
-	Console_UnlinkCustom_FullMethodName              = "/nakama.console.Console/UnlinkCustom"
-	Console_UnlinkDevice_FullMethodName              = "/nakama.console.Console/UnlinkDevice"
-	Console_UnlinkEmail_FullMethodName               = "/nakama.console.Console/UnlinkEmail"
-	Console_UnlinkApple_FullMethodName               = "/nakama.console.Console/UnlinkApple"
-	Console_UnlinkFacebook_FullMethodName            = "/nakama.console.Console/UnlinkFacebook"
-	Console_UnlinkFacebookInstantGame_FullMethodName = "/nakama.console.Console/UnlinkFacebookInstantGame"
-	Console_UnlinkGameCenter_FullMethodName          = "/nakama.console.Console/UnlinkGameCenter"
-	Console_UnlinkGoogle_FullMethodName              = "/nakama.console.Console/UnlinkGoogle"
-	Console_UnlinkSteam_FullMethodName               = "/nakama.console.Console/UnlinkSteam"
-	Console_UpdateAccount_FullMethodName             = "/nakama.console.Console/UpdateAccount"
-	Console_UpdateGroup_FullMethodName               = "/nakama.console.Console/UpdateGroup"
-	Console_WriteStorageObject_FullMethodName        = "/nakama.console.Console/WriteStorageObject"
+	Console_Authenticate_FullMethodName                = "/nakama.console.Console/Authenticate"
+	Console_AuthenticateLogout_FullMethodName          = "/nakama.console.Console/AuthenticateLogout"
+	Console_AuthenticateMFASetup_FullMethodName        = "/nakama.console.Console/AuthenticateMFASetup"
+	Console_AddUser_FullMethodName                     = "/nakama.console.Console/AddUser"
+	Console_AddChatMute_FullMethodName                 = "/nakama.console.Console/AddChatMute"
+	Console_AddGroupUsers_FullMethodName               = "/nakama.console.Console/AddGroupUsers"
+	Console_BanAccount_FullMethodName                  = "/nakama.console.Console/BanAccount"
+	Console_CallApiEndpoint_FullMethodName             = "/nakama.console.Console/CallApiEndpoint"
+	Console_CallRpcEndpoint_FullMethodName             = "/nakama.console.Console/CallRpcEndpoint"
+	Console_DeleteAllData_FullMethodName               = "/nakama.console.Console/DeleteAllData"
+	Console_DeleteAccount_FullMethodName               = "/nakama.console.Console/DeleteAccount"
+	Console_DeleteChannelMessages_FullMethodName       = "/nakama.console.Console/DeleteChannelMessages"
+	Console_DeleteChatMute_FullMethodName              = "/nakama.console.Console/DeleteChatMute"
+	Console_DeleteFriend_FullMethodName                = "/nakama.console.Console/DeleteFriend"
+	Console_DeleteSession_FullMethodName               = "/nakama.console.Console/DeleteSession"
+	Console_DeleteGroup_FullMethodName                 = "/nakama.console.Console/DeleteGroup"
+	Console_DeleteGroupUser_FullMethodName             = "/nakama.console.Console/DeleteGroupUser"
+	Console_DeleteStorage_FullMethodName               = "/nakama.console.Console/DeleteStorage"
+	Console_DeleteStorageObject_FullMethodName         = "/nakama.console.Console/DeleteStorageObject"
+	Console_DeleteAccounts_FullMethodName              = "/nakama.console.Console/DeleteAccounts"
+	Console_DeleteLeaderboard_FullMethodName           = "/nakama.console.Console/DeleteLeaderboard"
+	Console_DeleteLeaderboardRecord_FullMethodName     = "/nakama.console.Console/DeleteLeaderboardRecord"
+	Console_DeleteLockouts_FullMethodName              = "/nakama.console.Console/DeleteLockouts"
+	Console_DeleteNotification_FullMethodName          = "/nakama.console.Console/DeleteNotification"
+	Console_DeleteScheduledNotification_FullMethodName = "/nakama.console.Console/DeleteScheduledNotification"
+	Console_DeleteUser_FullMethodName                  = "/nakama.console.Console/DeleteUser"
+	Console_DeleteWalletLedger_FullMethodName          = "/nakama.console.Console/DeleteWalletLedger"
+	Console_DemoteGroupMember_FullMethodName           = "/nakama.console.Console/DemoteGroupMember"
+	Console_ExportAccount_FullMethodName               = "/nakama.console.Console/ExportAccount"
+	Console_ExportGroup_FullMethodName                 = "/nakama.console.Console/ExportGroup"
+	Console_GetAccount_FullMethodName                  = "/nakama.console.Console/GetAccount"
+	Console_GetConfig_FullMethodName                   = "/nakama.console.Console/GetConfig"
+	Console_GetFriends_FullMethodName                  = "/nakama.console.Console/GetFriends"
+	Console_GetSessions_FullMethodName                 = "/nakama.console.Console/GetSessions"
+	Console_GetGroup_FullMethodName                    = "/nakama.console.Console/GetGroup"
+	Console_GetMembers_FullMethodName                  = "/nakama.console.Console/GetMembers"
+	Console_GetGroups_FullMethodName                   = "/nakama.console.Console/GetGroups"
+	Console_GetLeaderboard_FullMethodName              = "/nakama.console.Console/GetLeaderboard"
+	Console_GetMatchState_FullMethodName               = "/nakama.console.Console/GetMatchState"
+	Console_GetRuntime_FullMethodName                  = "/nakama.console.Console/GetRuntime"
+	Console_GetStatus_FullMethodName                   = "/nakama.console.Console/GetStatus"
+	Console_GetStorage_FullMethodName                  = "/nakama.console.Console/GetStorage"
+	Console_GetWalletLedger_FullMethodName             = "/nakama.console.Console/GetWalletLedger"
+	Console_GetNotification_FullMethodName             = "/nakama.console.Console/GetNotification"
+	Console_GetPurchase_FullMethodName                 = "/nakama.console.Console/GetPurchase"
+	Console_GetSubscription_FullMethodName             = "/nakama.console.Console/GetSubscription"
+	Console_ListApiEndpoints_FullMethodName            = "/nakama.console.Console/ListApiEndpoints"
+	Console_ListLeaderboardRecords_FullMethodName      = "/nakama.console.Console/ListLeaderboardRecords"
+	Console_ListLeaderboards_FullMethodName            = "/nakama.console.Console/ListLeaderboards"
+	Console_ListStorage_FullMethodName                 = "/nakama.console.Console/ListStorage"
+	Console_ListStorageCollections_FullMethodName      = "/nakama.console.Console/ListStorageCollections"
+	Console_ListAccounts_FullMethodName                = "/nakama.console.Console/ListAccounts"
+	Console_ListChannelMessages_FullMethodName         = "/nakama.console.Console/ListChannelMessages"
+	Console_ListChatModerationAudit_FullMethodName     = "/nakama.console.Console/ListChatModerationAudit"
+	Console_ListChatMutes_FullMethodName               = "/nakama.console.Console/ListChatMutes"
+	Console_ListGroupEvents_FullMethodName             = "/nakama.console.Console/ListGroupEvents"
+	Console_ListGroups_FullMethodName                  = "/nakama.console.Console/ListGroups"
+	Console_ListLockouts_FullMethodName                = "/nakama.console.Console/ListLockouts"
+	Console_ListNotifications_FullMethodName           = "/nakama.console.Console/ListNotifications"
+	Console_ListScheduledNotifications_FullMethodName  = "/nakama.console.Console/ListScheduledNotifications"
+	Console_ListMatches_FullMethodName                 = "/nakama.console.Console/ListMatches"
+	Console_ListPurchases_FullMethodName               = "/nakama.console.Console/ListPurchases"
+	Console_ListSubscriptions_FullMethodName           = "/nakama.console.Console/ListSubscriptions"
+	Console_ListUsers_FullMethodName                   = "/nakama.console.Console/ListUsers"
+	Console_MergeAccounts_FullMethodName               = "/nakama.console.Console/MergeAccounts"
+	Console_PromoteGroupMember_FullMethodName          = "/nakama.console.Console/PromoteGroupMember"
+	Console_RequireUserMfa_FullMethodName              = "/nakama.console.Console/RequireUserMfa"
+	Console_ResetAccountMfa_FullMethodName             = "/nakama.console.Console/ResetAccountMfa"
+	Console_ResetUserMfa_FullMethodName                = "/nakama.console.Console/ResetUserMfa"
+	Console_RestoreAccount_FullMethodName              = "/nakama.console.Console/RestoreAccount"
+	Console_UnbanAccount_FullMethodName                = "/nakama.console.Console/UnbanAccount"
+	Console_UnlinkCustom_FullMethodName                = "/nakama.console.Console/UnlinkCustom"
+	Console_UnlinkDevice_FullMethodName                = "/nakama.console.Console/UnlinkDevice"
+	Console_UnlinkEmail_FullMethodName                 = "/nakama.console.Console/UnlinkEmail"
+	Console_UnlinkApple_FullMethodName                 = "/nakama.console.Console/UnlinkApple"
+	Console_UnlinkFacebook_FullMethodName              = "/nakama.console.Console/UnlinkFacebook"
+	Console_UnlinkFacebookInstantGame_FullMethodName   = "/nakama.console.Console/UnlinkFacebookInstantGame"
+	Console_UnlinkGameCenter_FullMethodName            = "/nakama.console.Console/UnlinkGameCenter"
+	Console_UnlinkGoogle_FullMethodName                = "/nakama.console.Console/UnlinkGoogle"
+	Console_UnlinkSteam_FullMethodName                 = "/nakama.console.Console/UnlinkSteam"
+	Console_UpdateAccount_FullMethodName               = "/nakama.console.Console/UpdateAccount"
+	Console_UpdateGroup_FullMethodName                 = "/nakama.console.Console/UpdateGroup"
+	Console_WriteStorageObject_FullMethodName          = "/nakama.console.Console/WriteStorageObject"
 )
 
 // ConsoleClient is the client API for Console service.
@@ -121,7 +135,8 @@ type ConsoleClient interface {
 	AuthenticateMFASetup(ctx context.Context, in *AuthenticateMFASetupRequest, opts ...grpc.CallOption) (*AuthenticateMFASetupResponse, error)
 	// Add a new console user.
 	AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Add/join members to a group.
+	// Mute a user in chat, rejecting their chat messages until the mute expires or is removed.
+	AddChatMute(ctx context.Context, in *AddChatMuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddGroupUsers(ctx context.Context, in *AddGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Ban a user.
 	BanAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -135,8 +150,12 @@ type ConsoleClient interface {
 	DeleteAccount(ctx context.Context, in *AccountDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete messages.
 	DeleteChannelMessages(ctx context.Context, in *DeleteChannelMessagesRequest, opts ...grpc.CallOption) (*DeleteChannelMessagesResponse, error)
+	// Remove a user's chat mute.
+	DeleteChatMute(ctx context.Context, in *DeleteChatMuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete the friend relationship between two users.
 	DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Log out one of a user's active sessions.
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove a group.
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove a user from a group.
@@ -151,8 +170,12 @@ type ConsoleClient interface {
 	DeleteLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete leaderboard record
 	DeleteLeaderboardRecord(ctx context.Context, in *DeleteLeaderboardRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Clear lockouts from failed login attempts for an account, an IP address, or both.
+	DeleteLockouts(ctx context.Context, in *LockoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete notification
 	DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Cancel a scheduled notification that has not been sent yet.
+	DeleteScheduledNotification(ctx context.Context, in *DeleteScheduledNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete console user.
 	DeleteUser(ctx context.Context, in *Username, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete a wallet ledger item.
@@ -169,6 +192,8 @@ type ConsoleClient interface {
 	GetConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Config, error)
 	// Get a user's list of friend relationships.
 	GetFriends(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*api.FriendList, error)
+	// Get a user's active sessions.
+	GetSessions(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*AccountSessionList, error)
 	// Get detailed group information.
 	GetGroup(ctx context.Context, in *GroupId, opts ...grpc.CallOption) (*api.Group, error)
 	// Get a list of members of the group.
@@ -207,10 +232,20 @@ type ConsoleClient interface {
 	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*AccountList, error)
 	// List channel messages with the selected filter
 	ListChannelMessages(ctx context.Context, in *ListChannelMessagesRequest, opts ...grpc.CallOption) (*api.ChannelMessageList, error)
+	// List the chat moderation audit trail, most recent first.
+	ListChatModerationAudit(ctx context.Context, in *ListChatModerationAuditRequest, opts ...grpc.CallOption) (*ChatModerationAuditList, error)
+	// List users currently muted in chat.
+	ListChatMutes(ctx context.Context, in *ListChatMutesRequest, opts ...grpc.CallOption) (*ChatMuteList, error)
+	// List the activity feed of a group, most recent first.
+	ListGroupEvents(ctx context.Context, in *ListGroupEventsRequest, opts ...grpc.CallOption) (*GroupEventList, error)
 	// List (and optionally filter) groups.
 	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*GroupList, error)
+	// List active lockouts from failed login attempts, optionally only those for an account or IP address.
+	ListLockouts(ctx context.Context, in *LockoutRequest, opts ...grpc.CallOption) (*LockoutList, error)
 	// List notifications.
 	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error)
+	// List scheduled notifications waiting to be sent.
+	ListScheduledNotifications(ctx context.Context, in *ListScheduledNotificationsRequest, opts ...grpc.CallOption) (*ScheduledNotificationList, error)
 	// List ongoing matches
 	ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*MatchList, error)
 	// List validated purchases
@@ -219,12 +254,18 @@ type ConsoleClient interface {
 	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*api.SubscriptionList, error)
 	// List (and optionally filter) users.
 	ListUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserList, error)
+	// Merge a duplicate account into another, moving everything it owns and deleting it.
+	MergeAccounts(ctx context.Context, in *AccountMergeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Promote a user from a group.
 	PromoteGroupMember(ctx context.Context, in *UpdateGroupUserStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Sets the user's MFA as required or not required.
 	RequireUserMfa(ctx context.Context, in *RequireUserMfaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reset an account's multi-factor authentication, so the player can sign in with only their password.
+	ResetAccountMfa(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Reset a user's multi-factor authentication credentials.
 	ResetUserMfa(ctx context.Context, in *ResetUserMfaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restore an account scheduled for deletion, cancelling its deletion.
+	RestoreAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Unban a user.
 	UnbanAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Unlink the custom ID from a user account.
@@ -297,6 +338,15 @@ func (c *consoleClient) AddUser(ctx context.Context, in *AddUserRequest, opts ..
 	return out, nil
 }
 
+func (c *consoleClient) AddChatMute(ctx context.Context, in *AddChatMuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_AddChatMute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) AddGroupUsers(ctx context.Context, in *AddGroupUsersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_AddGroupUsers_FullMethodName, in, out, opts...)
@@ -360,6 +410,15 @@ func (c *consoleClient) DeleteChannelMessages(ctx context.Context, in *DeleteCha
 	return out, nil
 }
 
+func (c *consoleClient) DeleteChatMute(ctx context.Context, in *DeleteChatMuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_DeleteChatMute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_DeleteFriend_FullMethodName, in, out, opts...)
@@ -369,6 +428,15 @@ func (c *consoleClient) DeleteFriend(ctx context.Context, in *DeleteFriendReques
 	return out, nil
 }
 
+func (c *consoleClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_DeleteSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_DeleteGroup_FullMethodName, in, out, opts...)
@@ -432,6 +500,15 @@ func (c *consoleClient) DeleteLeaderboardRecord(ctx context.Context, in *DeleteL
 	return out, nil
 }
 
+func (c *consoleClient) DeleteLockouts(ctx context.Context, in *LockoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_DeleteLockouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_DeleteNotification_FullMethodName, in, out, opts...)
@@ -441,6 +518,15 @@ func (c *consoleClient) DeleteNotification(ctx context.Context, in *DeleteNotifi
 	return out, nil
 }
 
+func (c *consoleClient) DeleteScheduledNotification(ctx context.Context, in *DeleteScheduledNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_DeleteScheduledNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) DeleteUser(ctx context.Context, in *Username, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_DeleteUser_FullMethodName, in, out, opts...)
@@ -513,6 +599,15 @@ func (c *consoleClient) GetFriends(ctx context.Context, in *AccountId, opts ...g
 	return out, nil
 }
 
+func (c *consoleClient) GetSessions(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*AccountSessionList, error) {
+	out := new(AccountSessionList)
+	err := c.cc.Invoke(ctx, Console_GetSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) GetGroup(ctx context.Context, in *GroupId, opts ...grpc.CallOption) (*api.Group, error) {
 	out := new(api.Group)
 	err := c.cc.Invoke(ctx, Console_GetGroup_FullMethodName, in, out, opts...)
@@ -684,6 +779,33 @@ func (c *consoleClient) ListChannelMessages(ctx context.Context, in *ListChannel
 	return out, nil
 }
 
+func (c *consoleClient) ListChatModerationAudit(ctx context.Context, in *ListChatModerationAuditRequest, opts ...grpc.CallOption) (*ChatModerationAuditList, error) {
+	out := new(ChatModerationAuditList)
+	err := c.cc.Invoke(ctx, Console_ListChatModerationAudit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) ListChatMutes(ctx context.Context, in *ListChatMutesRequest, opts ...grpc.CallOption) (*ChatMuteList, error) {
+	out := new(ChatMuteList)
+	err := c.cc.Invoke(ctx, Console_ListChatMutes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) ListGroupEvents(ctx context.Context, in *ListGroupEventsRequest, opts ...grpc.CallOption) (*GroupEventList, error) {
+	out := new(GroupEventList)
+	err := c.cc.Invoke(ctx, Console_ListGroupEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*GroupList, error) {
 	out := new(GroupList)
 	err := c.cc.Invoke(ctx, Console_ListGroups_FullMethodName, in, out, opts...)
@@ -693,6 +815,15 @@ func (c *consoleClient) ListGroups(ctx context.Context, in *ListGroupsRequest, o
 	return out, nil
 }
 
+func (c *consoleClient) ListLockouts(ctx context.Context, in *LockoutRequest, opts ...grpc.CallOption) (*LockoutList, error) {
+	out := new(LockoutList)
+	err := c.cc.Invoke(ctx, Console_ListLockouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error) {
 	out := new(NotificationList)
 	err := c.cc.Invoke(ctx, Console_ListNotifications_FullMethodName, in, out, opts...)
@@ -702,6 +833,15 @@ func (c *consoleClient) ListNotifications(ctx context.Context, in *ListNotificat
 	return out, nil
 }
 
+func (c *consoleClient) ListScheduledNotifications(ctx context.Context, in *ListScheduledNotificationsRequest, opts ...grpc.CallOption) (*ScheduledNotificationList, error) {
+	out := new(ScheduledNotificationList)
+	err := c.cc.Invoke(ctx, Console_ListScheduledNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*MatchList, error) {
 	out := new(MatchList)
 	err := c.cc.Invoke(ctx, Console_ListMatches_FullMethodName, in, out, opts...)
@@ -738,6 +878,15 @@ func (c *consoleClient) ListUsers(ctx context.Context, in *emptypb.Empty, opts .
 	return out, nil
 }
 
+func (c *consoleClient) MergeAccounts(ctx context.Context, in *AccountMergeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_MergeAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) PromoteGroupMember(ctx context.Context, in *UpdateGroupUserStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_PromoteGroupMember_FullMethodName, in, out, opts...)
@@ -756,6 +905,15 @@ func (c *consoleClient) RequireUserMfa(ctx context.Context, in *RequireUserMfaRe
 	return out, nil
 }
 
+func (c *consoleClient) ResetAccountMfa(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_ResetAccountMfa_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) ResetUserMfa(ctx context.Context, in *ResetUserMfaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_ResetUserMfa_FullMethodName, in, out, opts...)
@@ -765,6 +923,15 @@ func (c *consoleClient) ResetUserMfa(ctx context.Context, in *ResetUserMfaReques
 	return out, nil
 }
 
+func (c *consoleClient) RestoreAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Console_RestoreAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) UnbanAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Console_UnbanAccount_FullMethodName, in, out, opts...)
@@ -894,7 +1061,8 @@ type ConsoleServer interface {
 	AuthenticateMFASetup(context.Context, *AuthenticateMFASetupRequest) (*AuthenticateMFASetupResponse, error)
 	// Add a new console user.
 	AddUser(context.Context, *AddUserRequest) (*emptypb.Empty, error)
-	// Add/join members to a group.
+	// Mute a user in chat, rejecting their chat messages until the mute expires or is removed.
+	AddChatMute(context.Context, *AddChatMuteRequest) (*emptypb.Empty, error)
 	AddGroupUsers(context.Context, *AddGroupUsersRequest) (*emptypb.Empty, error)
 	// Ban a user.
 	BanAccount(context.Context, *AccountId) (*emptypb.Empty, error)
@@ -908,8 +1076,12 @@ type ConsoleServer interface {
 	DeleteAccount(context.Context, *AccountDeleteRequest) (*emptypb.Empty, error)
 	// Delete messages.
 	DeleteChannelMessages(context.Context, *DeleteChannelMessagesRequest) (*DeleteChannelMessagesResponse, error)
+	// Remove a user's chat mute.
+	DeleteChatMute(context.Context, *DeleteChatMuteRequest) (*emptypb.Empty, error)
 	// Delete the friend relationship between two users.
 	DeleteFriend(context.Context, *DeleteFriendRequest) (*emptypb.Empty, error)
+	// Log out one of a user's active sessions.
+	DeleteSession(context.Context, *DeleteSessionRequest) (*emptypb.Empty, error)
 	// Remove a group.
 	DeleteGroup(context.Context, *DeleteGroupRequest) (*emptypb.Empty, error)
 	// Remove a user from a group.
@@ -924,8 +1096,12 @@ type ConsoleServer interface {
 	DeleteLeaderboard(context.Context, *LeaderboardRequest) (*emptypb.Empty, error)
 	// Delete leaderboard record
 	DeleteLeaderboardRecord(context.Context, *DeleteLeaderboardRecordRequest) (*emptypb.Empty, error)
+	// Clear lockouts from failed login attempts for an account, an IP address, or both.
+	DeleteLockouts(context.Context, *LockoutRequest) (*emptypb.Empty, error)
 	// Delete notification
 	DeleteNotification(context.Context, *DeleteNotificationRequest) (*emptypb.Empty, error)
+	// Cancel a scheduled notification that has not been sent yet.
+	DeleteScheduledNotification(context.Context, *DeleteScheduledNotificationRequest) (*emptypb.Empty, error)
 	// Delete console user.
 	DeleteUser(context.Context, *Username) (*emptypb.Empty, error)
 	// Delete a wallet ledger item.
@@ -942,6 +1118,8 @@ type ConsoleServer interface {
 	GetConfig(context.Context, *emptypb.Empty) (*Config, error)
 	// Get a user's list of friend relationships.
 	GetFriends(context.Context, *AccountId) (*api.FriendList, error)
+	// Get a user's active sessions.
+	GetSessions(context.Context, *AccountId) (*AccountSessionList, error)
 	// Get detailed group information.
 	GetGroup(context.Context, *GroupId) (*api.Group, error)
 	// Get a list of members of the group.
@@ -980,10 +1158,20 @@ type ConsoleServer interface {
 	ListAccounts(context.Context, *ListAccountsRequest) (*AccountList, error)
 	// List channel messages with the selected filter
 	ListChannelMessages(context.Context, *ListChannelMessagesRequest) (*api.ChannelMessageList, error)
+	// List the chat moderation audit trail, most recent first.
+	ListChatModerationAudit(context.Context, *ListChatModerationAuditRequest) (*ChatModerationAuditList, error)
+	// List users currently muted in chat.
+	ListChatMutes(context.Context, *ListChatMutesRequest) (*ChatMuteList, error)
+	// List the activity feed of a group, most recent first.
+	ListGroupEvents(context.Context, *ListGroupEventsRequest) (*GroupEventList, error)
 	// List (and optionally filter) groups.
 	ListGroups(context.Context, *ListGroupsRequest) (*GroupList, error)
+	// List active lockouts from failed login attempts, optionally only those for an account or IP address.
+	ListLockouts(context.Context, *LockoutRequest) (*LockoutList, error)
 	// List notifications.
 	ListNotifications(context.Context, *ListNotificationsRequest) (*NotificationList, error)
+	// List scheduled notifications waiting to be sent.
+	ListScheduledNotifications(context.Context, *ListScheduledNotificationsRequest) (*ScheduledNotificationList, error)
 	// List ongoing matches
 	ListMatches(context.Context, *ListMatchesRequest) (*MatchList, error)
 	// List validated purchases
@@ -992,12 +1180,18 @@ type ConsoleServer interface {
 	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*api.SubscriptionList, error)
 	// List (and optionally filter) users.
 	ListUsers(context.Context, *emptypb.Empty) (*UserList, error)
+	// Merge a duplicate account into another, moving everything it owns and deleting it.
+	MergeAccounts(context.Context, *AccountMergeRequest) (*emptypb.Empty, error)
 	// Promote a user from a group.
 	PromoteGroupMember(context.Context, *UpdateGroupUserStateRequest) (*emptypb.Empty, error)
 	// Sets the user's MFA as required or not required.
 	RequireUserMfa(context.Context, *RequireUserMfaRequest) (*emptypb.Empty, error)
+	// Reset an account's multi-factor authentication, so the player can sign in with only their password.
+	ResetAccountMfa(context.Context, *AccountId) (*emptypb.Empty, error)
 	// Reset a user's multi-factor authentication credentials.
 	ResetUserMfa(context.Context, *ResetUserMfaRequest) (*emptypb.Empty, error)
+	// Restore an account scheduled for deletion, cancelling its deletion.
+	RestoreAccount(context.Context, *AccountId) (*emptypb.Empty, error)
 	// Unban a user.
 	UnbanAccount(context.Context, *AccountId) (*emptypb.Empty, error)
 	// Unlink the custom ID from a user account.
@@ -1043,6 +1237,9 @@ func (UnimplementedConsoleServer) AuthenticateMFASetup(context.Context, *Authent
 func (UnimplementedConsoleServer) AddUser(context.Context, *AddUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddUser not implemented")
 }
+func (UnimplementedConsoleServer) AddChatMute(context.Context, *AddChatMuteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChatMute not implemented")
+}
 func (UnimplementedConsoleServer) AddGroupUsers(context.Context, *AddGroupUsersRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddGroupUsers not implemented")
 }
@@ -1064,9 +1261,15 @@ func (UnimplementedConsoleServer) DeleteAccount(context.Context, *AccountDeleteR
 func (UnimplementedConsoleServer) DeleteChannelMessages(context.Context, *DeleteChannelMessagesRequest) (*DeleteChannelMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannelMessages not implemented")
 }
+func (UnimplementedConsoleServer) DeleteChatMute(context.Context, *DeleteChatMuteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChatMute not implemented")
+}
 func (UnimplementedConsoleServer) DeleteFriend(context.Context, *DeleteFriendRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriend not implemented")
 }
+func (UnimplementedConsoleServer) DeleteSession(context.Context, *DeleteSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
 func (UnimplementedConsoleServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
 }
@@ -1088,9 +1291,15 @@ func (UnimplementedConsoleServer) DeleteLeaderboard(context.Context, *Leaderboar
 func (UnimplementedConsoleServer) DeleteLeaderboardRecord(context.Context, *DeleteLeaderboardRecordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLeaderboardRecord not implemented")
 }
+func (UnimplementedConsoleServer) DeleteLockouts(context.Context, *LockoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLockouts not implemented")
+}
 func (UnimplementedConsoleServer) DeleteNotification(context.Context, *DeleteNotificationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
 }
+func (UnimplementedConsoleServer) DeleteScheduledNotification(context.Context, *DeleteScheduledNotificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledNotification not implemented")
+}
 func (UnimplementedConsoleServer) DeleteUser(context.Context, *Username) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
@@ -1115,6 +1324,9 @@ func (UnimplementedConsoleServer) GetConfig(context.Context, *emptypb.Empty) (*C
 func (UnimplementedConsoleServer) GetFriends(context.Context, *AccountId) (*api.FriendList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFriends not implemented")
 }
+func (UnimplementedConsoleServer) GetSessions(context.Context, *AccountId) (*AccountSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
 func (UnimplementedConsoleServer) GetGroup(context.Context, *GroupId) (*api.Group, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
 }
@@ -1172,12 +1384,27 @@ func (UnimplementedConsoleServer) ListAccounts(context.Context, *ListAccountsReq
 func (UnimplementedConsoleServer) ListChannelMessages(context.Context, *ListChannelMessagesRequest) (*api.ChannelMessageList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChannelMessages not implemented")
 }
+func (UnimplementedConsoleServer) ListChatModerationAudit(context.Context, *ListChatModerationAuditRequest) (*ChatModerationAuditList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChatModerationAudit not implemented")
+}
+func (UnimplementedConsoleServer) ListChatMutes(context.Context, *ListChatMutesRequest) (*ChatMuteList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChatMutes not implemented")
+}
+func (UnimplementedConsoleServer) ListGroupEvents(context.Context, *ListGroupEventsRequest) (*GroupEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupEvents not implemented")
+}
 func (UnimplementedConsoleServer) ListGroups(context.Context, *ListGroupsRequest) (*GroupList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
 }
+func (UnimplementedConsoleServer) ListLockouts(context.Context, *LockoutRequest) (*LockoutList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLockouts not implemented")
+}
 func (UnimplementedConsoleServer) ListNotifications(context.Context, *ListNotificationsRequest) (*NotificationList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
 }
+func (UnimplementedConsoleServer) ListScheduledNotifications(context.Context, *ListScheduledNotificationsRequest) (*ScheduledNotificationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledNotifications not implemented")
+}
 func (UnimplementedConsoleServer) ListMatches(context.Context, *ListMatchesRequest) (*MatchList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMatches not implemented")
 }
@@ -1190,15 +1417,24 @@ func (UnimplementedConsoleServer) ListSubscriptions(context.Context, *ListSubscr
 func (UnimplementedConsoleServer) ListUsers(context.Context, *emptypb.Empty) (*UserList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedConsoleServer) MergeAccounts(context.Context, *AccountMergeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAccounts not implemented")
+}
 func (UnimplementedConsoleServer) PromoteGroupMember(context.Context, *UpdateGroupUserStateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PromoteGroupMember not implemented")
 }
 func (UnimplementedConsoleServer) RequireUserMfa(context.Context, *RequireUserMfaRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequireUserMfa not implemented")
 }
+func (UnimplementedConsoleServer) ResetAccountMfa(context.Context, *AccountId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetAccountMfa not implemented")
+}
 func (UnimplementedConsoleServer) ResetUserMfa(context.Context, *ResetUserMfaRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetUserMfa not implemented")
 }
+func (UnimplementedConsoleServer) RestoreAccount(context.Context, *AccountId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
 func (UnimplementedConsoleServer) UnbanAccount(context.Context, *AccountId) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbanAccount not implemented")
 }
@@ -1323,6 +1559,24 @@ func _Console_AddUser_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_AddChatMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChatMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).AddChatMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_AddChatMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).AddChatMute(ctx, req.(*AddChatMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_AddGroupUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddGroupUsersRequest)
 	if err := dec(in); err != nil {
@@ -1449,6 +1703,24 @@ func _Console_DeleteChannelMessages_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_DeleteChatMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChatMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).DeleteChatMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_DeleteChatMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).DeleteChatMute(ctx, req.(*DeleteChatMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_DeleteFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteFriendRequest)
 	if err := dec(in); err != nil {
@@ -1467,6 +1739,24 @@ func _Console_DeleteFriend_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_DeleteSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteGroupRequest)
 	if err := dec(in); err != nil {
@@ -1593,6 +1883,24 @@ func _Console_DeleteLeaderboardRecord_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_DeleteLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).DeleteLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_DeleteLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).DeleteLockouts(ctx, req.(*LockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteNotificationRequest)
 	if err := dec(in); err != nil {
@@ -1611,6 +1919,24 @@ func _Console_DeleteNotification_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_DeleteScheduledNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).DeleteScheduledNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_DeleteScheduledNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).DeleteScheduledNotification(ctx, req.(*DeleteScheduledNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Username)
 	if err := dec(in); err != nil {
@@ -1755,6 +2081,24 @@ func _Console_GetFriends_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).GetSessions(ctx, req.(*AccountId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GroupId)
 	if err := dec(in); err != nil {
@@ -2097,6 +2441,60 @@ func _Console_ListChannelMessages_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_ListChatModerationAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatModerationAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ListChatModerationAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_ListChatModerationAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ListChatModerationAudit(ctx, req.(*ListChatModerationAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_ListChatMutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatMutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ListChatMutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_ListChatMutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ListChatMutes(ctx, req.(*ListChatMutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_ListGroupEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ListGroupEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_ListGroupEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ListGroupEvents(ctx, req.(*ListGroupEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListGroupsRequest)
 	if err := dec(in); err != nil {
@@ -2115,6 +2513,24 @@ func _Console_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_ListLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ListLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_ListLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ListLockouts(ctx, req.(*LockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -2133,6 +2549,24 @@ func _Console_ListNotifications_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_ListScheduledNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ListScheduledNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_ListScheduledNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ListScheduledNotifications(ctx, req.(*ListScheduledNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMatchesRequest)
 	if err := dec(in); err != nil {
@@ -2205,6 +2639,24 @@ func _Console_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_MergeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).MergeAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_MergeAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).MergeAccounts(ctx, req.(*AccountMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_PromoteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateGroupUserStateRequest)
 	if err := dec(in); err != nil {
@@ -2241,6 +2693,24 @@ func _Console_RequireUserMfa_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_ResetAccountMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ResetAccountMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_ResetAccountMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ResetAccountMfa(ctx, req.(*AccountId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_ResetUserMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResetUserMfaRequest)
 	if err := dec(in); err != nil {
@@ -2259,6 +2729,24 @@ func _Console_ResetUserMfa_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Console_RestoreAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).RestoreAccount(ctx, req.(*AccountId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_UnbanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountId)
 	if err := dec(in); err != nil {
@@ -2516,6 +3004,10 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddUser",
 			Handler:    _Console_AddUser_Handler,
 		},
+		{
+			MethodName: "AddChatMute",
+			Handler:    _Console_AddChatMute_Handler,
+		},
 		{
 			MethodName: "AddGroupUsers",
 			Handler:    _Console_AddGroupUsers_Handler,
@@ -2544,10 +3036,18 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChannelMessages",
 			Handler:    _Console_DeleteChannelMessages_Handler,
 		},
+		{
+			MethodName: "DeleteChatMute",
+			Handler:    _Console_DeleteChatMute_Handler,
+		},
 		{
 			MethodName: "DeleteFriend",
 			Handler:    _Console_DeleteFriend_Handler,
 		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _Console_DeleteSession_Handler,
+		},
 		{
 			MethodName: "DeleteGroup",
 			Handler:    _Console_DeleteGroup_Handler,
@@ -2576,10 +3076,18 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLeaderboardRecord",
 			Handler:    _Console_DeleteLeaderboardRecord_Handler,
 		},
+		{
+			MethodName: "DeleteLockouts",
+			Handler:    _Console_DeleteLockouts_Handler,
+		},
 		{
 			MethodName: "DeleteNotification",
 			Handler:    _Console_DeleteNotification_Handler,
 		},
+		{
+			MethodName: "DeleteScheduledNotification",
+			Handler:    _Console_DeleteScheduledNotification_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _Console_DeleteUser_Handler,
@@ -2612,6 +3120,10 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFriends",
 			Handler:    _Console_GetFriends_Handler,
 		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _Console_GetSessions_Handler,
+		},
 		{
 			MethodName: "GetGroup",
 			Handler:    _Console_GetGroup_Handler,
@@ -2688,14 +3200,34 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListChannelMessages",
 			Handler:    _Console_ListChannelMessages_Handler,
 		},
+		{
+			MethodName: "ListChatModerationAudit",
+			Handler:    _Console_ListChatModerationAudit_Handler,
+		},
+		{
+			MethodName: "ListChatMutes",
+			Handler:    _Console_ListChatMutes_Handler,
+		},
+		{
+			MethodName: "ListGroupEvents",
+			Handler:    _Console_ListGroupEvents_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _Console_ListGroups_Handler,
 		},
+		{
+			MethodName: "ListLockouts",
+			Handler:    _Console_ListLockouts_Handler,
+		},
 		{
 			MethodName: "ListNotifications",
 			Handler:    _Console_ListNotifications_Handler,
 		},
+		{
+			MethodName: "ListScheduledNotifications",
+			Handler:    _Console_ListScheduledNotifications_Handler,
+		},
 		{
 			MethodName: "ListMatches",
 			Handler:    _Console_ListMatches_Handler,
@@ -2712,6 +3244,10 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _Console_ListUsers_Handler,
 		},
+		{
+			MethodName: "MergeAccounts",
+			Handler:    _Console_MergeAccounts_Handler,
+		},
 		{
 			MethodName: "PromoteGroupMember",
 			Handler:    _Console_PromoteGroupMember_Handler,
@@ -2720,10 +3256,18 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequireUserMfa",
 			Handler:    _Console_RequireUserMfa_Handler,
 		},
+		{
+			MethodName: "ResetAccountMfa",
+			Handler:    _Console_ResetAccountMfa_Handler,
+		},
 		{
 			MethodName: "ResetUserMfa",
 			Handler:    _Console_ResetUserMfa_Handler,
 		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _Console_RestoreAccount_Handler,
+		},
 		{
 			MethodName: "UnbanAccount",
 			Handler:    _Console_UnbanAccount_Handler,
@@ -33,6 +33,7 @@ export class AccountComponent implements OnInit {
   public views = [
     {label: 'Profile', path: 'profile'},
     {label: 'Authentication', path: 'authentication'},
+    {label: 'Sessions', path: 'sessions'},
     {label: 'Friends', path: 'friends'},
     {label: 'Groups', path: 'groups'},
     {label: 'Notifications', path: 'notifications'},
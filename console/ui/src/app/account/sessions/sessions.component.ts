@@ -0,0 +1,90 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {Component, Injectable, OnInit} from '@angular/core';
+import {
+  AccountSession, AccountSessionList, ApiAccount,
+  ConsoleService,
+  UserRole,
+} from '../../console.service';
+import {ActivatedRoute, ActivatedRouteSnapshot, Resolve, RouterStateSnapshot} from '@angular/router';
+import {AuthenticationService} from '../../authentication.service';
+import {Observable} from 'rxjs';
+import {DeleteConfirmService} from '../../shared/delete-confirm.service';
+
+@Component({
+  templateUrl: './sessions.component.html',
+  styleUrls: ['./sessions.component.scss']
+})
+export class SessionsComponent implements OnInit {
+  public error = '';
+  public account: ApiAccount;
+  public sessions: Array<AccountSession> = [];
+
+  constructor(
+    private readonly route: ActivatedRoute,
+    private readonly consoleService: ConsoleService,
+    private readonly authService: AuthenticationService,
+    private readonly deleteConfirmService: DeleteConfirmService,
+  ) {}
+
+  ngOnInit(): void {
+    this.route.data.subscribe(
+      d => {
+        this.sessions.length = 0;
+        this.sessions.push(...(d[0].sessions || []));
+      },
+      err => {
+        this.error = err;
+      });
+
+    this.route.parent.data.subscribe(
+      d => {
+        this.account = d[0].account;
+      },
+      err => {
+        this.error = err;
+      });
+  }
+
+  deleteAllowed(): boolean {
+    return this.authService.sessionRole <= UserRole.USER_ROLE_MAINTAINER;
+  }
+
+  deleteSession(event, i: number, s: AccountSession): void {
+    this.deleteConfirmService.openDeleteConfirmModal(
+      () => {
+        event.target.disabled = true;
+        event.preventDefault();
+        this.error = '';
+        this.consoleService.deleteSession('', this.account.user.id, s.id).subscribe(() => {
+          this.error = '';
+          this.sessions.splice(i, 1);
+        }, err => {
+          this.error = err;
+        });
+      }
+    );
+  }
+}
+
+@Injectable({providedIn: 'root'})
+export class SessionsResolver implements Resolve<AccountSessionList> {
+  constructor(private readonly consoleService: ConsoleService) {}
+
+  resolve(route: ActivatedRouteSnapshot, state: RouterStateSnapshot): Observable<AccountSessionList> {
+    const userId = route.parent.paramMap.get('id');
+    return this.consoleService.getSessions('', userId);
+  }
+}
@@ -30,6 +30,7 @@ import {ProfileComponent} from './account/profile/profile.component';
 import {AuthenticationComponent} from './account/authentication/authentication.component';
 import {WalletComponent, WalletLedgerResolver} from './account/wallet/wallet.component';
 import {FriendsComponent, FriendsResolver} from './account/friends/friends.component';
+import {SessionsComponent, SessionsResolver} from './account/sessions/sessions.component';
 import {GroupsComponent, GroupsResolver} from './account/groups/groups.component';
 import {GroupDetailsComponent} from './group/details/groupDetailsComponent';
 import {GroupMembersComponent, GroupMembersResolver} from './group/members/groupMembers.component';
@@ -88,6 +89,7 @@ const routes: Routes = [
           {path: '', redirectTo: 'profile', pathMatch: 'full'},
           {path: 'profile', component: ProfileComponent, resolve: []},
           {path: 'authentication', component: AuthenticationComponent, resolve: []},
+          {path: 'sessions', component: SessionsComponent, resolve: [SessionsResolver]},
           {path: 'wallet', component: WalletComponent, resolve: [WalletLedgerResolver]},
           {path: 'friends', component: FriendsComponent, resolve: [FriendsResolver]},
           {path: 'groups', component: GroupsComponent, resolve: [GroupsResolver]},
@@ -48,6 +48,7 @@ import {ProfileComponent} from './account/profile/profile.component';
 import {GroupDetailsComponent} from './group/details/groupDetailsComponent';
 import {AuthenticationComponent} from './account/authentication/authentication.component';
 import {FriendsComponent} from './account/friends/friends.component';
+import {SessionsComponent} from './account/sessions/sessions.component';
 import {WalletComponent} from './account/wallet/wallet.component';
 import {GroupsComponent} from './account/groups/groups.component';
 import {GroupMembersComponent} from './group/members/groupMembers.component';
@@ -86,6 +87,7 @@ import {QRCodeModule} from 'angularx-qrcode';
     AuthenticationComponent,
     WalletComponent,
     FriendsComponent,
+    SessionsComponent,
     GroupsComponent,
     GroupComponent,
     GroupDetailsComponent,
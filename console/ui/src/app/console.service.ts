@@ -9,6 +9,8 @@ import { Observable } from 'rxjs';
 export interface Account {
   // The user's account details.
   account?:ApiAccount
+  // The UNIX time when the account will be permanently deleted, if its deletion is scheduled.
+  delete_time?:string
   // The UNIX time when the account was disabled.
   disable_time?:string
 }
@@ -43,6 +45,57 @@ export interface AccountList {
   users?:Array<ApiUser>
 }
 
+/** Merge one account into another. */
+export interface AccountMergeRequest {
+  // The account to merge, deleted once merged.
+  source_id?:string
+  // How to handle storage objects with the same collection and key in both accounts.
+  storage_conflict?:AccountMergeRequestStorageConflict
+  // The account to merge into.
+  target_id?:string
+}
+
+/** How to handle storage objects both accounts own. */
+export enum AccountMergeRequestStorageConflict {
+  KEEP_TARGET = 0,
+  KEEP_SOURCE = 1,
+  FAIL = 2,
+}
+
+/** A session and refresh token pair issued to a user that has neither expired nor been revoked. */
+export interface AccountSession {
+  // How the user authenticated, for example 'device' or 'email', or 'runtime' for tokens issued by the server runtime.
+  auth_method?:string
+  // The client IP address the session was created or last refreshed from.
+  client_ip?:string
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the session was created.
+  create_time?:string
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the session can no longer be used or refreshed.
+  expire_time?:string
+  // The ID shared by the session's tokens.
+  id?:string
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the session was last refreshed, if ever.
+  refresh_time?:string
+  // The user agent of the client the session was created or last refreshed from.
+  user_agent?:string
+}
+
+/** A list of a user's active sessions. */
+export interface AccountSessionList {
+  // Active sessions, most recently created first.
+  sessions?:Array<AccountSession>
+}
+
+/** Mute a user in chat. */
+export interface AddChatMuteRequest {
+  // Number of seconds the mute lasts, 0 for a mute that does not expire.
+  duration_sec?:number
+  // Reason for the mute, visible to console users.
+  reason?:string
+  // User ID to mute.
+  user_id?:string
+}
+
 export interface AddGroupUsersRequest {
   // Users to add/join.
   ids?:string
@@ -122,6 +175,54 @@ export interface CallRpcEndpointRequest {
   user_id?:string
 }
 
+/** A chat message acted on by chat moderation. */
+export interface ChatModerationAuditEntry {
+  // Action taken on the message: 'mask', 'reject', 'shadow' or 'muted'.
+  action?:string
+  // Channel the message was sent to.
+  channel_id?:string
+  // Original content of the message, before any masking.
+  content?:string
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the message was moderated.
+  create_time?:string
+  // ID of the audit entry.
+  id?:string
+  // Filtered words found in the message.
+  matched_words?:Array<string>
+  // ID of the moderated message.
+  message_id?:string
+  // ID of the user who sent the message.
+  user_id?:string
+}
+
+/** A list of chat moderation audit entries. */
+export interface ChatModerationAuditList {
+  // List of audit entries.
+  entries?:Array<ChatModerationAuditEntry>
+  // Next page cursor if any.
+  next_cursor?:string
+}
+
+/** A user muted in chat. */
+export interface ChatMute {
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user was muted.
+  create_time?:string
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the mute expires, if it expires.
+  expiry_time?:string
+  // Reason for the mute.
+  reason?:string
+  // ID of the muted user.
+  user_id?:string
+}
+
+/** A list of users muted in chat. */
+export interface ChatMuteList {
+  // List of mutes.
+  mutes?:Array<ChatMute>
+  // Next page cursor if any.
+  next_cursor?:string
+}
+
 /** The current server configuration and any associated warnings. */
 export interface Config {
   // JSON-encoded active server configuration.
@@ -153,6 +254,32 @@ export interface DeleteChannelMessagesResponse {
   total?:string
 }
 
+/** An action taken in a group. */
+export interface GroupEvent {
+  // ID of the user who performed the action, empty if it was performed by the server.
+  actor_id?:string
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the event occurred.
+  create_time?:string
+  // Additional event details as a JSON object.
+  data?:string
+  // ID of the group the event belongs to.
+  group_id?:string
+  // ID of the event.
+  id?:string
+  // ID of the user the action was performed on, empty if it applies to the group itself.
+  target_id?:string
+  // Type of the event: 'join', 'join_request', 'leave', 'add', 'kick', 'ban', 'promote', 'demote' or 'update'.
+  type?:string
+}
+
+/** A list of group events. */
+export interface GroupEventList {
+  // List of events.
+  events?:Array<GroupEvent>
+  // Next page cursor if any.
+  next_cursor?:string
+}
+
 /** An export of all information stored for a group. */
 export interface GroupExport {
   // The group details.
@@ -244,6 +371,29 @@ export enum ListChannelMessagesRequestType {
   DIRECT = 3,
 }
 
+/** An account or IP address locked out after repeated failed login attempts. */
+export interface Lockout {
+  // The account or IP address locked out.
+  key?:string
+  // When the lockout ends.
+  locked_until?:string
+  // Whether an account or an IP address is locked out.
+  type?:LockoutType
+}
+
+/** A list of lockouts. */
+export interface LockoutList {
+  // Active lockouts.
+  lockouts?:Array<Lockout>
+}
+
+/** What is locked out. */
+export enum LockoutType {
+  UNKNOWN = 0,
+  ACCOUNT = 1,
+  IP = 2,
+}
+
 /** A list of realtime matches, with their node names. */
 export interface MatchList {
   matches?:Array<MatchListMatch>
@@ -320,6 +470,38 @@ export interface RuntimeInfoModuleInfo {
   path?:string
 }
 
+/** A notification waiting to be sent at a future time. */
+export interface ScheduledNotification {
+  // Category code for this notification.
+  code?:number
+  // Content of the notification in JSON.
+  content?:string
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the notification was scheduled.
+  create_time?:string
+  // Number of seconds after sending that the notification expires, 0 if it never expires.
+  expiry_sec?:number
+  // ID of the scheduled notification, also used for the notification once sent.
+  id?:string
+  // True if this notification will be persisted to the database.
+  persistent?:boolean
+  // The UNIX time (for gRPC clients) or ISO string (for REST clients) when the notification will be sent.
+  send_time?:string
+  // ID of the sender, if a user. Otherwise 'null'.
+  sender_id?:string
+  // Subject of the notification.
+  subject?:string
+  // User id.
+  user_id?:string
+}
+
+/** A list of scheduled notifications. */
+export interface ScheduledNotificationList {
+  // Next page cursor if any.
+  next_cursor?:string
+  // List of scheduled notifications.
+  notifications?:Array<ScheduledNotification>
+}
+
 export enum StatusHealth {
   STATUS_HEALTH_OK = 0,
   STATUS_HEALTH_ERROR = 1,
@@ -950,6 +1132,13 @@ export class ConsoleService {
     return this.httpClient.get<AccountList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** Merge a duplicate account into another, moving everything it owns and deleting it. */
+  mergeAccounts(auth_token: string, body: AccountMergeRequest): Observable<any> {
+    const urlPath = `/v2/console/account/merge`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.post(this.config.host + urlPath, body, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Get a list of the user's wallet transactions. */
   getWalletLedger(auth_token: string, account_id: string, limit?: number, cursor?: string): Observable<WalletLedgerList> {
     account_id = encodeURIComponent(String(account_id))
@@ -1041,6 +1230,39 @@ export class ConsoleService {
     return this.httpClient.delete(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** Reset an account's multi-factor authentication, so the player can sign in with only their password. */
+  resetAccountMfa(auth_token: string, id: string): Observable<any> {
+    id = encodeURIComponent(String(id))
+    const urlPath = `/v2/console/account/${id}/mfa/reset`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.post(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Restore an account scheduled for deletion, cancelling its deletion. */
+  restoreAccount(auth_token: string, id: string): Observable<any> {
+    id = encodeURIComponent(String(id))
+    const urlPath = `/v2/console/account/${id}/restore`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.post(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Get a user's active sessions. */
+  getSessions(auth_token: string, id: string): Observable<AccountSessionList> {
+    id = encodeURIComponent(String(id))
+    const urlPath = `/v2/console/account/${id}/session`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.get<AccountSessionList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Log out one of a user's active sessions. */
+  deleteSession(auth_token: string, id: string, session_id: string): Observable<any> {
+    id = encodeURIComponent(String(id))
+    session_id = encodeURIComponent(String(session_id))
+    const urlPath = `/v2/console/account/${id}/session/${session_id}`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.delete(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Unban a user. */
   unbanAccount(auth_token: string, id: string): Observable<any> {
     id = encodeURIComponent(String(id))
@@ -1182,7 +1404,7 @@ export class ConsoleService {
   }
 
   /** List channel messages with the selected filter */
-  listChannelMessages(auth_token: string, type?: string, label?: string, group_id?: string, user_id_one?: string, user_id_two?: string, cursor?: string): Observable<ApiChannelMessageList> {
+  listChannelMessages(auth_token: string, type?: string, label?: string, group_id?: string, user_id_one?: string, user_id_two?: string, cursor?: string, query?: string, sender_id?: string, start_time?: string, end_time?: string): Observable<ApiChannelMessageList> {
     const urlPath = `/v2/console/channel`;
     let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
     if (type) {
@@ -1203,9 +1425,65 @@ export class ConsoleService {
     if (cursor) {
       params = params.set('cursor', cursor);
     }
+    if (query) {
+      params = params.set('query', query);
+    }
+    if (sender_id) {
+      params = params.set('sender_id', sender_id);
+    }
+    if (start_time) {
+      params = params.set('start_time', start_time);
+    }
+    if (end_time) {
+      params = params.set('end_time', end_time);
+    }
     return this.httpClient.get<ApiChannelMessageList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** List the chat moderation audit trail, most recent first. */
+  listChatModerationAudit(auth_token: string, user_id?: string, limit?: number, cursor?: string): Observable<ChatModerationAuditList> {
+    const urlPath = `/v2/console/chat/moderation`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    if (user_id) {
+      params = params.set('user_id', user_id);
+    }
+    if (limit) {
+      params = params.set('limit', String(limit));
+    }
+    if (cursor) {
+      params = params.set('cursor', cursor);
+    }
+    return this.httpClient.get<ChatModerationAuditList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** List users currently muted in chat. */
+  listChatMutes(auth_token: string, limit?: number, cursor?: string): Observable<ChatMuteList> {
+    const urlPath = `/v2/console/chat/mute`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    if (limit) {
+      params = params.set('limit', String(limit));
+    }
+    if (cursor) {
+      params = params.set('cursor', cursor);
+    }
+    return this.httpClient.get<ChatMuteList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Mute a user in chat, rejecting their chat messages until the mute expires or is removed. */
+  addChatMute(auth_token: string, body: AddChatMuteRequest): Observable<any> {
+    const urlPath = `/v2/console/chat/mute`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.post(this.config.host + urlPath, body, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Remove a user's chat mute. */
+  deleteChatMute(auth_token: string, user_id: string): Observable<any> {
+    user_id = encodeURIComponent(String(user_id))
+    const urlPath = `/v2/console/chat/mute/${user_id}`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.delete(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Get server config and configuration warnings. */
   getConfig(auth_token: string): Observable<Config> {
     const urlPath = `/v2/console/config`;
@@ -1244,7 +1522,7 @@ export class ConsoleService {
     return this.httpClient.post(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
-  /** Add/join members to a group. */
+  /**  */
   addGroupUsers(auth_token: string, group_id: string, body: AddGroupUsersRequest): Observable<any> {
     group_id = encodeURIComponent(String(group_id))
     const urlPath = `/v2/console/group/${group_id}/add`;
@@ -1252,6 +1530,20 @@ export class ConsoleService {
     return this.httpClient.post(this.config.host + urlPath, body, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** List the activity feed of a group, most recent first. */
+  listGroupEvents(auth_token: string, group_id: string, limit?: number, cursor?: string): Observable<GroupEventList> {
+    group_id = encodeURIComponent(String(group_id))
+    const urlPath = `/v2/console/group/${group_id}/event`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    if (limit) {
+      params = params.set('limit', String(limit));
+    }
+    if (cursor) {
+      params = params.set('cursor', cursor);
+    }
+    return this.httpClient.get<GroupEventList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Remove a group. */
   deleteGroup(auth_token: string, id: string): Observable<any> {
     id = encodeURIComponent(String(id))
@@ -1363,6 +1655,32 @@ export class ConsoleService {
     return this.httpClient.get<ApiLeaderboardRecordList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** Clear lockouts from failed login attempts for an account, an IP address, or both. */
+  deleteLockouts(auth_token: string, account?: string, ip?: string): Observable<any> {
+    const urlPath = `/v2/console/lockout`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    if (account) {
+      params = params.set('account', account);
+    }
+    if (ip) {
+      params = params.set('ip', ip);
+    }
+    return this.httpClient.delete(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** List active lockouts from failed login attempts, optionally only those for an account or IP address. */
+  listLockouts(auth_token: string, account?: string, ip?: string): Observable<LockoutList> {
+    const urlPath = `/v2/console/lockout`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    if (account) {
+      params = params.set('account', account);
+    }
+    if (ip) {
+      params = params.set('ip', ip);
+    }
+    return this.httpClient.get<LockoutList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** List ongoing matches */
   listMatches(auth_token: string, limit?: number, authoritative?: boolean, label?: string, min_size?: number, max_size?: number, match_id?: string, query?: string, node?: string): Observable<MatchList> {
     const urlPath = `/v2/console/match`;
@@ -1470,6 +1788,30 @@ export class ConsoleService {
     return this.httpClient.get<RuntimeInfo>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** List scheduled notifications waiting to be sent. */
+  listScheduledNotifications(auth_token: string, user_id?: string, limit?: number, cursor?: string): Observable<ScheduledNotificationList> {
+    const urlPath = `/v2/console/scheduled_notification`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    if (user_id) {
+      params = params.set('user_id', user_id);
+    }
+    if (limit) {
+      params = params.set('limit', String(limit));
+    }
+    if (cursor) {
+      params = params.set('cursor', cursor);
+    }
+    return this.httpClient.get<ScheduledNotificationList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Cancel a scheduled notification that has not been sent yet. */
+  deleteScheduledNotification(auth_token: string, id: string): Observable<any> {
+    id = encodeURIComponent(String(id))
+    const urlPath = `/v2/console/scheduled_notification/${id}`;
+    let params = new HttpParams({ encoder: new CustomHttpParamEncoder() });
+    return this.httpClient.delete(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Get current status data for all nodes. */
   getStatus(auth_token: string): Observable<StatusList> {
     const urlPath = `/v2/console/status`;
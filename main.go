@@ -176,16 +176,29 @@ func main() {
 	// Start up server components.
 	metrics := server.NewLocalMetrics(logger, startupLogger, db, config)
 	sessionRegistry := server.NewLocalSessionRegistry(metrics)
-	sessionCache := server.NewLocalSessionCache(config.GetSession().TokenExpirySec, config.GetSession().RefreshTokenExpirySec)
+	var sessionCache server.SessionCache
+	if config.GetSession().Cache == server.SessionCacheDatabase {
+		sessionCache, err = server.NewDatabaseSessionCache(ctx, logger, db, config.GetSession().TokenExpirySec, config.GetSession().RefreshTokenExpirySec)
+		if err != nil {
+			startupLogger.Fatal("Failed to load session cache", zap.Error(err))
+		}
+	} else {
+		sessionCache = server.NewLocalSessionCache(config.GetSession().TokenExpirySec, config.GetSession().RefreshTokenExpirySec)
+	}
 	consoleSessionCache := server.NewLocalSessionCache(config.GetConsole().TokenExpirySec, 0)
-	loginAttemptCache := server.NewLocalLoginAttemptCache()
 	statusRegistry := server.NewLocalStatusRegistry(logger, config, sessionRegistry, jsonpbMarshaler)
 	tracker := server.StartLocalTracker(logger, config, sessionRegistry, statusRegistry, metrics, jsonpbMarshaler)
-	router := server.NewLocalMessageRouter(sessionRegistry, tracker, jsonpbMarshaler)
+	pushNotifier := server.NewLocalPushNotifier(logger, db, config)
+	router := server.NewLocalMessageRouter(sessionRegistry, tracker, pushNotifier, jsonpbMarshaler)
 	leaderboardCache := server.NewLocalLeaderboardCache(ctx, logger, startupLogger, db)
 	leaderboardRankCache := server.NewLocalLeaderboardRankCache(ctx, startupLogger, db, config.GetLeaderboard(), leaderboardCache)
 	leaderboardScheduler := server.NewLocalLeaderboardScheduler(logger, db, config, leaderboardCache, leaderboardRankCache)
 	googleRefundScheduler := server.NewGoogleRefundScheduler(logger, db, config)
+	notificationExpiryScheduler := server.NewLocalNotificationExpiryScheduler(logger, db, config)
+	notificationScheduler := server.NewLocalNotificationScheduler(logger, db, config, tracker, router)
+	chatModerator := server.NewLocalChatModerator(logger, db, config)
+	channelRetentionScheduler := server.NewLocalChannelRetentionScheduler(logger, db, config)
+	friendInviteExpiryScheduler := server.NewLocalFriendInviteExpiryScheduler(logger, db, config)
 	matchRegistry := server.NewLocalMatchRegistry(logger, startupLogger, config, sessionRegistry, tracker, router, metrics, config.GetName())
 	tracker.SetMatchJoinListener(matchRegistry.Join)
 	tracker.SetMatchLeaveListener(matchRegistry.Leave)
@@ -196,10 +209,16 @@ func main() {
 	if err != nil {
 		logger.Fatal("Failed to initialize storage index", zap.Error(err))
 	}
-	runtime, runtimeInfo, err := server.NewRuntime(ctx, logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, storageIndex, fmCallbackHandler)
+	groupIndex, err := server.NewLocalGroupIndex(logger, db)
+	if err != nil {
+		logger.Fatal("Failed to initialize group index", zap.Error(err))
+	}
+	accountDeletionScheduler := server.NewLocalAccountDeletionScheduler(logger, db, config, leaderboardCache, leaderboardRankCache, groupIndex, sessionRegistry, sessionCache, tracker)
+	runtime, runtimeInfo, err := server.NewRuntime(ctx, logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, storageIndex, groupIndex, fmCallbackHandler)
 	if err != nil {
 		startupLogger.Fatal("Failed initializing runtime modules", zap.Error(err))
 	}
+	loginAttemptCache := server.NewLocalLoginAttemptCache(logger, db, config, version, runtime)
 	matchmaker := server.NewLocalMatchmaker(logger, startupLogger, config, router, metrics, runtime)
 	partyRegistry := server.NewLocalPartyRegistry(logger, config, matchmaker, tracker, streamManager, router, config.GetName())
 	tracker.SetPartyJoinListener(partyRegistry.Join)
@@ -211,19 +230,24 @@ func main() {
 			logger.Error("Failed to load storage index entries from database", zap.Error(err))
 		}
 	}()
+	go func() {
+		if err := groupIndex.Load(ctx); err != nil {
+			logger.Error("Failed to load group index entries from database", zap.Error(err))
+		}
+	}()
 
 	leaderboardScheduler.Start(runtime)
 	googleRefundScheduler.Start(runtime)
 
-	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, sessionRegistry, statusRegistry, matchRegistry, partyRegistry, matchmaker, tracker, router, runtime)
+	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, sessionRegistry, statusRegistry, matchRegistry, partyRegistry, matchmaker, tracker, router, chatModerator, runtime)
 	statusHandler := server.NewLocalStatusHandler(logger, sessionRegistry, matchRegistry, tracker, metrics, config.GetName())
 
 	telemetryEnabled := len(os.Getenv("NAKAMA_TELEMETRY")) < 1
 	console.UIFS.Nt = !telemetryEnabled
 	cookie := newOrLoadCookie(telemetryEnabled, config)
 
-	apiServer := server.StartApiServer(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, storageIndex, leaderboardCache, leaderboardRankCache, sessionRegistry, sessionCache, statusRegistry, matchRegistry, matchmaker, tracker, router, streamManager, metrics, pipeline, runtime)
-	consoleServer := server.StartConsoleServer(logger, startupLogger, db, config, tracker, router, streamManager, metrics, sessionRegistry, sessionCache, consoleSessionCache, loginAttemptCache, statusRegistry, statusHandler, runtimeInfo, matchRegistry, configWarnings, semver, leaderboardCache, leaderboardRankCache, leaderboardScheduler, storageIndex, apiServer, runtime, cookie)
+	apiServer := server.StartApiServer(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, storageIndex, groupIndex, leaderboardCache, leaderboardRankCache, sessionRegistry, sessionCache, statusRegistry, matchRegistry, matchmaker, tracker, router, chatModerator, streamManager, metrics, pipeline, runtime)
+	consoleServer := server.StartConsoleServer(logger, startupLogger, db, config, tracker, router, chatModerator, streamManager, metrics, sessionRegistry, sessionCache, consoleSessionCache, loginAttemptCache, statusRegistry, statusHandler, runtimeInfo, matchRegistry, configWarnings, semver, leaderboardCache, leaderboardRankCache, leaderboardScheduler, storageIndex, groupIndex, apiServer, runtime, cookie)
 
 	if telemetryEnabled {
 		const telemetryKey = "YU1bIKUhjQA9WC0O6ouIRIWTaPlJ5kFs"
@@ -253,6 +277,13 @@ func main() {
 	matchmaker.Stop()
 	leaderboardScheduler.Stop()
 	googleRefundScheduler.Stop()
+	notificationExpiryScheduler.Stop()
+	notificationScheduler.Stop()
+	chatModerator.Stop()
+	channelRetentionScheduler.Stop()
+	friendInviteExpiryScheduler.Stop()
+	accountDeletionScheduler.Stop()
+	pushNotifier.Stop()
 	tracker.Stop()
 	statusRegistry.Stop()
 	sessionCache.Stop()
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_push_device (
+    PRIMARY KEY (token),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    token       VARCHAR(512) NOT NULL,
+    user_id     UUID         NOT NULL,
+    -- fcm(0), apns(1)
+    platform    SMALLINT     NOT NULL DEFAULT 0,
+    create_time TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    update_time TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS user_push_device_user_id_update_time_idx ON user_push_device (user_id, update_time DESC);
+
+CREATE TABLE IF NOT EXISTS user_push_preference (
+    PRIMARY KEY (user_id, code),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id UUID     NOT NULL,
+    -- Notification code the user has opted out of receiving as a push notification.
+    code    SMALLINT NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_push_preference;
+DROP TABLE IF EXISTS user_push_device;
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS notification_schedule (
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    id          UUID         NOT NULL,
+    user_id     UUID         NOT NULL,
+    subject     VARCHAR(255) NOT NULL,
+    content     JSONB        NOT NULL DEFAULT '{}',
+    code        SMALLINT     NOT NULL, -- Negative values are system reserved.
+    sender_id   UUID         NOT NULL,
+    persistent  BOOLEAN      NOT NULL DEFAULT FALSE,
+    expiry_sec  INT          NOT NULL DEFAULT 0 CHECK (expiry_sec >= 0), -- 0 means sent notifications never expire.
+    send_time   TIMESTAMPTZ  NOT NULL,
+    create_time TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS notification_schedule_send_time_id_idx ON notification_schedule (send_time, id);
+CREATE INDEX IF NOT EXISTS notification_schedule_user_id_send_time_id_idx ON notification_schedule (user_id, send_time, id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notification_schedule;
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS chat_mute (
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id     UUID         NOT NULL,
+    reason      VARCHAR(255) NOT NULL DEFAULT '',
+    create_time TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    -- NULL for mutes that do not expire.
+    expiry_time TIMESTAMPTZ  DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS chat_moderation_audit (
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    id            UUID         NOT NULL,
+    user_id       UUID         NOT NULL,
+    channel_id    VARCHAR(512) NOT NULL,
+    message_id    UUID         NOT NULL,
+    -- One of: mask, reject, shadow, muted.
+    action        VARCHAR(16)  NOT NULL,
+    content       TEXT         NOT NULL,
+    matched_words TEXT[]       NOT NULL DEFAULT '{}',
+    create_time   TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS chat_moderation_audit_create_time_id_idx ON chat_moderation_audit (create_time DESC, id DESC);
+CREATE INDEX IF NOT EXISTS chat_moderation_audit_user_id_create_time_id_idx ON chat_moderation_audit (user_id, create_time DESC, id DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS chat_moderation_audit;
+
+DROP TABLE IF EXISTS chat_mute;
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+-- Both PostgreSQL and CockroachDB support full-text search on a stored tsvector column, but not a shared function to
+-- index only the string values of JSON, so the whole content text is indexed.
+ALTER TABLE message
+    ADD COLUMN IF NOT EXISTS content_search TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content::TEXT)) STORED;
+
+CREATE INDEX IF NOT EXISTS message_content_search_idx ON message USING GIN (content_search);
+
+-- +migrate Down
+DROP INDEX IF EXISTS message_content_search_idx;
+
+ALTER TABLE message
+    DROP COLUMN IF EXISTS content_search;
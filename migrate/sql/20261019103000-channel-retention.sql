@@ -0,0 +1,33 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS channel_retention (
+    PRIMARY KEY (stream_mode, stream_subject, stream_descriptor, stream_label),
+
+    stream_mode       SMALLINT     NOT NULL,
+    stream_subject    UUID         NOT NULL,
+    stream_descriptor UUID         NOT NULL,
+    stream_label      VARCHAR(128) NOT NULL,
+    -- 0 keeps messages regardless of age or count.
+    max_age_sec       INTEGER      NOT NULL DEFAULT 0 CHECK (max_age_sec >= 0),
+    max_messages      INTEGER      NOT NULL DEFAULT 0 CHECK (max_messages >= 0),
+    update_time       TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS channel_retention;
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS group_role (
+    PRIMARY KEY (group_id, name),
+    FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE,
+
+    group_id    UUID        NOT NULL,
+    name        VARCHAR(64) NOT NULL CHECK (length(name) > 0),
+    -- Bitmask of GroupPermission values.
+    permissions INTEGER     NOT NULL DEFAULT 0 CHECK (permissions >= 0),
+    create_time TIMESTAMPTZ NOT NULL DEFAULT now(),
+    update_time TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS group_user_role (
+    PRIMARY KEY (group_id, user_id),
+    FOREIGN KEY (group_id, role) REFERENCES group_role (group_id, name) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    group_id    UUID        NOT NULL,
+    user_id     UUID        NOT NULL,
+    role        VARCHAR(64) NOT NULL,
+    create_time TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS group_user_role;
+DROP TABLE IF EXISTS group_role;
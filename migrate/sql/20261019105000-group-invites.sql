@@ -0,0 +1,29 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+-- Pending invites are stored as group_edge rows with state invited(5).
+ALTER TABLE group_edge
+    ADD COLUMN IF NOT EXISTS inviter_id         UUID,
+    ADD COLUMN IF NOT EXISTS invite_expire_time TIMESTAMPTZ;
+
+-- +migrate Down
+DELETE FROM group_edge WHERE state = 5;
+
+ALTER TABLE group_edge
+    DROP COLUMN IF EXISTS invite_expire_time,
+    DROP COLUMN IF EXISTS inviter_id;
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS group_event (
+    PRIMARY KEY (id),
+    FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE,
+
+    id          UUID        NOT NULL,
+    group_id    UUID        NOT NULL,
+    -- One of: join, join_request, leave, add, kick, ban, promote, demote, update.
+    type        VARCHAR(16) NOT NULL,
+    -- Users are not foreign keys so the history outlives deleted accounts. Nil UUID when not applicable.
+    actor_id    UUID        NOT NULL,
+    target_id   UUID        NOT NULL,
+    data        JSONB       NOT NULL DEFAULT '{}',
+    create_time TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS group_event_group_id_create_time_id_idx ON group_event (group_id, create_time DESC, id DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS group_event;
@@ -0,0 +1,23 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+-- Pending friend invites expire based on when the sent invite edge was created.
+CREATE INDEX IF NOT EXISTS user_edge_invite_sent_update_time_idx ON user_edge (update_time) WHERE state = 1;
+
+-- +migrate Down
+DROP INDEX IF EXISTS user_edge_invite_sent_update_time_idx;
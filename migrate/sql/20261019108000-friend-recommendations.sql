@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS friend_recommendation_signal (
+    PRIMARY KEY (user_id, candidate_id, source),
+    FOREIGN KEY (user_id)      REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (candidate_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id      UUID             NOT NULL,
+    candidate_id UUID             NOT NULL,
+    -- Recent match co-participation is recorded by the server as 'match', other sources are set by the runtime.
+    source       VARCHAR(32)      NOT NULL,
+    weight       DOUBLE PRECISION NOT NULL DEFAULT 0,
+    expiry_time  TIMESTAMPTZ      DEFAULT NULL,
+    update_time  TIMESTAMPTZ      NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS friend_recommendation_cache (
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id     UUID        NOT NULL,
+    candidates  JSONB       NOT NULL DEFAULT '[]',
+    expiry_time TIMESTAMPTZ NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS friend_recommendation_cache;
+DROP TABLE IF EXISTS friend_recommendation_signal;
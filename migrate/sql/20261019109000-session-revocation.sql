@@ -0,0 +1,42 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+-- Rows are kept only until the tokens they refer to would have expired on their own, and are not tied to the
+-- users table so revocations recorded for a user remain in effect while their account is being removed.
+CREATE TABLE IF NOT EXISTS session_revoked_token (
+    PRIMARY KEY (user_id, token_id, refresh),
+
+    user_id     UUID         NOT NULL,
+    token_id    VARCHAR(128) NOT NULL,
+    refresh     BOOLEAN      NOT NULL DEFAULT FALSE,
+    expiry_time TIMESTAMPTZ  NOT NULL
+);
+CREATE INDEX IF NOT EXISTS session_revoked_token_expiry_time_idx ON session_revoked_token (expiry_time);
+
+CREATE TABLE IF NOT EXISTS session_user_invalidation (
+    PRIMARY KEY (user_id),
+
+    user_id           UUID        NOT NULL,
+    invalidation_time TIMESTAMPTZ NOT NULL,
+    expiry_time       TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS session_user_invalidation_expiry_time_idx ON session_user_invalidation (expiry_time);
+
+-- +migrate Down
+DROP TABLE IF EXISTS session_user_invalidation;
+DROP TABLE IF EXISTS session_revoked_token;
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS session_active (
+    PRIMARY KEY (user_id, token_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id             UUID         NOT NULL,
+    -- Shared by the session token and refresh token issued together.
+    token_id            VARCHAR(128) NOT NULL,
+    auth_method         VARCHAR(32)  NOT NULL DEFAULT '',
+    client_ip           VARCHAR(64)  NOT NULL DEFAULT '',
+    user_agent          VARCHAR(256) NOT NULL DEFAULT '',
+    create_time         TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    refresh_time        TIMESTAMPTZ  NOT NULL DEFAULT '1970-01-01 00:00:00 UTC',
+    session_expiry_time TIMESTAMPTZ  NOT NULL,
+    refresh_expiry_time TIMESTAMPTZ  NOT NULL DEFAULT '1970-01-01 00:00:00 UTC',
+    -- The later of the two token expiries, after which the session is no longer active.
+    expiry_time         TIMESTAMPTZ  NOT NULL
+);
+CREATE INDEX IF NOT EXISTS session_active_expiry_time_idx ON session_active (expiry_time);
+
+-- +migrate Down
+DROP TABLE IF EXISTS session_active;
@@ -0,0 +1,23 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+-- The ID of the most recently issued refresh token of a session when refresh tokens are rotated.
+ALTER TABLE session_active ADD COLUMN IF NOT EXISTS refresh_id VARCHAR(128) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE session_active DROP COLUMN IF EXISTS refresh_id;
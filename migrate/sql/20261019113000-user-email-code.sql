@@ -0,0 +1,37 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+
+-- +migrate Up
+-- Outstanding email verification and password reset codes, at most one of each kind per user.
+CREATE TABLE IF NOT EXISTS user_email_code (
+    PRIMARY KEY (user_id, purpose),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    UNIQUE (code_hash),
+
+    user_id     UUID         NOT NULL,
+    purpose     VARCHAR(32)  NOT NULL,
+    -- Keyed hash of the code, the code itself is only ever sent to the user.
+    code_hash   BYTEA        NOT NULL,
+    -- The address the code was sent to, the code can only be used while the account still has this address.
+    email       VARCHAR(255) NOT NULL,
+    create_time TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    expiry_time TIMESTAMPTZ  NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_email_code;
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+
+-- +migrate Up
+-- Both columns are encrypted with the MFA storage encryption key, and set once a user confirms MFA enrollment.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS mfa_secret         BYTEA DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS mfa_recovery_codes BYTEA DEFAULT NULL,
+    -- Time steps of recently accepted codes, so a code can't be replayed while it is still valid.
+    ADD COLUMN IF NOT EXISTS mfa_used_counters  VARCHAR(255) NOT NULL DEFAULT '';
+
+-- MFA challenges exchanged for a session, kept until they expire so each one can only be used once.
+CREATE TABLE IF NOT EXISTS user_mfa_challenge (
+    PRIMARY KEY (user_id, token_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id     UUID        NOT NULL,
+    token_id    UUID        NOT NULL,
+    expire_time TIMESTAMPTZ NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_mfa_challenge;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS mfa_secret,
+    DROP COLUMN IF EXISTS mfa_recovery_codes,
+    DROP COLUMN IF EXISTS mfa_used_counters;
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+
+-- +migrate Up
+-- Accounts disabled pending permanent deletion once their grace period passes.
+CREATE TABLE IF NOT EXISTS user_deletion (
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id      UUID        NOT NULL,
+    -- Whether to record the deletion in the user tombstone table.
+    recorded     BOOLEAN     NOT NULL DEFAULT FALSE,
+    -- The account's disable time before deletion was scheduled, put back if the account is restored.
+    disable_time TIMESTAMPTZ NOT NULL DEFAULT '1970-01-01 00:00:00 UTC',
+    create_time  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    delete_time  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS user_deletion_delete_time_idx ON user_deletion (delete_time);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_deletion;
@@ -0,0 +1,31 @@
+/*
+ * Copyright 2026 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+-- Active lockouts from repeated failed login attempts, kept so they survive a server restart.
+CREATE TABLE IF NOT EXISTS login_lockout (
+    PRIMARY KEY (lockout_type, key),
+
+    -- Whether an account (1) or an IP address (2) is locked out.
+    lockout_type SMALLINT     NOT NULL CHECK (lockout_type > 0),
+    key          VARCHAR(255) NOT NULL,
+    create_time  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    locked_until TIMESTAMPTZ  NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS login_lockout;
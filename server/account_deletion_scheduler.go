@@ -0,0 +1,77 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const accountDeletionBatchSize = 100
+
+// AccountDeletionScheduler periodically permanently deletes accounts whose deletion grace period has passed.
+type AccountDeletionScheduler interface {
+	Stop()
+}
+
+type LocalAccountDeletionScheduler struct {
+	logger *zap.Logger
+	db     *sql.DB
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewLocalAccountDeletionScheduler(logger *zap.Logger, db *sql.DB, config Config, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, groupIndex GroupIndex, sessionRegistry SessionRegistry, sessionCache SessionCache, tracker Tracker) AccountDeletionScheduler {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	s := &LocalAccountDeletionScheduler{
+		logger: logger,
+		db:     db,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(config.GetAccount().DeletionIntervalSec) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				// Accounts are disabled while they wait, so there is no rush to catch up on a large backlog at once.
+				count, err := DeleteScheduledAccounts(s.ctx, s.logger, s.db, config, leaderboardCache, leaderboardRankCache, groupIndex, sessionRegistry, sessionCache, tracker, accountDeletionBatchSize)
+				if err != nil {
+					continue
+				}
+				if count > 0 {
+					s.logger.Info("Deleted accounts whose deletion grace period passed", zap.Int("count", count))
+				}
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *LocalAccountDeletionScheduler) Stop() {
+	s.ctxCancelFn()
+}
@@ -18,7 +18,6 @@ import (
 	"compress/flate"
 	"compress/gzip"
 	"context"
-	"crypto"
 	"crypto/tls"
 	"crypto/x509"
 	"database/sql"
@@ -78,6 +77,7 @@ type ApiServer struct {
 	version              string
 	socialClient         *social.Client
 	storageIndex         StorageIndex
+	groupIndex           GroupIndex
 	leaderboardCache     LeaderboardCache
 	leaderboardRankCache LeaderboardRankCache
 	sessionCache         SessionCache
@@ -86,15 +86,20 @@ type ApiServer struct {
 	matchRegistry        MatchRegistry
 	tracker              Tracker
 	router               MessageRouter
+	chatModerator        ChatModerator
 	streamManager        StreamManager
 	metrics              Metrics
 	matchmaker           Matchmaker
 	runtime              *Runtime
+	rateLimiter          *ApiRateLimiter
+	chatRateLimiter      *apiChatRateLimiter
+	mailSender           MailSender
+	mfaAttemptCache      LoginAttemptCache
 	grpcServer           *grpc.Server
 	grpcGatewayServer    *http.Server
 }
 
-func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, storageIndex StorageIndex, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, streamManager StreamManager, metrics Metrics, pipeline *Pipeline, runtime *Runtime) *ApiServer {
+func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, storageIndex StorageIndex, groupIndex GroupIndex, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, chatModerator ChatModerator, streamManager StreamManager, metrics Metrics, pipeline *Pipeline, runtime *Runtime) *ApiServer {
 	var gatewayContextTimeoutMs string
 	if config.GetSocket().IdleTimeoutMs > 500 {
 		// Ensure the GRPC Gateway timeout is just under the idle timeout (if possible) to ensure it has priority.
@@ -105,6 +110,17 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 		gatewayContextTimeoutMs = fmt.Sprintf("%vm", config.GetSocket().IdleTimeoutMs)
 	}
 
+	rateLimiter := NewApiRateLimiter(config, runtime)
+	var channelRateLimiter *keyedRateLimiter
+	if pipeline != nil {
+		channelRateLimiter = pipeline.channelRateLimiter
+	}
+	chatRateLimiter := newApiChatRateLimiter(config.GetRateLimit().Realtime, channelRateLimiter)
+	mailSender := NewMailSender(logger, config, version, runtime)
+	// Failed MFA codes lock out the player account only for further MFA attempts. These are kept apart from console
+	// login lockouts, and are neither persisted nor managed through the console.
+	mfaAttemptCache := NewLocalLoginAttemptCache(logger, nil, config, version, nil)
+
 	serverOpts := []grpc.ServerOption{
 		grpc.StatsHandler(&MetricsGrpcHandler{MetricsFn: metrics.Api, Metrics: metrics}),
 		grpc.MaxRecvMsgSize(int(config.GetSocket().MaxRequestSizeBytes)),
@@ -113,6 +129,9 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 			if err != nil {
 				return nil, err
 			}
+			if err := rateLimitInterceptorFunc(logger, rateLimiter, metrics, ctx, req, info); err != nil {
+				return nil, err
+			}
 			return handler(ctx, req)
 		}),
 	}
@@ -137,16 +156,22 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 		leaderboardCache:     leaderboardCache,
 		leaderboardRankCache: leaderboardRankCache,
 		storageIndex:         storageIndex,
+		groupIndex:           groupIndex,
 		sessionCache:         sessionCache,
 		sessionRegistry:      sessionRegistry,
 		statusRegistry:       statusRegistry,
 		matchRegistry:        matchRegistry,
 		tracker:              tracker,
 		router:               router,
+		chatModerator:        chatModerator,
 		streamManager:        streamManager,
 		metrics:              metrics,
 		matchmaker:           matchmaker,
 		runtime:              runtime,
+		rateLimiter:          rateLimiter,
+		chatRateLimiter:      chatRateLimiter,
+		mailSender:           mailSender,
+		mfaAttemptCache:      mfaAttemptCache,
 		grpcServer:           grpcServer,
 	}
 
@@ -230,6 +255,7 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 	grpcGatewayRouter := mux.NewRouter()
 	// Special case routes. Do NOT enable compression on WebSocket route, it results in "http: response.Write on hijacked connection" errors.
 	grpcGatewayRouter.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(200) }).Methods(http.MethodGet)
+	grpcGatewayRouter.HandleFunc("/.well-known/jwks.json", NewSessionJWKSHandler(config)).Methods(http.MethodGet)
 	grpcGatewayRouter.HandleFunc("/ws", NewSocketWsAcceptor(logger, config, sessionRegistry, sessionCache, statusRegistry, matchmaker, tracker, metrics, runtime, protojsonMarshaler, protojsonUnmarshaler, pipeline)).Methods(http.MethodGet)
 
 	// Another nested router to hijack RPC requests bound for GRPC Gateway.
@@ -342,6 +368,7 @@ func (s *ApiServer) Stop() {
 	}
 	// 2. Stop GRPC server. This also closes the underlying listener.
 	s.grpcServer.GracefulStop()
+	s.mfaAttemptCache.Stop()
 }
 
 func (s *ApiServer) Healthcheck(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
@@ -353,6 +380,12 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 	case "/nakama.api.Nakama/Healthcheck":
 		// Healthcheck has no security.
 		return ctx, nil
+	case "/nakama.api.Nakama/RequestPasswordReset":
+		fallthrough
+	case "/nakama.api.Nakama/ResetPassword":
+		fallthrough
+	case "/nakama.api.Nakama/VerifyEmail":
+		fallthrough
 	case "/nakama.api.Nakama/SessionRefresh":
 		fallthrough
 	case "/nakama.api.Nakama/AuthenticateApple":
@@ -371,8 +404,12 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 		fallthrough
 	case "/nakama.api.Nakama/AuthenticateGoogle":
 		fallthrough
+	case "/nakama.api.Nakama/AuthenticateMFA":
+		fallthrough
+	case "/nakama.api.Nakama/AuthenticateOIDC":
+		fallthrough
 	case "/nakama.api.Nakama/AuthenticateSteam":
-		// Session refresh and authentication functions only require server key.
+		// Session refresh, authentication, and emailed code functions only require server key.
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
 			logger.Error("Cannot extract metadata from incoming context")
@@ -431,7 +468,7 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 			// Value of "authorization" or "grpc-authorization" was empty or repeated.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
 		}
-		userID, username, vars, exp, tokenId, tokenIssuedAt, ok := parseBearerAuth([]byte(config.GetSession().EncryptionKey), auth[0])
+		userID, username, vars, exp, tokenId, tokenIssuedAt, ok := parseBearerAuth(sessionTokenKeyFunc(config.GetSession()), auth[0])
 		if !ok {
 			// Value of "authorization" or "grpc-authorization" was malformed or expired.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
@@ -459,7 +496,7 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 			// Value of "authorization" or "grpc-authorization" was empty or repeated.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
 		}
-		userID, username, vars, exp, tokenId, tokenIssuedAt, ok := parseBearerAuth([]byte(config.GetSession().EncryptionKey), auth[0])
+		userID, username, vars, exp, tokenId, tokenIssuedAt, ok := parseBearerAuth(sessionTokenKeyFunc(config.GetSession()), auth[0])
 		if !ok {
 			// Value of "authorization" or "grpc-authorization" was malformed or expired.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
@@ -492,7 +529,7 @@ func parseBasicAuth(auth string) (username, password string, ok bool) {
 	return cs[:s], cs[s+1:], true
 }
 
-func parseBearerAuth(hmacSecretByte []byte, auth string) (userID uuid.UUID, username string, vars map[string]string, exp int64, tokenId string, issuedAt int64, ok bool) {
+func parseBearerAuth(keyFunc jwt.Keyfunc, auth string) (userID uuid.UUID, username string, vars map[string]string, exp int64, tokenId string, issuedAt int64, ok bool) {
 	if auth == "" {
 		return
 	}
@@ -500,28 +537,31 @@ func parseBearerAuth(hmacSecretByte []byte, auth string) (userID uuid.UUID, user
 	if !strings.HasPrefix(auth, prefix) {
 		return
 	}
-	return parseToken(hmacSecretByte, auth[len(prefix):])
+	return parseToken(keyFunc, auth[len(prefix):])
 }
 
-func parseToken(hmacSecretByte []byte, tokenString string) (userID uuid.UUID, username string, vars map[string]string, exp int64, tokenId string, issuedAt int64, ok bool) {
-	jwtToken, err := jwt.ParseWithClaims(tokenString, &SessionTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if s, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.Hash != crypto.SHA256 {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return hmacSecretByte, nil
-	})
+func parseToken(keyFunc jwt.Keyfunc, tokenString string) (userID uuid.UUID, username string, vars map[string]string, exp int64, tokenId string, issuedAt int64, ok bool) {
+	claims, userID, ok := parseTokenClaims(keyFunc, tokenString)
+	if !ok {
+		return
+	}
+	return userID, claims.Username, claims.Vars, claims.ExpiresAt, claims.TokenId, claims.IssuedAt, true
+}
+
+func parseTokenClaims(keyFunc jwt.Keyfunc, tokenString string) (claims *SessionTokenClaims, userID uuid.UUID, ok bool) {
+	jwtToken, err := jwt.ParseWithClaims(tokenString, &SessionTokenClaims{}, keyFunc)
 	if err != nil {
 		return
 	}
-	claims, ok := jwtToken.Claims.(*SessionTokenClaims)
+	claims, ok = jwtToken.Claims.(*SessionTokenClaims)
 	if !ok || !jwtToken.Valid {
-		return
+		return nil, uuid.Nil, false
 	}
 	userID, err = uuid.FromString(claims.UserId)
 	if err != nil {
-		return
+		return nil, uuid.Nil, false
 	}
-	return userID, claims.Username, claims.Vars, claims.ExpiresAt, claims.TokenId, claims.IssuedAt, true
+	return claims, userID, true
 }
 
 func decompressHandler(logger *zap.Logger, h http.Handler) http.HandlerFunc {
@@ -543,6 +583,27 @@ func decompressHandler(logger *zap.Logger, h http.Handler) http.HandlerFunc {
 	}
 }
 
+func rateLimitInterceptorFunc(logger *zap.Logger, rateLimiter *ApiRateLimiter, metrics Metrics, ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) error {
+	if info.FullMethod == "/nakama.api.Nakama/Healthcheck" {
+		// Healthcheck is never rate limited.
+		return nil
+	}
+
+	method := strings.TrimPrefix(info.FullMethod, API_PREFIX)
+	var rpcID string
+	if rpc, ok := req.(*api.Rpc); ok {
+		rpcID = strings.ToLower(rpc.Id)
+	}
+	userID, _ := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+	clientIP, _ := extractClientAddressFromContext(logger, ctx)
+
+	if !rateLimiter.Allow(clientIP, userID, method, rpcID, time.Now()) {
+		metrics.CountApiRateLimited(method, rpcID, 1)
+		return status.Error(codes.ResourceExhausted, "Rate limit exceeded.")
+	}
+	return nil
+}
+
 func extractClientAddressFromContext(logger *zap.Logger, ctx context.Context) (string, string) {
 	var clientAddr string
 	md, _ := metadata.FromIncomingContext(ctx)
@@ -93,7 +93,18 @@ func (s *ApiServer) DeleteAccount(ctx context.Context, in *emptypb.Empty) (*empt
 		}
 	}
 
-	if err := DeleteAccount(ctx, s.logger, s.db, s.config, s.leaderboardCache, s.leaderboardRankCache, s.sessionRegistry, s.sessionCache, s.tracker, userID, false); err != nil {
+	if s.config.GetAccount().DeletionGracePeriodSec > 0 {
+		// Disable the account for now, it can be restored until the grace period passes.
+		if err := ScheduleAccountDeletion(ctx, s.logger, s.db, s.config, s.sessionRegistry, s.sessionCache, s.tracker, userID, false); err != nil {
+			if errors.Is(err, ErrAccountNotFound) {
+				return nil, status.Error(codes.NotFound, "Account not found.")
+			}
+			if errors.Is(err, ErrAccountDeletionExists) {
+				return nil, status.Error(codes.FailedPrecondition, "Account is already scheduled for deletion.")
+			}
+			return nil, status.Error(codes.Internal, "Error deleting user account.")
+		}
+	} else if err := DeleteAccount(ctx, s.logger, s.db, s.config, s.leaderboardCache, s.leaderboardRankCache, s.groupIndex, s.sessionRegistry, s.sessionCache, s.tracker, userID, false); err != nil {
 		if errors.Is(err, ErrAccountNotFound) {
 			return nil, status.Error(codes.NotFound, "Account not found.")
 		}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *ApiServer) RequestEmailVerification(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	if s.mailSender == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Account emails are not enabled.")
+	}
+
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if err := RequestEmailVerification(ctx, s.logger, s.db, s.config, s.mailSender, userID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) VerifyEmail(ctx context.Context, in *apigrpc.VerifyEmailRequest) (*emptypb.Empty, error) {
+	if s.mailSender == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Account emails are not enabled.")
+	}
+
+	if _, err := VerifyEmail(ctx, s.logger, s.db, s.config, in.Code); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) RequestPasswordReset(ctx context.Context, in *apigrpc.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if s.mailSender == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Account emails are not enabled.")
+	}
+
+	if err := RequestPasswordReset(ctx, s.logger, s.db, s.config, s.mailSender, in.Email); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) ResetPassword(ctx context.Context, in *apigrpc.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if s.mailSender == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Account emails are not enabled.")
+	}
+
+	userID, err := ResetPassword(ctx, s.logger, s.db, s.config, in.Code, in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	// Whoever held the old password should no longer have access to the account.
+	s.sessionCache.RemoveAll(userID)
+
+	return &emptypb.Empty{}, nil
+}
@@ -15,11 +15,15 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -96,3 +100,240 @@ func (s *ApiServer) ListChannelMessages(ctx context.Context, in *api.ListChannel
 
 	return messageList, nil
 }
+
+func (s *ApiServer) ListChannelMessageReactions(ctx context.Context, in *apigrpc.ListChannelMessageReactionsRequest) (*apigrpc.ChannelMessageReactionsList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if len(in.MessageIds) == 0 {
+		return &apigrpc.ChannelMessageReactionsList{Messages: []*apigrpc.ChannelMessageReactions{}}, nil
+	} else if len(in.MessageIds) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid message IDs - at most 100 messages may be requested.")
+	}
+	messageIDs := make([]uuid.UUID, 0, len(in.MessageIds))
+	for _, id := range in.MessageIds {
+		messageID, err := uuid.FromString(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid message ID.")
+		}
+		messageIDs = append(messageIDs, messageID)
+	}
+
+	stream, err := s.channelStreamForCaller(ctx, userID, in.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := ChannelMessageReactionsList(ctx, s.logger, s.db, userID, stream, messageIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error listing message reactions.")
+	}
+
+	return list, nil
+}
+
+func (s *ApiServer) ListChannelMessageReplies(ctx context.Context, in *apigrpc.ListChannelMessageRepliesRequest) (*api.ChannelMessageList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	messageID, err := uuid.FromString(in.MessageId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid message ID.")
+	}
+
+	limit := 100
+	if in.Limit != 0 {
+		if in.Limit < 1 || in.Limit > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.Limit)
+	}
+
+	stream, err := s.channelStreamForCaller(ctx, userID, in.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageList, err := ChannelMessageRepliesList(ctx, s.logger, s.db, stream, in.ChannelId, messageID, limit, in.Cursor)
+	if err == runtime.ErrChannelCursorInvalid {
+		return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
+	} else if err == errChannelMessageNotFound {
+		return nil, status.Error(codes.NotFound, "Message not found.")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Error listing message replies.")
+	}
+
+	return messageList, nil
+}
+
+func (s *ApiServer) ReactChannelMessage(ctx context.Context, in *apigrpc.ReactChannelMessageRequest) (*apigrpc.ChannelMessageReactions, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	messageID, err := uuid.FromString(in.MessageId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid message ID.")
+	}
+	if !channelMessageReactionValid(in.Reaction) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid reaction - must be 1-64 characters without whitespace.")
+	}
+
+	stream, err := s.channelStreamForCaller(ctx, userID, in.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	reactions, err := ChannelMessageReact(ctx, s.logger, s.db, s.router, stream, in.ChannelId, messageID, userID, ctx.Value(ctxUsernameKey{}).(string), in.Reaction)
+	if err == errChannelMessageNotFound {
+		return nil, status.Error(codes.NotFound, "Message not found.")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Error reacting to message.")
+	}
+
+	return reactions, nil
+}
+
+func (s *ApiServer) SendChannelMessageReply(ctx context.Context, in *apigrpc.SendChannelMessageReplyRequest) (*api.ChannelMessage, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	messageID, err := uuid.FromString(in.MessageId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid message ID.")
+	}
+	if maybeJSON := []byte(in.Content); !json.Valid(maybeJSON) || bytes.TrimSpace(maybeJSON)[0] != byteBracket {
+		return nil, status.Error(codes.InvalidArgument, "Message content must be a valid JSON object.")
+	}
+
+	stream, err := s.channelStreamForCaller(ctx, userID, in.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.channelCheckCallerSend(ctx, userID, stream, in.ChannelId); err != nil {
+		return nil, err
+	}
+
+	message, err := ChannelMessageReply(ctx, s.logger, s.db, s.router, s.chatModerator, stream, in.ChannelId, messageID, in.Content, userID.String(), ctx.Value(ctxUsernameKey{}).(string))
+	if err == errChannelMessageNotFound {
+		return nil, status.Error(codes.NotFound, "Message not found.")
+	} else if err == errChannelMessageReplyNested {
+		return nil, status.Error(codes.InvalidArgument, "Cannot reply to a reply.")
+	} else if err == errChannelMessageRejected {
+		return nil, status.Error(codes.InvalidArgument, "Message rejected by chat moderation.")
+	} else if err == errChannelMessageSenderMuted {
+		return nil, status.Error(codes.PermissionDenied, "Muted users cannot send messages.")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Error sending message reply.")
+	}
+
+	return message, nil
+}
+
+// Resolve a channel ID to its stream and check the caller is allowed to interact with it.
+func (s *ApiServer) channelStreamForCaller(ctx context.Context, userID uuid.UUID, channelID string) (PresenceStream, error) {
+	if channelID == "" {
+		return PresenceStream{}, status.Error(codes.InvalidArgument, "Invalid channel ID.")
+	}
+	streamConversionResult, err := ChannelIdToStream(channelID)
+	if err != nil {
+		return PresenceStream{}, status.Error(codes.InvalidArgument, "Invalid channel ID.")
+	}
+
+	switch err := channelCheckCallerPermission(ctx, s.logger, s.db, userID, streamConversionResult.Stream); err {
+	case nil:
+	case runtime.ErrChannelGroupNotFound:
+		return PresenceStream{}, status.Error(codes.InvalidArgument, "Group not found.")
+	case runtime.ErrChannelIDInvalid:
+		return PresenceStream{}, status.Error(codes.InvalidArgument, "Channel not found.")
+	default:
+		return PresenceStream{}, status.Error(codes.Internal, "Error checking channel permissions.")
+	}
+
+	return streamConversionResult.Stream, nil
+}
+
+// Replies are chat messages, so they are held to the checks realtime messages get: the caller must have joined the
+// channel, must not be blocked by the other user in a direct message, and counts against the chat rate limits.
+func (s *ApiServer) channelCheckCallerSend(ctx context.Context, userID uuid.UUID, stream PresenceStream, channelID string) error {
+	joined := false
+	for _, presence := range s.tracker.ListByStream(stream, true, true) {
+		if presence.UserID == userID {
+			joined = true
+			break
+		}
+	}
+	if !joined {
+		return status.Error(codes.FailedPrecondition, "Must join channel before sending messages.")
+	}
+
+	if stream.Mode == StreamModeDM {
+		otherUserID := stream.Subject
+		if otherUserID == userID {
+			otherUserID = stream.Subcontext
+		}
+		allowed, err := UserExistsAndDoesNotBlock(ctx, s.db, otherUserID, userID)
+		if err != nil {
+			s.logger.Error("Could not check direct message block.", zap.Error(err))
+			return status.Error(codes.Internal, "Error checking channel permissions.")
+		}
+		if !allowed {
+			return status.Error(codes.InvalidArgument, "Channel not found.")
+		}
+	}
+
+	if s.chatRateLimiter != nil && !s.chatRateLimiter.allow(userID, channelID, time.Now()) {
+		s.metrics.CountRealtimeRateLimited(rateLimitCategoryChat, 1)
+		return status.Error(codes.ResourceExhausted, "Rate limit exceeded.")
+	}
+
+	return nil
+}
+
+func (s *ApiServer) SearchChannelMessages(ctx context.Context, in *apigrpc.SearchChannelMessagesRequest) (*api.ChannelMessageList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	filter := &ChannelMessageSearchFilter{Query: in.Query}
+	if in.SenderId != "" {
+		senderID, err := uuid.FromString(in.SenderId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid sender ID.")
+		}
+		filter.SenderID = senderID
+	}
+	if in.StartTime != nil {
+		filter.StartTime = in.StartTime.AsTime()
+	}
+	if in.EndTime != nil {
+		filter.EndTime = in.EndTime.AsTime()
+	}
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() && !filter.EndTime.After(filter.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "End time must be after start time.")
+	}
+
+	limit := 100
+	if in.Limit != 0 {
+		if in.Limit < 1 || in.Limit > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.Limit)
+	}
+
+	if in.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Invalid channel ID.")
+	}
+	streamConversionResult, err := ChannelIdToStream(in.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid channel ID.")
+	}
+
+	messageList, err := ChannelMessagesSearch(ctx, s.logger, s.db, userID, streamConversionResult.Stream, in.ChannelId, filter, limit, in.Cursor)
+	if err == errChannelMessageSearchInvalid {
+		return nil, status.Error(codes.InvalidArgument, "Search requires words to match, a sender ID, or a time range.")
+	} else if err == runtime.ErrChannelCursorInvalid {
+		return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
+	} else if err == runtime.ErrChannelGroupNotFound {
+		return nil, status.Error(codes.InvalidArgument, "Group not found.")
+	} else if err == runtime.ErrChannelIDInvalid {
+		return nil, status.Error(codes.InvalidArgument, "Channel not found.")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Error searching messages in channel.")
+	}
+
+	return messageList, nil
+}
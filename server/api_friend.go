@@ -21,6 +21,7 @@ import (
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -144,6 +145,25 @@ func (s *ApiServer) ListFriendsOfFriends(ctx context.Context, in *api.ListFriend
 	return friendsOfFriends, nil
 }
 
+func (s *ApiServer) ListFriendRecommendations(ctx context.Context, in *apigrpc.ListFriendRecommendationsRequest) (*apigrpc.FriendRecommendationList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	limit := 10
+	if in.Limit != 0 {
+		if in.Limit < 1 || in.Limit > friendRecommendationMaxCandidates {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.Limit)
+	}
+
+	recommendations, err := FriendRecommendationsList(ctx, s.logger, s.db, s.statusRegistry, s.config.GetFriend(), userID, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while trying to list friend recommendations.")
+	}
+
+	return recommendations, nil
+}
+
 func (s *ApiServer) AddFriends(ctx context.Context, in *api.AddFriendsRequest) (*emptypb.Empty, error) {
 	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
 
@@ -174,55 +194,87 @@ func (s *ApiServer) AddFriends(ctx context.Context, in *api.AddFriendsRequest) (
 		return &emptypb.Empty{}, nil
 	}
 
+	if err := s.addFriends(ctx, userID, in.GetIds(), in.GetUsernames(), ""); err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterAddFriends(); fn != nil {
+		afterFn := func(clientIP, clientPort string) error {
+			return fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxVarsKey{}).(map[string]string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, s.metrics, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) InviteFriends(ctx context.Context, in *apigrpc.InviteFriendsRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if err := s.addFriends(ctx, userID, in.GetIds(), in.GetUsernames(), in.GetMessage()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Validate and resolve the users to add as friends, and send or accept friend invites. Errors returned are gRPC
+// status errors ready to return to the client.
+func (s *ApiServer) addFriends(ctx context.Context, userID uuid.UUID, ids, usernames []string, message string) error {
+	if len(ids) == 0 && len(usernames) == 0 {
+		return nil
+	}
+
 	username := ctx.Value(ctxUsernameKey{}).(string)
 
-	for _, id := range in.GetIds() {
+	for _, id := range ids {
 		if userID.String() == id {
-			return nil, status.Error(codes.InvalidArgument, "Cannot add self as friend.")
+			return status.Error(codes.InvalidArgument, "Cannot add self as friend.")
 		}
 		if uid, err := uuid.FromString(id); err != nil || uid == uuid.Nil {
-			return nil, status.Error(codes.InvalidArgument, "Invalid user ID '"+id+"'.")
+			return status.Error(codes.InvalidArgument, "Invalid user ID '"+id+"'.")
 		}
 	}
 
-	for _, u := range in.GetUsernames() {
+	for _, u := range usernames {
 		if u == "" {
-			return nil, status.Error(codes.InvalidArgument, "Username must not be empty.")
+			return status.Error(codes.InvalidArgument, "Username must not be empty.")
 		}
 		if username == u {
-			return nil, status.Error(codes.InvalidArgument, "Cannot add self as friend.")
+			return status.Error(codes.InvalidArgument, "Cannot add self as friend.")
 		}
 	}
 
-	userIDs, err := fetchUserID(ctx, s.db, in.GetUsernames())
+	userIDs, err := fetchUserID(ctx, s.db, usernames)
 	if err != nil {
-		s.logger.Error("Could not fetch user IDs.", zap.Error(err), zap.Strings("usernames", in.GetUsernames()))
-		return nil, status.Error(codes.Internal, "Error while trying to add friends.")
+		s.logger.Error("Could not fetch user IDs.", zap.Error(err), zap.Strings("usernames", usernames))
+		return status.Error(codes.Internal, "Error while trying to add friends.")
 	}
 
-	if len(userIDs)+len(in.GetIds()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "No valid ID or username was provided.")
+	if len(userIDs)+len(ids) == 0 {
+		return status.Error(codes.InvalidArgument, "No valid ID or username was provided.")
 	}
 
-	allIDs := make([]string, 0, len(in.GetIds())+len(userIDs))
-	allIDs = append(allIDs, in.GetIds()...)
+	allIDs := make([]string, 0, len(ids)+len(userIDs))
+	allIDs = append(allIDs, ids...)
 	allIDs = append(allIDs, userIDs...)
 
-	if err := AddFriends(ctx, s.logger, s.db, s.tracker, s.router, userID, username, allIDs); err != nil {
-		return nil, status.Error(codes.Internal, "Error while trying to add friends.")
-	}
-
-	// After hook.
-	if fn := s.runtime.AfterAddFriends(); fn != nil {
-		afterFn := func(clientIP, clientPort string) error {
-			return fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxVarsKey{}).(map[string]string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+	if err := AddFriends(ctx, s.logger, s.db, s.tracker, s.router, s.config.GetFriend(), userID, username, allIDs, message); err != nil {
+		switch err {
+		case errFriendInviteMessageTooLong:
+			return status.Error(codes.InvalidArgument, "Friend invite message is too long.")
+		case errFriendInviteOutgoingLimit:
+			return status.Error(codes.ResourceExhausted, "Too many pending friend invites.")
+		case errFriendInviteIncomingLimit:
+			return status.Error(codes.ResourceExhausted, "User has too many pending friend invites.")
 		}
-
-		// Execute the after function lambda wrapped in a trace for stats measurement.
-		traceApiAfter(ctx, s.logger, s.metrics, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+		return status.Error(codes.Internal, "Error while trying to add friends.")
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *ApiServer) DeleteFriends(ctx context.Context, in *api.DeleteFriendsRequest) (*emptypb.Empty, error) {
@@ -16,10 +16,12 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -64,7 +66,7 @@ func (s *ApiServer) CreateGroup(ctx context.Context, in *api.CreateGroupRequest)
 		maxCount = int(mc)
 	}
 
-	group, err := CreateGroup(ctx, s.logger, s.db, userID, userID, in.GetName(), in.GetLangTag(), in.GetDescription(), in.GetAvatarUrl(), "", in.GetOpen(), maxCount)
+	group, err := CreateGroup(ctx, s.logger, s.db, s.groupIndex, userID, userID, in.GetName(), in.GetLangTag(), in.GetDescription(), in.GetAvatarUrl(), "", in.GetOpen(), maxCount)
 	if err != nil {
 		if err == runtime.ErrGroupNameInUse {
 			return nil, status.Error(codes.AlreadyExists, "Group name is in use.")
@@ -132,7 +134,7 @@ func (s *ApiServer) UpdateGroup(ctx context.Context, in *api.UpdateGroupRequest)
 		}
 	}
 
-	if err = UpdateGroup(ctx, s.logger, s.db, groupID, userID, uuid.Nil, in.GetName(), in.GetLangTag(), in.GetDescription(), in.GetAvatarUrl(), nil, in.GetOpen(), -1); err != nil {
+	if err = UpdateGroup(ctx, s.logger, s.db, s.groupIndex, groupID, userID, uuid.Nil, in.GetName(), in.GetLangTag(), in.GetDescription(), in.GetAvatarUrl(), nil, in.GetOpen(), -1); err != nil {
 		switch err {
 		case runtime.ErrGroupPermissionDenied:
 			return nil, status.Error(codes.NotFound, "Group not found or you're not allowed to update.")
@@ -195,7 +197,7 @@ func (s *ApiServer) DeleteGroup(ctx context.Context, in *api.DeleteGroupRequest)
 		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
 	}
 
-	err = DeleteGroup(ctx, s.logger, s.db, s.tracker, groupID, userID)
+	err = DeleteGroup(ctx, s.logger, s.db, s.groupIndex, s.tracker, groupID, userID)
 	if err != nil {
 		if err == runtime.ErrGroupPermissionDenied {
 			return nil, status.Error(codes.InvalidArgument, "Group not found or you're not allowed to delete.")
@@ -252,7 +254,7 @@ func (s *ApiServer) JoinGroup(ctx context.Context, in *api.JoinGroupRequest) (*e
 		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
 	}
 
-	err = JoinGroup(ctx, s.logger, s.db, s.tracker, s.router, groupID, userID, username)
+	err = JoinGroup(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, groupID, userID, username)
 	if err != nil {
 		if err == runtime.ErrGroupNotFound {
 			return nil, status.Error(codes.NotFound, "Group not found.")
@@ -311,7 +313,7 @@ func (s *ApiServer) LeaveGroup(ctx context.Context, in *api.LeaveGroupRequest) (
 		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
 	}
 
-	err = LeaveGroup(ctx, s.logger, s.db, s.tracker, s.router, s.streamManager, groupID, userID, username)
+	err = LeaveGroup(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, s.streamManager, groupID, userID, username)
 	if err != nil {
 		if err == runtime.ErrGroupLastSuperadmin {
 			return nil, status.Error(codes.InvalidArgument, "Cannot leave group when you are the last superadmin.")
@@ -380,7 +382,7 @@ func (s *ApiServer) AddGroupUsers(ctx context.Context, in *api.AddGroupUsersRequ
 		userIDs = append(userIDs, uid)
 	}
 
-	err = AddGroupUsers(ctx, s.logger, s.db, s.tracker, s.router, userID, groupID, userIDs)
+	err = AddGroupUsers(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, userID, groupID, userIDs)
 	if err != nil {
 		if err == runtime.ErrGroupPermissionDenied {
 			return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
@@ -453,7 +455,7 @@ func (s *ApiServer) BanGroupUsers(ctx context.Context, in *api.BanGroupUsersRequ
 		userIDs = append(userIDs, uid)
 	}
 
-	if err = BanGroupUsers(ctx, s.logger, s.db, s.tracker, s.router, s.streamManager, userID, groupID, userIDs); err != nil {
+	if err = BanGroupUsers(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, s.streamManager, userID, groupID, userIDs); err != nil {
 		if err == runtime.ErrGroupPermissionDenied {
 			return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
 		}
@@ -521,7 +523,7 @@ func (s *ApiServer) KickGroupUsers(ctx context.Context, in *api.KickGroupUsersRe
 		userIDs = append(userIDs, uid)
 	}
 
-	if err = KickGroupUsers(ctx, s.logger, s.db, s.tracker, s.router, s.streamManager, userID, groupID, userIDs, false); err != nil {
+	if err = KickGroupUsers(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, s.streamManager, userID, groupID, userIDs, false); err != nil {
 		if err == runtime.ErrGroupPermissionDenied {
 			return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
 		}
@@ -589,7 +591,7 @@ func (s *ApiServer) PromoteGroupUsers(ctx context.Context, in *api.PromoteGroupU
 		userIDs = append(userIDs, uid)
 	}
 
-	err = PromoteGroupUsers(ctx, s.logger, s.db, s.router, userID, groupID, userIDs)
+	err = PromoteGroupUsers(ctx, s.logger, s.db, s.groupIndex, s.router, userID, groupID, userIDs)
 	if err != nil {
 		if err == runtime.ErrGroupPermissionDenied {
 			return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
@@ -885,3 +887,228 @@ func (s *ApiServer) ListGroups(ctx context.Context, in *api.ListGroupsRequest) (
 
 	return groups, nil
 }
+
+func (s *ApiServer) SearchGroups(ctx context.Context, in *apigrpc.SearchGroupsRequest) (*api.GroupList, error) {
+	limit := 1
+	if in.GetLimit() != 0 {
+		if in.GetLimit() < 1 || in.GetLimit() > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.GetLimit())
+	}
+
+	groups, err := s.groupIndex.List(ctx, in.GetQuery(), limit, in.GetOrder(), in.GetCursor())
+	if err != nil {
+		if err == errGroupIndexCursorInvalid {
+			return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
+		}
+		if errors.Is(err, errGroupIndexQueryInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to search groups.")
+	}
+
+	return groups, nil
+}
+
+func (s *ApiServer) InviteGroupUsers(ctx context.Context, in *apigrpc.InviteGroupUsersRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	groupID, err := uuid.FromString(in.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
+	}
+
+	if len(in.UserIds) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(in.UserIds))
+	for _, id := range in.UserIds {
+		uid := uuid.FromStringOrNil(id)
+		if uid == uuid.Nil {
+			return nil, status.Error(codes.InvalidArgument, "User ID must be a valid ID.")
+		}
+		userIDs = append(userIDs, uid)
+	}
+
+	err = GroupInvitesSend(ctx, s.logger, s.db, s.tracker, s.router, s.config.GetGroup(), userID, groupID, userIDs)
+	if err != nil {
+		if err == runtime.ErrGroupPermissionDenied || err == runtime.ErrGroupNotFound {
+			return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
+		} else if err == runtime.ErrGroupUserNotFound {
+			return nil, status.Error(codes.InvalidArgument, "One or more users not found.")
+		} else if err == errGroupInviteLimit {
+			return nil, status.Error(codes.ResourceExhausted, "Group has too many pending invites.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to invite users to a group.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) AcceptGroupInvite(ctx context.Context, in *apigrpc.GroupInviteRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	groupID, err := uuid.FromString(in.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
+	}
+
+	err = GroupInviteAccept(ctx, s.logger, s.db, s.groupIndex, s.router, groupID, userID, ctx.Value(ctxUsernameKey{}).(string))
+	if err != nil {
+		if err == runtime.ErrGroupNotFound || err == errGroupInviteNotFound {
+			return nil, status.Error(codes.NotFound, "Group invite not found or expired.")
+		} else if err == runtime.ErrGroupFull {
+			return nil, status.Error(codes.InvalidArgument, "Group is full.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to accept group invite.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) DeclineGroupInvite(ctx context.Context, in *apigrpc.GroupInviteRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	groupID, err := uuid.FromString(in.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
+	}
+
+	err = GroupInviteDecline(ctx, s.logger, s.db, groupID, userID)
+	if err != nil {
+		if err == errGroupInviteNotFound {
+			return nil, status.Error(codes.NotFound, "Group invite not found.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to decline group invite.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) RevokeGroupInvites(ctx context.Context, in *apigrpc.RevokeGroupInvitesRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	groupID, err := uuid.FromString(in.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
+	}
+
+	if len(in.UserIds) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(in.UserIds))
+	for _, id := range in.UserIds {
+		uid := uuid.FromStringOrNil(id)
+		if uid == uuid.Nil {
+			return nil, status.Error(codes.InvalidArgument, "User ID must be a valid ID.")
+		}
+		userIDs = append(userIDs, uid)
+	}
+
+	err = GroupInvitesRevoke(ctx, s.logger, s.db, userID, groupID, userIDs)
+	if err != nil {
+		if err == runtime.ErrGroupPermissionDenied {
+			return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to revoke group invites.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) ListGroupEvents(ctx context.Context, in *apigrpc.ListGroupEventsRequest) (*apigrpc.GroupEventList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	groupID, err := uuid.FromString(in.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
+	}
+
+	limit := 100
+	if in.Limit != 0 {
+		if in.Limit < 1 || in.Limit > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.Limit)
+	}
+
+	// Only members, admins and superadmins may read a group's activity feed.
+	state, _, err := groupCallerState(ctx, s.logger, s.db, groupID, userID, 0)
+	if err != nil && err != runtime.ErrGroupPermissionDenied {
+		return nil, status.Error(codes.Internal, "Error while trying to list group events.")
+	}
+	if err != nil || state > int(api.GroupUserList_GroupUser_MEMBER) {
+		return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
+	}
+
+	events, err := GroupEventsList(ctx, s.logger, s.db, groupID, limit, in.Cursor)
+	if err != nil {
+		if err == runtime.ErrGroupUserInvalidCursor {
+			return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to list group events.")
+	}
+
+	return events, nil
+}
+
+func (s *ApiServer) ListGroupInvites(ctx context.Context, in *apigrpc.ListGroupInvitesRequest) (*apigrpc.GroupInviteList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	limit := 100
+	if in.Limit != 0 {
+		if in.Limit < 1 || in.Limit > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.Limit)
+	}
+
+	invites, err := GroupInvitesList(ctx, s.logger, s.db, userID, limit, in.Cursor)
+	if err != nil {
+		if err == runtime.ErrGroupUserInvalidCursor {
+			return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to list group invites.")
+	}
+
+	return invites, nil
+}
+
+func (s *ApiServer) ListGroupUserInvites(ctx context.Context, in *apigrpc.ListGroupUserInvitesRequest) (*apigrpc.GroupInviteList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	groupID, err := uuid.FromString(in.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group ID must be a valid ID.")
+	}
+
+	limit := 100
+	if in.Limit != 0 {
+		if in.Limit < 1 || in.Limit > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.Limit)
+	}
+
+	// Only users allowed to send invites may see who has been invited.
+	allowed, err := GroupUserPermissionCheck(ctx, s.logger, s.db, groupID, userID, GroupPermissionInvite)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while trying to list group invites.")
+	}
+	if !allowed {
+		return nil, status.Error(codes.NotFound, "Group not found or permission denied.")
+	}
+
+	invites, err := GroupUserInvitesList(ctx, s.logger, s.db, groupID, limit, in.Cursor)
+	if err != nil {
+		if err == runtime.ErrGroupUserInvalidCursor {
+			return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to list group invites.")
+	}
+
+	return invites, nil
+}
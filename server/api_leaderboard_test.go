@@ -113,12 +113,12 @@ nk.leaderboard_create(%q, %t, %q, %q, reset, metadata, %t)
 		tracker := &LocalTracker{}
 		sessionCache := NewLocalSessionCache(1_000, 3_600)
 
-		pipeline := NewPipeline(logger, cfg, db, protojsonMarshaler, protojsonUnmarshaler, nil, nil, nil, nil, nil, tracker, router, runtime)
+		pipeline := NewPipeline(logger, cfg, db, protojsonMarshaler, protojsonUnmarshaler, nil, nil, nil, nil, nil, tracker, router, nil, runtime)
 
 		apiServer := StartApiServer(logger, logger, db, protojsonMarshaler,
-			protojsonUnmarshaler, cfg, "3.0.0", nil, nil, rtData.leaderboardCache,
+			protojsonUnmarshaler, cfg, "3.0.0", nil, nil, nil, rtData.leaderboardCache,
 			rtData.leaderboardRankCache, nil, sessionCache,
-			nil, nil, nil, tracker, router, nil, metrics, pipeline, runtime)
+			nil, nil, nil, tracker, router, nil, nil, metrics, pipeline, runtime)
 
 		WaitForSocket(nil, cfg)
 
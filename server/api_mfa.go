@@ -0,0 +1,106 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *ApiServer) AuthenticateMFA(ctx context.Context, in *apigrpc.AuthenticateMFARequest) (*api.Session, error) {
+	// Before hook.
+	if fn := s.runtime.BeforeAuthenticateMFA(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, "", "", nil, 0, clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, s.metrics, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "MFA challenge token is required.")
+	}
+
+	claims, err := AuthenticateMFA(ctx, s.logger, s.db, s.config, s.mfaAttemptCache, in.Token, in.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.config.GetSession().SingleSession {
+		s.sessionCache.RemoveAll(uuid.Must(uuid.FromString(claims.UserId)))
+	}
+
+	tokenID := uuid.Must(uuid.NewV4()).String()
+	tokenIssuedAt := time.Now().Unix()
+	token, exp := generateToken(s.config, tokenID, tokenIssuedAt, claims.UserId, claims.Username, claims.Vars)
+	refreshToken, refreshExp := generateRefreshToken(s.config, tokenID, tokenIssuedAt, claims.UserId, claims.Username, claims.Vars)
+	s.sessionCache.Add(uuid.FromStringOrNil(claims.UserId), exp, tokenID, refreshExp, tokenID, sessionDeviceFromContext(s.logger, ctx, claims.Method))
+	session := &api.Session{Token: token, RefreshToken: refreshToken}
+
+	// After hook.
+	if fn := s.runtime.AfterAuthenticateMFA(); fn != nil {
+		afterFn := func(clientIP, clientPort string) error {
+			return fn(ctx, s.logger, claims.UserId, claims.Username, claims.Vars, exp, clientIP, clientPort, session, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, s.metrics, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return session, nil
+}
+
+func (s *ApiServer) EnrollMFA(ctx context.Context, in *emptypb.Empty) (*apigrpc.MFAEnrollment, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	return EnrollMFA(ctx, s.logger, s.db, s.config, userID)
+}
+
+func (s *ApiServer) ConfirmMFA(ctx context.Context, in *apigrpc.ConfirmMFARequest) (*apigrpc.MFARecoveryCodes, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "MFA enrollment token is required.")
+	}
+
+	recoveryCodes, err := ConfirmMFA(ctx, s.logger, s.db, s.config, userID, in.Token, in.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apigrpc.MFARecoveryCodes{RecoveryCodes: recoveryCodes}, nil
+}
@@ -16,8 +16,10 @@ package server
 
 import (
 	"context"
+
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -58,7 +60,7 @@ func (s *ApiServer) ListNotifications(ctx context.Context, in *api.ListNotificat
 		limit = int(in.GetLimit().Value)
 	}
 
-	notificationList, err := NotificationList(ctx, s.logger, s.db, userID, limit, in.CacheableCursor, true)
+	notificationList, err := NotificationList(ctx, s.logger, s.db, userID, limit, in.CacheableCursor, true, nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Error retrieving notifications.")
 	}
@@ -106,7 +108,7 @@ func (s *ApiServer) DeleteNotifications(ctx context.Context, in *api.DeleteNotif
 		return &emptypb.Empty{}, nil
 	}
 
-	if err := NotificationDelete(ctx, s.logger, s.db, userID, in.GetIds()); err != nil {
+	if err := NotificationDelete(ctx, s.logger, s.db, s.router, userID, in.GetIds()); err != nil {
 		return nil, status.Error(codes.Internal, "Error while deleting notifications.")
 	}
 
@@ -122,3 +124,56 @@ func (s *ApiServer) DeleteNotifications(ctx context.Context, in *api.DeleteNotif
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *ApiServer) ListNotificationInbox(ctx context.Context, in *apigrpc.ListNotificationInboxRequest) (*apigrpc.NotificationInbox, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	limit := 1
+	if in.GetLimit() != 0 {
+		if in.GetLimit() < 1 || in.GetLimit() > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.GetLimit())
+	}
+
+	if len(in.GetCodeRanges()) > 10 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid code ranges - at most 10 code ranges may be used.")
+	}
+	for _, codeRange := range in.GetCodeRanges() {
+		if codeRange.GetMinCode() > codeRange.GetMaxCode() {
+			return nil, status.Error(codes.InvalidArgument, "Invalid code range - min code must not be greater than max code.")
+		}
+	}
+
+	notificationInbox, err := NotificationInboxList(ctx, s.logger, s.db, userID, limit, in.GetCacheableCursor(), true, &NotificationListFilter{
+		UnreadOnly: in.GetUnreadOnly(),
+		CodeRanges: in.GetCodeRanges(),
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "Error retrieving notifications.")
+	}
+
+	return notificationInbox, nil
+}
+
+func (s *ApiServer) ReadNotifications(ctx context.Context, in *apigrpc.ReadNotificationsRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if len(in.GetIds()) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+	for _, id := range in.GetIds() {
+		if _, err := uuid.FromString(id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid notification ID.")
+		}
+	}
+
+	if err := NotificationsRead(ctx, s.logger, s.db, s.router, userID, in.GetIds()); err != nil {
+		return nil, status.Error(codes.Internal, "Error while marking notifications as read.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Maximum accepted length of a push device token, matches the database column.
+const pushDeviceTokenMaxLength = 512
+
+func (s *ApiServer) AddPushDevice(ctx context.Context, in *apigrpc.AddPushDeviceRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "Push device token is required.")
+	} else if len(in.Token) > pushDeviceTokenMaxLength {
+		return nil, status.Error(codes.InvalidArgument, "Invalid push device token, must be 1-512 bytes.")
+	}
+	if _, ok := apigrpc.PushDevice_Platform_name[int32(in.Platform)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "Invalid push device platform.")
+	}
+
+	if err := PushDeviceAdd(ctx, s.logger, s.db, s.config.GetNotification().MaxPushDevices, userID, in.Platform, in.Token); err != nil {
+		return nil, status.Error(codes.Internal, "Error adding push device.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) DeletePushDevice(ctx context.Context, in *apigrpc.DeletePushDeviceRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "Push device token is required.")
+	}
+
+	if err := PushDeviceDelete(ctx, s.logger, s.db, userID, in.Token); err != nil {
+		return nil, status.Error(codes.Internal, "Error deleting push device.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiServer) GetPushPreferences(ctx context.Context, in *emptypb.Empty) (*apigrpc.PushPreferences, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	preferences, err := PushPreferencesGet(ctx, s.logger, s.db, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error retrieving push preferences.")
+	}
+
+	return preferences, nil
+}
+
+func (s *ApiServer) ListPushDevices(ctx context.Context, in *emptypb.Empty) (*apigrpc.PushDeviceList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	devices, err := PushDeviceList(ctx, s.logger, s.db, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error listing push devices.")
+	}
+
+	return devices, nil
+}
+
+func (s *ApiServer) UpdatePushPreferences(ctx context.Context, in *apigrpc.PushPreferences) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	for _, code := range in.DisabledCodes {
+		// Notification codes are stored as SMALLINT, system reserved codes are negative.
+		if code < -32768 || code > 32767 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid notification code, must be -32768 to 32767.")
+		}
+	}
+
+	if err := PushPreferencesUpdate(ctx, s.logger, s.db, userID, in.DisabledCodes); err != nil {
+		return nil, status.Error(codes.Internal, "Error updating push preferences.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -41,6 +41,7 @@ var (
 	internalServerErrorBytes = []byte(`{"error":"Internal Server Error","message":"Internal Server Error","code":13}`)
 	badJSONBytes             = []byte(`{"error":"json: cannot unmarshal object into Go value of type string","message":"json: cannot unmarshal object into Go value of type string","code":3}`)
 	requestBodyTooLargeBytes = []byte(`{"code":3, "message":"http: request body too large"}`)
+	rateLimitExceededBytes   = []byte(`{"error":"Rate limit exceeded","message":"Rate limit exceeded","code":8}`)
 )
 
 func (s *ApiServer) RpcFuncHttp(w http.ResponseWriter, r *http.Request) {
@@ -78,7 +79,7 @@ func (s *ApiServer) RpcFuncHttp(w http.ResponseWriter, r *http.Request) {
 		} else {
 			var tokenId string
 			var tokenIssuedAt int64
-			userID, username, vars, expiry, tokenId, tokenIssuedAt, isTokenAuth = parseBearerAuth([]byte(s.config.GetSession().EncryptionKey), auth[0])
+			userID, username, vars, expiry, tokenId, tokenIssuedAt, isTokenAuth = parseBearerAuth(sessionTokenKeyFunc(s.config.GetSession()), auth[0])
 			requestCtx = context.WithValue(requestCtx, ctxTokenIDKey{}, tokenId)
 			requestCtx = context.WithValue(requestCtx, ctxExpiryKey{}, expiry)
 			requestCtx = context.WithValue(requestCtx, ctxTokenIssuedAtKey{}, tokenIssuedAt)
@@ -131,6 +132,18 @@ func (s *ApiServer) RpcFuncHttp(w http.ResponseWriter, r *http.Request) {
 	}
 	id = strings.ToLower(maybeID)
 
+	clientIP, clientPort := extractClientAddressFromRequest(s.logger, r)
+	if !s.rateLimiter.Allow(clientIP, userID, "RpcFunc", id, time.Now()) {
+		s.metrics.CountApiRateLimited("RpcFunc", id, 1)
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		sentBytes, err = w.Write(rateLimitExceededBytes)
+		if err != nil {
+			s.logger.Debug("Error writing response to client", zap.Error(err))
+		}
+		return
+	}
+
 	// Find the correct RPC function.
 	fn := s.runtime.Rpc(id)
 	if fn == nil {
@@ -200,8 +213,6 @@ func (s *ApiServer) RpcFuncHttp(w http.ResponseWriter, r *http.Request) {
 		uid = userID.String()
 	}
 
-	clientIP, clientPort := extractClientAddressFromRequest(s.logger, r)
-
 	// Extract http headers
 	headers := make(map[string][]string)
 	for k, v := range r.Header {
@@ -16,13 +16,18 @@ package server
 
 import (
 	"context"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *ApiServer) SessionRefresh(ctx context.Context, in *api.SessionRefreshRequest) (*api.Session, error) {
@@ -53,7 +58,7 @@ func (s *ApiServer) SessionRefresh(ctx context.Context, in *api.SessionRefreshRe
 		return nil, status.Error(codes.InvalidArgument, "Refresh token is required.")
 	}
 
-	userID, username, vars, tokenId, tokenIssuedAt, err := SessionRefresh(ctx, s.logger, s.db, s.config, s.sessionCache, in.Token)
+	userID, username, vars, tokenId, refreshId, tokenIssuedAt, err := SessionRefresh(ctx, s.logger, s.db, s.config, s.sessionCache, in.Token)
 	if err != nil {
 		return nil, err
 	}
@@ -65,16 +70,40 @@ func (s *ApiServer) SessionRefresh(ctx context.Context, in *api.SessionRefreshRe
 	}
 	userIDStr := userID.String()
 
-	//newTokenId := uuid.Must(uuid.NewV4()).String()
-	//token, tokenExp := generateToken(s.config, newTokenId, userIDStr, username, useVars)
-	//refreshToken, refreshTokenExp := generateRefreshToken(s.config, newTokenId, userIDStr, username, useVars)
-	//s.sessionCache.Remove(userID, tokenExp, "", refreshTokenExp, tokenId)
-	//s.sessionCache.Add(userID, tokenExp, newTokenId, refreshTokenExp, newTokenId)
-	//session := &api.Session{Created: false, Token: token, RefreshToken: refreshToken}
-
+	device := sessionDeviceFromContext(s.logger, ctx, "refresh")
 	token, tokenExp := generateToken(s.config, tokenId, tokenIssuedAt, userIDStr, username, useVars)
-	refreshToken, refreshTokenExp := generateRefreshToken(s.config, tokenId, tokenIssuedAt, userIDStr, username, useVars)
-	s.sessionCache.Add(userID, tokenExp, tokenId, refreshTokenExp, tokenId)
+	var refreshToken string
+	var refreshTokenExp int64
+	if s.config.GetSession().RefreshTokenRotation {
+		newRefreshId := uuid.Must(uuid.NewV4()).String()
+		refreshToken, refreshTokenExp = generateRotatedRefreshToken(s.config, tokenId, newRefreshId, tokenIssuedAt, userIDStr, username, useVars)
+		if !s.sessionCache.Rotate(userID, tokenExp, tokenId, refreshTokenExp, refreshId, newRefreshId, device) {
+			// The refresh token was already exchanged, either by the user or by someone holding a copy of it. Log out the
+			// session so neither can continue to use it.
+			s.logger.Warn("Rotated refresh token presented again, revoking session.", zap.String("uid", userIDStr), zap.String("session_id", tokenId))
+			_ = SessionRevoke(s.sessionCache, userID, tokenId)
+
+			if fn := s.runtime.Event(); fn != nil {
+				clientIP, clientPort := extractClientAddressFromContext(s.logger, ctx)
+				evtCtx := NewRuntimeGoContext(ctx, s.config.GetName(), s.version, s.config.GetRuntime().Environment, RuntimeExecutionModeEvent, nil, nil, 0, userIDStr, username, vars, "", clientIP, clientPort, "")
+				fn(evtCtx, &api.Event{
+					Name: RuntimeEventRefreshTokenReuse,
+					Properties: map[string]string{
+						"session_id": tokenId,
+						"client_ip":  clientIP,
+						"user_agent": device.UserAgent,
+					},
+					Timestamp: timestamppb.Now(),
+					External:  false,
+				})
+			}
+
+			return nil, status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
+		}
+	} else {
+		refreshToken, refreshTokenExp = generateRefreshToken(s.config, tokenId, tokenIssuedAt, userIDStr, username, useVars)
+		s.sessionCache.Add(userID, tokenExp, tokenId, refreshTokenExp, tokenId, device)
+	}
 	session := &api.Session{Created: false, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
@@ -139,3 +168,69 @@ func (s *ApiServer) SessionLogout(ctx context.Context, in *api.SessionLogoutRequ
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *ApiServer) ListSessions(ctx context.Context, in *emptypb.Empty) (*apigrpc.ActiveSessionList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+	tokenID, _ := ctx.Value(ctxTokenIDKey{}).(string)
+
+	sessions := s.sessionCache.List(userID)
+	list := &apigrpc.ActiveSessionList{Sessions: make([]*apigrpc.ActiveSession, 0, len(sessions))}
+	for _, active := range sessions {
+		session := &apigrpc.ActiveSession{
+			Id:         active.TokenID,
+			AuthMethod: active.AuthMethod,
+			ClientIp:   active.ClientIP,
+			UserAgent:  active.UserAgent,
+			CreateTime: &timestamppb.Timestamp{Seconds: active.IssueTime},
+			ExpireTime: &timestamppb.Timestamp{Seconds: max(active.SessionExpiry, active.RefreshExpiry)},
+			Current:    active.TokenID == tokenID,
+		}
+		if active.RefreshTime > 0 {
+			session.RefreshTime = &timestamppb.Timestamp{Seconds: active.RefreshTime}
+		}
+		list.Sessions = append(list.Sessions, session)
+	}
+
+	return list, nil
+}
+
+func (s *ApiServer) RevokeSession(ctx context.Context, in *apigrpc.RevokeSessionRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	if in.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Session ID is required.")
+	}
+
+	if err := SessionRevoke(s.sessionCache, userID, in.Id); err != nil {
+		if err == ErrActiveSessionNotFound {
+			return nil, status.Error(codes.NotFound, "Session not found.")
+		}
+		s.logger.Error("Error revoking session.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error revoking session.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Describe the client a session is being issued to from the incoming request.
+func sessionDeviceFromContext(logger *zap.Logger, ctx context.Context, authMethod string) *SessionDevice {
+	clientIP, _ := extractClientAddressFromContext(logger, ctx)
+
+	var userAgent string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ua := md.Get("grpcgateway-user-agent"); len(ua) > 0 {
+		// Requests through the HTTP gateway.
+		userAgent = ua[0]
+	} else if ua := md.Get("user-agent"); len(ua) > 0 {
+		userAgent = ua[0]
+	}
+	if utf8.RuneCountInString(userAgent) > 256 {
+		userAgent = string([]rune(userAgent)[:256])
+	}
+
+	return &SessionDevice{
+		AuthMethod: authMethod,
+		ClientIP:   clientIP,
+		UserAgent:  strings.ToValidUTF8(userAgent, ""),
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ChannelRetentionScheduler periodically removes persisted channel messages outside of their retention policy.
+type ChannelRetentionScheduler interface {
+	Stop()
+}
+
+type LocalChannelRetentionScheduler struct {
+	logger *zap.Logger
+	db     *sql.DB
+	config *ChatRetentionConfig
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewLocalChannelRetentionScheduler(logger *zap.Logger, db *sql.DB, config Config) ChannelRetentionScheduler {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	s := &LocalChannelRetentionScheduler{
+		logger: logger,
+		db:     db,
+		config: config.GetChat().Retention,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.config.IntervalSec) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := channelRetentionPurge(s.ctx, s.logger, s.db, s.config); err != nil && s.ctx.Err() == nil {
+					s.logger.Error("Failed to remove channel messages outside retention policy", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *LocalChannelRetentionScheduler) Stop() {
+	s.ctxCancelFn()
+}
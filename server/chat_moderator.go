@@ -0,0 +1,438 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+const (
+	chatModerationActionMask   = "mask"
+	chatModerationActionReject = "reject"
+	chatModerationActionShadow = "shadow"
+	chatModerationActionMuted  = "muted"
+)
+
+var chatModerationPolicies = map[string]struct{}{
+	"none":                     {},
+	chatModerationActionMask:   {},
+	chatModerationActionReject: {},
+	chatModerationActionShadow: {},
+}
+
+var (
+	errChannelMessageRejected    = errors.New("channel message rejected by moderation")
+	errChannelMessageSenderMuted = errors.New("channel message sender is muted")
+)
+
+// Common character substitutions normalized before matching when leetspeak matching is enabled.
+var chatLeetspeakReplacer = strings.NewReplacer(
+	"0", "o",
+	"1", "i",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+	"8", "b",
+	"9", "g",
+	"@", "a",
+	"$", "s",
+	"!", "i",
+	"|", "l",
+	"+", "t",
+)
+
+const chatLeetspeakSymbols = "@$!|+"
+
+// ChatModerator applies word filters and user mutes to chat messages sent by clients.
+type ChatModerator interface {
+	// Moderate checks a message from the given sender, and returns the content to deliver. If shadow is true the
+	// message must only be delivered back to its sender.
+	Moderate(ctx context.Context, logger *zap.Logger, stream PresenceStream, channelID, messageID, senderID, content string) (moderated string, shadow bool, err error)
+	Mute(ctx context.Context, logger *zap.Logger, userID uuid.UUID, reason string, expiryTime time.Time) error
+	Unmute(ctx context.Context, logger *zap.Logger, userID uuid.UUID) error
+	Stop()
+}
+
+type LocalChatModerator struct {
+	logger   *zap.Logger
+	db       *sql.DB
+	config   *ChatModerationConfig
+	filter   *chatWordFilter
+	maskRune rune
+
+	mutesMutex sync.RWMutex
+	// Muted user IDs to their mute expiry time, zero for mutes that do not expire.
+	mutes map[uuid.UUID]time.Time
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewLocalChatModerator(logger *zap.Logger, db *sql.DB, config Config) ChatModerator {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	moderationConfig := config.GetChat().Moderation
+	words := moderationConfig.Words
+	if moderationConfig.WordsFile != "" {
+		fileWords, err := chatModerationReadWordsFile(moderationConfig.WordsFile)
+		if err != nil {
+			logger.Fatal("Failed to read chat moderation words file", zap.String("chat.moderation.words_file", moderationConfig.WordsFile), zap.Error(err))
+		}
+		words = append(append(make([]string, 0, len(words)+len(fileWords)), words...), fileWords...)
+	}
+	maskRune, _ := utf8.DecodeRuneInString(moderationConfig.MaskCharacter)
+
+	m := &LocalChatModerator{
+		logger:   logger,
+		db:       db,
+		config:   moderationConfig,
+		filter:   newChatWordFilter(words, moderationConfig.Leetspeak),
+		maskRune: maskRune,
+
+		mutes: make(map[uuid.UUID]time.Time),
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	m.refreshMutes()
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(moderationConfig.MuteRefreshSec) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+				m.refreshMutes()
+			}
+		}
+	}()
+
+	return m
+}
+
+func (m *LocalChatModerator) Stop() {
+	m.ctxCancelFn()
+}
+
+func (m *LocalChatModerator) Moderate(ctx context.Context, logger *zap.Logger, stream PresenceStream, channelID, messageID, senderID, content string) (string, bool, error) {
+	userID, err := uuid.FromString(senderID)
+	if err != nil {
+		// Not a user message, nothing to moderate.
+		return content, false, nil
+	}
+
+	if m.isMuted(userID) {
+		m.audit(ctx, logger, userID, channelID, messageID, chatModerationActionMuted, content, nil)
+		return "", false, errChannelMessageSenderMuted
+	}
+
+	var policy string
+	switch stream.Mode {
+	case StreamModeChannel:
+		policy = m.config.RoomPolicy
+	case StreamModeGroup:
+		policy = m.config.GroupPolicy
+	case StreamModeDM:
+		policy = m.config.DirectPolicy
+	}
+	if m.filter.empty() || policy == "" || policy == "none" {
+		return content, false, nil
+	}
+
+	masked, matches, err := m.filter.filterJSON(content, m.maskRune)
+	if err != nil {
+		// Content is validated as a JSON object before it reaches moderation.
+		logger.Warn("Could not parse chat message content for moderation", zap.Error(err))
+		return content, false, nil
+	}
+	if len(matches) == 0 {
+		return content, false, nil
+	}
+
+	m.audit(ctx, logger, userID, channelID, messageID, policy, content, matches)
+
+	switch policy {
+	case chatModerationActionReject:
+		return "", false, errChannelMessageRejected
+	case chatModerationActionShadow:
+		return content, true, nil
+	default:
+		return masked, false, nil
+	}
+}
+
+func (m *LocalChatModerator) Mute(ctx context.Context, logger *zap.Logger, userID uuid.UUID, reason string, expiryTime time.Time) error {
+	if err := ChatMuteAdd(ctx, logger, m.db, userID, reason, expiryTime); err != nil {
+		return err
+	}
+
+	m.mutesMutex.Lock()
+	m.mutes[userID] = expiryTime
+	m.mutesMutex.Unlock()
+
+	return nil
+}
+
+func (m *LocalChatModerator) Unmute(ctx context.Context, logger *zap.Logger, userID uuid.UUID) error {
+	if err := ChatMuteDelete(ctx, logger, m.db, userID); err != nil {
+		return err
+	}
+
+	m.mutesMutex.Lock()
+	delete(m.mutes, userID)
+	m.mutesMutex.Unlock()
+
+	return nil
+}
+
+func (m *LocalChatModerator) isMuted(userID uuid.UUID) bool {
+	m.mutesMutex.RLock()
+	expiryTime, found := m.mutes[userID]
+	m.mutesMutex.RUnlock()
+
+	return found && (expiryTime.IsZero() || expiryTime.After(time.Now()))
+}
+
+func (m *LocalChatModerator) refreshMutes() {
+	rows, err := m.db.QueryContext(m.ctx, "SELECT user_id, expiry_time FROM chat_mute WHERE expiry_time IS NULL OR expiry_time > now()")
+	if err != nil {
+		if m.ctx.Err() == nil {
+			m.logger.Error("Failed to load chat mutes", zap.Error(err))
+		}
+		return
+	}
+	defer rows.Close()
+
+	mutes := make(map[uuid.UUID]time.Time)
+	for rows.Next() {
+		var userID uuid.UUID
+		var expiryTime pgtype.Timestamptz
+		if err := rows.Scan(&userID, &expiryTime); err != nil {
+			m.logger.Error("Failed to scan chat mutes", zap.Error(err))
+			return
+		}
+		mutes[userID] = expiryTime.Time
+	}
+	if err := rows.Err(); err != nil {
+		m.logger.Error("Failed to load chat mutes", zap.Error(err))
+		return
+	}
+
+	m.mutesMutex.Lock()
+	m.mutes = mutes
+	m.mutesMutex.Unlock()
+}
+
+func (m *LocalChatModerator) audit(ctx context.Context, logger *zap.Logger, userID uuid.UUID, channelID, messageID, action, content string, matches []string) {
+	if matches == nil {
+		matches = []string{}
+	}
+	query := `INSERT INTO chat_moderation_audit (id, user_id, channel_id, message_id, action, content, matched_words)
+VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	if _, err := m.db.ExecContext(ctx, query, uuid.Must(uuid.NewV4()), userID, channelID, messageID, action, content, matches); err != nil {
+		// Moderation still applies even if the audit trail cannot be written.
+		logger.Error("Failed to write chat moderation audit entry", zap.Error(err), zap.String("user_id", userID.String()), zap.String("action", action))
+	}
+}
+
+func chatModerationReadWordsFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	words := make([]string, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+
+	return words, scanner.Err()
+}
+
+// Matches whole words in text against a list of filtered words, with optional '*' wildcards.
+type chatWordFilter struct {
+	exact     map[string]struct{}
+	wildcards []*regexp.Regexp
+	leetspeak bool
+}
+
+func newChatWordFilter(words []string, leetspeak bool) *chatWordFilter {
+	f := &chatWordFilter{
+		exact:     make(map[string]struct{}, len(words)),
+		leetspeak: leetspeak,
+	}
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if strings.Trim(word, "*") == "" {
+			// A wildcard on its own would match every word.
+			continue
+		}
+		if !strings.Contains(word, "*") {
+			f.exact[word] = struct{}{}
+			continue
+		}
+		parts := strings.Split(word, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		f.wildcards = append(f.wildcards, regexp.MustCompile("^"+strings.Join(parts, ".*")+"$"))
+	}
+	return f
+}
+
+func (f *chatWordFilter) empty() bool {
+	return len(f.exact) == 0 && len(f.wildcards) == 0
+}
+
+func (f *chatWordFilter) match(word string) bool {
+	if _, found := f.exact[word]; found {
+		return true
+	}
+	for _, wildcard := range f.wildcards {
+		if wildcard.MatchString(word) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *chatWordFilter) matchWord(word string) bool {
+	lower := strings.ToLower(word)
+	return f.match(lower) || (f.leetspeak && f.match(chatLeetspeakReplacer.Replace(lower)))
+}
+
+func (f *chatWordFilter) isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || (f.leetspeak && strings.ContainsRune(chatLeetspeakSymbols, r))
+}
+
+// Filter returns the text with each matched word replaced by the mask character, and the words that matched.
+func (f *chatWordFilter) filter(text string, mask rune) (string, []string) {
+	var matches []string
+	var builder strings.Builder
+	start := -1
+	flush := func(end int) {
+		word := text[start:end]
+		prefix, suffix := "", ""
+		if !f.matchWord(word) {
+			// Symbols at either end may be punctuation rather than substitutions, so also try without them.
+			trimmed := strings.Trim(word, chatLeetspeakSymbols)
+			if f.leetspeak && trimmed != "" && trimmed != word && f.matchWord(trimmed) {
+				i := strings.Index(word, trimmed)
+				prefix, word, suffix = word[:i], trimmed, word[i+len(trimmed):]
+			} else {
+				word = ""
+			}
+		}
+		if word != "" {
+			matches = append(matches, word)
+			builder.WriteString(prefix)
+			builder.WriteString(strings.Repeat(string(mask), utf8.RuneCountInString(word)))
+			builder.WriteString(suffix)
+		} else {
+			builder.WriteString(text[start:end])
+		}
+		start = -1
+	}
+	for i, r := range text {
+		if f.isWordRune(r) {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+		if start != -1 {
+			flush(i)
+		}
+		builder.WriteRune(r)
+	}
+	if start != -1 {
+		flush(len(text))
+	}
+
+	if len(matches) == 0 {
+		return text, nil
+	}
+	return builder.String(), matches
+}
+
+// FilterJSON applies the filter to every string value in a JSON document. The document is only re-encoded if
+// any words were matched.
+func (f *chatWordFilter) filterJSON(content string, mask rune) (string, []string, error) {
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", nil, err
+	}
+
+	var matches []string
+	var walk func(v any) any
+	walk = func(v any) any {
+		switch v := v.(type) {
+		case string:
+			filtered, m := f.filter(v, mask)
+			matches = append(matches, m...)
+			return filtered
+		case map[string]any:
+			for key, item := range v {
+				v[key] = walk(item)
+			}
+		case []any:
+			for i, item := range v {
+				v[i] = walk(item)
+			}
+		}
+		return v
+	}
+	value = walk(value)
+	if len(matches) == 0 {
+		return content, nil, nil
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", nil, err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), matches, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatWordFilter(t *testing.T) {
+	filter := newChatWordFilter([]string{"darn", "heck*", "*", "  Gosh "}, true)
+
+	tests := []struct {
+		name    string
+		text    string
+		masked  string
+		matches []string
+	}{
+		{"no match", "hello there", "hello there", nil},
+		{"exact", "oh darn it", "oh **** it", []string{"darn"}},
+		{"case insensitive", "oh DARN it", "oh **** it", []string{"DARN"}},
+		{"whole words only", "darning socks", "darning socks", nil},
+		{"wildcard", "what the heckity", "what the *******", []string{"heckity"}},
+		{"trimmed config word", "gosh!", "****!", []string{"gosh"}},
+		{"leetspeak digits", "d4rn", "****", []string{"d4rn"}},
+		{"leetspeak symbols", "g0$h", "****", []string{"g0$h"}},
+		{"trailing punctuation", "darn!!", "****!!", []string{"darn"}},
+		{"unicode neighbours", "¡darn, darn!", "¡****, ****!", []string{"darn", "darn"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			masked, matches := filter.filter(tt.text, '*')
+			assert.Equal(t, tt.masked, masked)
+			assert.Equal(t, tt.matches, matches)
+		})
+	}
+}
+
+func TestChatWordFilterLeetspeakDisabled(t *testing.T) {
+	filter := newChatWordFilter([]string{"darn"}, false)
+
+	masked, matches := filter.filter("d4rn darn", '#')
+	assert.Equal(t, "d4rn ####", masked)
+	assert.Equal(t, []string{"darn"}, matches)
+}
+
+func TestChatWordFilterJSON(t *testing.T) {
+	filter := newChatWordFilter([]string{"darn"}, true)
+
+	t.Run("unchanged content is not re-encoded", func(t *testing.T) {
+		content := `{ "message": "hello", "count": 1.50 }`
+		masked, matches, err := filter.filterJSON(content, '*')
+		require.NoError(t, err)
+		assert.Equal(t, content, masked)
+		assert.Empty(t, matches)
+	})
+
+	t.Run("nested string values are masked", func(t *testing.T) {
+		content := `{"message":"darn <b>","darn":["ok","darn"],"count":1.50}`
+		masked, matches, err := filter.filterJSON(content, '*')
+		require.NoError(t, err)
+		assert.Equal(t, []string{"darn", "darn"}, matches)
+
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal([]byte(masked), &decoded))
+		assert.Equal(t, "**** <b>", decoded["message"])
+		assert.Equal(t, []any{"ok", "****"}, decoded["darn"], "object keys are not masked")
+		assert.Contains(t, masked, `"count":1.50`)
+		assert.Contains(t, masked, "<b>", "HTML characters are not escaped")
+	})
+}
@@ -27,8 +27,10 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 // Config interface is the Nakama core configuration.
@@ -53,6 +55,14 @@ type Config interface {
 	GetSatori() *SatoriConfig
 	GetStorage() *StorageConfig
 	GetMFA() *MFAConfig
+	GetNotification() *NotificationConfig
+	GetChat() *ChatConfig
+	GetRateLimit() *RateLimitConfig
+	GetGroup() *GroupConfig
+	GetFriend() *FriendConfig
+	GetMail() *MailConfig
+	GetAccount() *AccountConfig
+	GetLockout() *LockoutConfig
 	GetLimit() int
 
 	Clone() (Config, error)
@@ -115,6 +125,14 @@ func ParseArgs(logger *zap.Logger, args []string) Config {
 	}
 	sort.Strings(mainConfig.GetRuntime().Env)
 
+	if mainConfig.GetSession().SigningMethod != "" && mainConfig.GetSession().SigningMethod != SessionSigningMethodHS256 {
+		keys, err := LoadSessionSigningKeys(mainConfig.GetSession().SigningMethod, mainConfig.GetSession().SigningKeyFile, mainConfig.GetSession().VerificationKeyFiles)
+		if err != nil {
+			logger.Fatal("Failed to load session token signing keys", zap.Error(err), zap.Strings("param", []string{"session.signing_method", "session.signing_key_file", "session.verification_key_files"}))
+		}
+		mainConfig.GetSession().SigningKeys = keys
+	}
+
 	if mainConfig.GetGoogleAuth() != nil && mainConfig.GetGoogleAuth().CredentialsJSON != "" {
 		cnf, err := google.ConfigFromJSON([]byte(mainConfig.GetGoogleAuth().CredentialsJSON))
 		if err != nil {
@@ -159,6 +177,28 @@ func ValidateConfig(logger *zap.Logger, c Config) map[string]string {
 	if c.GetSession().SingleParty && !c.GetSession().SingleSocket {
 		logger.Fatal("Single party cannot be enabled without single socket", zap.Strings("param", []string{"session.single_party", "session.single_socket"}))
 	}
+	switch c.GetSession().SigningMethod {
+	case SessionSigningMethodHS256, SessionSigningMethodRS256, SessionSigningMethodES256, SessionSigningMethodEdDSA:
+	default:
+		logger.Fatal("Session signing method must be 'HS256', 'RS256', 'ES256' or 'EdDSA'", zap.String("param", "session.signing_method"))
+	}
+	if c.GetSession().Cache != SessionCacheLocal && c.GetSession().Cache != SessionCacheDatabase {
+		logger.Fatal("Session cache must be 'local' or 'database'", zap.String("param", "session.cache"))
+	}
+	for name, provider := range c.GetSocial().OIDC {
+		if name == "" || len(name) > 128 || invalidCharsRegex.MatchString(name) {
+			logger.Fatal("OpenID Connect provider name must be 1-128 characters with no spaces or control characters", zap.String("param", "social.oidc"), zap.String("provider", name))
+		}
+		if provider == nil || provider.Issuer == "" {
+			logger.Fatal("OpenID Connect provider issuer must be set", zap.String("param", "social.oidc."+name+".issuer"))
+		}
+		if len(provider.ClientIDs) == 0 {
+			logger.Fatal("OpenID Connect provider must have at least one client ID", zap.String("param", "social.oidc."+name+".client_ids"))
+		}
+		if provider.JWKSURL == "" {
+			logger.Fatal("OpenID Connect provider JWKS URL must be set", zap.String("param", "social.oidc."+name+".jwks_url"))
+		}
+	}
 	if c.GetRuntime().HTTPKey == "" {
 		logger.Fatal("Runtime HTTP key must be set", zap.String("param", "runtime.http_key"))
 	}
@@ -417,6 +457,185 @@ func ValidateConfig(logger *zap.Logger, c Config) map[string]string {
 	if k := c.GetMFA().StorageEncryptionKey; k != "" && len(k) != 32 {
 		logger.Fatal("MFA encryption key has to be 32 bits long")
 	}
+	if c.GetMFA().EnrollmentExpirySec < 1 {
+		logger.Fatal("MFA enrollment expiry seconds must be >= 1", zap.Int("mfa.enrollment_expiry_sec", c.GetMFA().EnrollmentExpirySec))
+	}
+	if c.GetMFA().ChallengeExpirySec < 1 {
+		logger.Fatal("MFA challenge expiry seconds must be >= 1", zap.Int("mfa.challenge_expiry_sec", c.GetMFA().ChallengeExpirySec))
+	}
+
+	if c.GetNotification().ExpiryCleanupSec < 1 {
+		logger.Fatal("Notification expiry cleanup seconds must be >= 1", zap.Int("notification.expiry_cleanup_sec", c.GetNotification().ExpiryCleanupSec))
+	}
+	if c.GetNotification().ScheduleIntervalMs < 100 {
+		logger.Fatal("Notification schedule interval milliseconds must be >= 100", zap.Int("notification.schedule_interval_ms", c.GetNotification().ScheduleIntervalMs))
+	}
+	if c.GetNotification().ScheduleBatchSize < 1 {
+		logger.Fatal("Notification schedule batch size must be >= 1", zap.Int("notification.schedule_batch_size", c.GetNotification().ScheduleBatchSize))
+	}
+	if c.GetNotification().PushQueueSize < 1 {
+		logger.Fatal("Notification push queue size must be >= 1", zap.Int("notification.push_queue_size", c.GetNotification().PushQueueSize))
+	}
+	if c.GetNotification().PushQueueWorkers < 1 {
+		logger.Fatal("Notification push queue workers must be >= 1", zap.Int("notification.push_queue_workers", c.GetNotification().PushQueueWorkers))
+	}
+	if c.GetNotification().MaxPushDevices < 1 {
+		logger.Fatal("Notification max push devices must be >= 1", zap.Int("notification.max_push_devices", c.GetNotification().MaxPushDevices))
+	}
+	for key, policy := range map[string]string{
+		"chat.moderation.room_policy":   c.GetChat().Moderation.RoomPolicy,
+		"chat.moderation.group_policy":  c.GetChat().Moderation.GroupPolicy,
+		"chat.moderation.direct_policy": c.GetChat().Moderation.DirectPolicy,
+	} {
+		if _, ok := chatModerationPolicies[policy]; !ok {
+			logger.Fatal("Chat moderation policy must be one of 'none', 'mask', 'reject' or 'shadow'", zap.String(key, policy))
+		}
+	}
+	if utf8.RuneCountInString(c.GetChat().Moderation.MaskCharacter) != 1 {
+		logger.Fatal("Chat moderation mask character must be a single character", zap.String("chat.moderation.mask_character", c.GetChat().Moderation.MaskCharacter))
+	}
+	if c.GetChat().Moderation.MuteRefreshSec < 1 {
+		logger.Fatal("Chat moderation mute refresh seconds must be >= 1", zap.Int("chat.moderation.mute_refresh_sec", c.GetChat().Moderation.MuteRefreshSec))
+	}
+	for key, value := range map[string]int{
+		"chat.retention.room_max_age_sec":    c.GetChat().Retention.RoomMaxAgeSec,
+		"chat.retention.room_max_messages":   c.GetChat().Retention.RoomMaxMessages,
+		"chat.retention.group_max_age_sec":   c.GetChat().Retention.GroupMaxAgeSec,
+		"chat.retention.group_max_messages":  c.GetChat().Retention.GroupMaxMessages,
+		"chat.retention.direct_max_age_sec":  c.GetChat().Retention.DirectMaxAgeSec,
+		"chat.retention.direct_max_messages": c.GetChat().Retention.DirectMaxMessages,
+	} {
+		if value < 0 {
+			logger.Fatal("Chat retention limits must be >= 0", zap.Int(key, value))
+		}
+	}
+	if c.GetChat().Retention.IntervalSec < 1 {
+		logger.Fatal("Chat retention interval seconds must be >= 1", zap.Int("chat.retention.interval_sec", c.GetChat().Retention.IntervalSec))
+	}
+	if c.GetChat().Retention.BatchSize < 1 {
+		logger.Fatal("Chat retention batch size must be >= 1", zap.Int("chat.retention.batch_size", c.GetChat().Retention.BatchSize))
+	}
+	for key, value := range map[string]float64{
+		"rate_limit.realtime.chat_rate":         c.GetRateLimit().Realtime.ChatRate,
+		"rate_limit.realtime.data_rate":         c.GetRateLimit().Realtime.DataRate,
+		"rate_limit.realtime.rpc_rate":          c.GetRateLimit().Realtime.RpcRate,
+		"rate_limit.realtime.other_rate":        c.GetRateLimit().Realtime.OtherRate,
+		"rate_limit.realtime.channel_chat_rate": c.GetRateLimit().Realtime.ChannelChatRate,
+	} {
+		if value < 0 {
+			logger.Fatal("Realtime rate limits must be >= 0", zap.Float64(key, value))
+		}
+	}
+	for key, value := range map[string]int{
+		"rate_limit.realtime.chat_burst":         c.GetRateLimit().Realtime.ChatBurst,
+		"rate_limit.realtime.data_burst":         c.GetRateLimit().Realtime.DataBurst,
+		"rate_limit.realtime.rpc_burst":          c.GetRateLimit().Realtime.RpcBurst,
+		"rate_limit.realtime.other_burst":        c.GetRateLimit().Realtime.OtherBurst,
+		"rate_limit.realtime.channel_chat_burst": c.GetRateLimit().Realtime.ChannelChatBurst,
+	} {
+		if value < 1 {
+			logger.Fatal("Realtime rate limit bursts must be >= 1", zap.Int(key, value))
+		}
+	}
+	if c.GetRateLimit().Realtime.CloseThreshold < 0 {
+		logger.Fatal("Realtime rate limit close threshold must be >= 0", zap.Int("rate_limit.realtime.close_threshold", c.GetRateLimit().Realtime.CloseThreshold))
+	}
+	if c.GetRateLimit().Realtime.CloseWindowSec < 1 {
+		logger.Fatal("Realtime rate limit close window seconds must be >= 1", zap.Int("rate_limit.realtime.close_window_sec", c.GetRateLimit().Realtime.CloseWindowSec))
+	}
+	if c.GetRateLimit().Api.IpRate < 0 {
+		logger.Fatal("API rate limit per IP must be >= 0", zap.Float64("rate_limit.api.ip_rate", c.GetRateLimit().Api.IpRate))
+	}
+	if c.GetRateLimit().Api.IpBurst < 1 {
+		logger.Fatal("API rate limit burst per IP must be >= 1", zap.Int("rate_limit.api.ip_burst", c.GetRateLimit().Api.IpBurst))
+	}
+	if c.GetRateLimit().Api.MethodRate < 0 {
+		logger.Fatal("API rate limit per method must be >= 0", zap.Float64("rate_limit.api.method_rate", c.GetRateLimit().Api.MethodRate))
+	}
+	if c.GetRateLimit().Api.MethodBurst < 1 {
+		logger.Fatal("API rate limit burst per method must be >= 1", zap.Int("rate_limit.api.method_burst", c.GetRateLimit().Api.MethodBurst))
+	}
+	if c.GetGroup().InviteExpirySec < 1 {
+		logger.Fatal("Group invite expiry seconds must be >= 1", zap.Int("group.invite_expiry_sec", c.GetGroup().InviteExpirySec))
+	}
+	if c.GetGroup().MaxPendingInvites < 0 {
+		logger.Fatal("Group max pending invites must be >= 0", zap.Int("group.max_pending_invites", c.GetGroup().MaxPendingInvites))
+	}
+	if c.GetFriend().InviteExpirySec < 0 {
+		logger.Fatal("Friend invite expiry seconds must be >= 0", zap.Int("friend.invite_expiry_sec", c.GetFriend().InviteExpirySec))
+	}
+	if c.GetFriend().InviteCleanupSec < 1 {
+		logger.Fatal("Friend invite cleanup seconds must be >= 1", zap.Int("friend.invite_cleanup_sec", c.GetFriend().InviteCleanupSec))
+	}
+	if c.GetFriend().MaxPendingOutgoing < 0 {
+		logger.Fatal("Friend max pending outgoing invites must be >= 0", zap.Int("friend.max_pending_outgoing", c.GetFriend().MaxPendingOutgoing))
+	}
+	if c.GetFriend().MaxPendingIncoming < 0 {
+		logger.Fatal("Friend max pending incoming invites must be >= 0", zap.Int("friend.max_pending_incoming", c.GetFriend().MaxPendingIncoming))
+	}
+	if c.GetFriend().MaxMessageLength < 0 {
+		logger.Fatal("Friend invite max message length must be >= 0", zap.Int("friend.max_message_length", c.GetFriend().MaxMessageLength))
+	}
+	if c.GetFriend().RecommendationCacheSec < 0 {
+		logger.Fatal("Friend recommendation cache seconds must be >= 0", zap.Int("friend.recommendation_cache_sec", c.GetFriend().RecommendationCacheSec))
+	}
+	if c.GetFriend().RecommendationMatchWindowSec < 0 {
+		logger.Fatal("Friend recommendation match window seconds must be >= 0", zap.Int("friend.recommendation_match_window_sec", c.GetFriend().RecommendationMatchWindowSec))
+	}
+	if c.GetFriend().RecommendationMaxGroupSize < 0 {
+		logger.Fatal("Friend recommendation max group size must be >= 0", zap.Int("friend.recommendation_max_group_size", c.GetFriend().RecommendationMaxGroupSize))
+	}
+	switch c.GetMail().Sender {
+	case MailSenderNone, MailSenderRuntime:
+	case MailSenderLog:
+		logger.Warn("WARNING: account emails are written to the server log, including password reset codes. Do not use in production!", zap.String("param", "mail.sender"))
+		configWarnings["mail.sender"] = "Account emails are written to the server log, do not use in production!"
+	case MailSenderSMTP:
+		if c.GetMail().SmtpHost == "" {
+			logger.Fatal("SMTP host must be set when sending email with SMTP", zap.String("param", "mail.smtp_host"))
+		}
+		if c.GetMail().SmtpPort < 1 || c.GetMail().SmtpPort > 65535 {
+			logger.Fatal("SMTP port must be between 1 and 65535", zap.Int("mail.smtp_port", c.GetMail().SmtpPort))
+		}
+		if c.GetMail().From == "" {
+			logger.Fatal("Sender address must be set when sending email with SMTP", zap.String("param", "mail.from"))
+		}
+	default:
+		logger.Fatal("Mail sender must be empty, 'log', 'smtp' or 'runtime'", zap.String("param", "mail.sender"))
+	}
+	if c.GetMail().VerificationExpirySec < 1 {
+		logger.Fatal("Email verification expiry seconds must be >= 1", zap.Int("mail.verification_expiry_sec", c.GetMail().VerificationExpirySec))
+	}
+	if c.GetMail().PasswordResetExpirySec < 1 {
+		logger.Fatal("Password reset expiry seconds must be >= 1", zap.Int("mail.password_reset_expiry_sec", c.GetMail().PasswordResetExpirySec))
+	}
+	if c.GetMail().ResendIntervalSec < 0 {
+		logger.Fatal("Email resend interval seconds must be >= 0", zap.Int("mail.resend_interval_sec", c.GetMail().ResendIntervalSec))
+	}
+	if c.GetAccount().DeletionGracePeriodSec < 0 {
+		logger.Fatal("Account deletion grace period seconds must be >= 0", zap.Int("account.deletion_grace_period_sec", c.GetAccount().DeletionGracePeriodSec))
+	}
+	if c.GetAccount().DeletionIntervalSec < 1 {
+		logger.Fatal("Account deletion interval seconds must be >= 1", zap.Int("account.deletion_interval_sec", c.GetAccount().DeletionIntervalSec))
+	}
+	if c.GetLockout().AccountMaxAttempts < 0 {
+		logger.Fatal("Lockout account max attempts must be >= 0", zap.Int("lockout.account_max_attempts", c.GetLockout().AccountMaxAttempts))
+	}
+	if c.GetLockout().AccountWindowSec < 1 {
+		logger.Fatal("Lockout account window seconds must be >= 1", zap.Int("lockout.account_window_sec", c.GetLockout().AccountWindowSec))
+	}
+	if c.GetLockout().AccountLockoutSec < 1 {
+		logger.Fatal("Lockout account lockout seconds must be >= 1", zap.Int("lockout.account_lockout_sec", c.GetLockout().AccountLockoutSec))
+	}
+	if c.GetLockout().IpMaxAttempts < 0 {
+		logger.Fatal("Lockout IP max attempts must be >= 0", zap.Int("lockout.ip_max_attempts", c.GetLockout().IpMaxAttempts))
+	}
+	if c.GetLockout().IpWindowSec < 1 {
+		logger.Fatal("Lockout IP window seconds must be >= 1", zap.Int("lockout.ip_window_sec", c.GetLockout().IpWindowSec))
+	}
+	if c.GetLockout().IpLockoutSec < 1 {
+		logger.Fatal("Lockout IP lockout seconds must be >= 1", zap.Int("lockout.ip_lockout_sec", c.GetLockout().IpLockoutSec))
+	}
 
 	return configWarnings
 }
@@ -458,28 +677,36 @@ func convertRuntimeEnv(logger *zap.Logger, existingEnv map[string]string, mergeE
 }
 
 type config struct {
-	Name             string             `yaml:"name" json:"name" usage:"Nakama server’s node name - must be unique."`
-	Config           []string           `yaml:"config" json:"config" usage:"The absolute file path to configuration YAML file."`
-	ShutdownGraceSec int                `yaml:"shutdown_grace_sec" json:"shutdown_grace_sec" usage:"Maximum number of seconds to wait for the server to complete work before shutting down. Default is 0 seconds. If 0 the server will shut down immediately when it receives a termination signal."`
-	Datadir          string             `yaml:"data_dir" json:"data_dir" usage:"An absolute path to a writeable folder where Nakama will store its data."`
-	Logger           *LoggerConfig      `yaml:"logger" json:"logger" usage:"Logger levels and output."`
-	Metrics          *MetricsConfig     `yaml:"metrics" json:"metrics" usage:"Metrics settings."`
-	Session          *SessionConfig     `yaml:"session" json:"session" usage:"Session authentication settings."`
-	Socket           *SocketConfig      `yaml:"socket" json:"socket" usage:"Socket configuration."`
-	Database         *DatabaseConfig    `yaml:"database" json:"database" usage:"Database connection settings."`
-	Social           *SocialConfig      `yaml:"social" json:"social" usage:"Properties for social provider integrations."`
-	Runtime          *RuntimeConfig     `yaml:"runtime" json:"runtime" usage:"Script Runtime properties."`
-	Match            *MatchConfig       `yaml:"match" json:"match" usage:"Authoritative realtime match properties."`
-	Tracker          *TrackerConfig     `yaml:"tracker" json:"tracker" usage:"Presence tracker properties."`
-	Console          *ConsoleConfig     `yaml:"console" json:"console" usage:"Console settings."`
-	Leaderboard      *LeaderboardConfig `yaml:"leaderboard" json:"leaderboard" usage:"Leaderboard settings."`
-	Matchmaker       *MatchmakerConfig  `yaml:"matchmaker" json:"matchmaker" usage:"Matchmaker settings."`
-	IAP              *IAPConfig         `yaml:"iap" json:"iap" usage:"In-App Purchase settings."`
-	GoogleAuth       *GoogleAuthConfig  `yaml:"google_auth" json:"google_auth" usage:"Google's auth settings."`
-	Satori           *SatoriConfig      `yaml:"satori" json:"satori" usage:"Satori integration settings."`
-	Storage          *StorageConfig     `yaml:"storage" json:"storage" usage:"Storage settings."`
-	MFA              *MFAConfig         `yaml:"mfa" json:"mfa" usage:"MFA settings."`
-	Limit            int                `json:"-"` // Only used for migrate command.
+	Name             string              `yaml:"name" json:"name" usage:"Nakama server’s node name - must be unique."`
+	Config           []string            `yaml:"config" json:"config" usage:"The absolute file path to configuration YAML file."`
+	ShutdownGraceSec int                 `yaml:"shutdown_grace_sec" json:"shutdown_grace_sec" usage:"Maximum number of seconds to wait for the server to complete work before shutting down. Default is 0 seconds. If 0 the server will shut down immediately when it receives a termination signal."`
+	Datadir          string              `yaml:"data_dir" json:"data_dir" usage:"An absolute path to a writeable folder where Nakama will store its data."`
+	Logger           *LoggerConfig       `yaml:"logger" json:"logger" usage:"Logger levels and output."`
+	Metrics          *MetricsConfig      `yaml:"metrics" json:"metrics" usage:"Metrics settings."`
+	Session          *SessionConfig      `yaml:"session" json:"session" usage:"Session authentication settings."`
+	Socket           *SocketConfig       `yaml:"socket" json:"socket" usage:"Socket configuration."`
+	Database         *DatabaseConfig     `yaml:"database" json:"database" usage:"Database connection settings."`
+	Social           *SocialConfig       `yaml:"social" json:"social" usage:"Properties for social provider integrations."`
+	Runtime          *RuntimeConfig      `yaml:"runtime" json:"runtime" usage:"Script Runtime properties."`
+	Match            *MatchConfig        `yaml:"match" json:"match" usage:"Authoritative realtime match properties."`
+	Tracker          *TrackerConfig      `yaml:"tracker" json:"tracker" usage:"Presence tracker properties."`
+	Console          *ConsoleConfig      `yaml:"console" json:"console" usage:"Console settings."`
+	Leaderboard      *LeaderboardConfig  `yaml:"leaderboard" json:"leaderboard" usage:"Leaderboard settings."`
+	Matchmaker       *MatchmakerConfig   `yaml:"matchmaker" json:"matchmaker" usage:"Matchmaker settings."`
+	IAP              *IAPConfig          `yaml:"iap" json:"iap" usage:"In-App Purchase settings."`
+	GoogleAuth       *GoogleAuthConfig   `yaml:"google_auth" json:"google_auth" usage:"Google's auth settings."`
+	Satori           *SatoriConfig       `yaml:"satori" json:"satori" usage:"Satori integration settings."`
+	Storage          *StorageConfig      `yaml:"storage" json:"storage" usage:"Storage settings."`
+	MFA              *MFAConfig          `yaml:"mfa" json:"mfa" usage:"MFA settings."`
+	Notification     *NotificationConfig `yaml:"notification" json:"notification" usage:"Notification delivery settings."`
+	Chat             *ChatConfig         `yaml:"chat" json:"chat" usage:"Realtime chat settings."`
+	RateLimit        *RateLimitConfig    `yaml:"rate_limit" json:"rate_limit" usage:"Client request rate limiting settings."`
+	Group            *GroupConfig        `yaml:"group" json:"group" usage:"Group settings."`
+	Friend           *FriendConfig       `yaml:"friend" json:"friend" usage:"Friend settings."`
+	Mail             *MailConfig         `yaml:"mail" json:"mail" usage:"Account email delivery settings."`
+	Account          *AccountConfig      `yaml:"account" json:"account" usage:"User account lifecycle settings."`
+	Lockout          *LockoutConfig      `yaml:"lockout" json:"lockout" usage:"Failed login attempt lockout settings."`
+	Limit            int                 `json:"-"` // Only used for migrate command.
 }
 
 // NewConfig constructs a Config struct which represents server settings, and populates it with default values.
@@ -509,6 +736,14 @@ func NewConfig(logger *zap.Logger) *config {
 		Satori:           NewSatoriConfig(),
 		Storage:          NewStorageConfig(),
 		MFA:              NewMFAConfig(),
+		Notification:     NewNotificationConfig(),
+		Chat:             NewChatConfig(),
+		RateLimit:        NewRateLimitConfig(),
+		Group:            NewGroupConfig(),
+		Friend:           NewFriendConfig(),
+		Mail:             NewMailConfig(),
+		Account:          NewAccountConfig(),
+		Lockout:          NewLockoutConfig(),
 		Limit:            -1,
 	}
 }
@@ -540,6 +775,14 @@ func (c *config) Clone() (Config, error) {
 		GoogleAuth:       c.GoogleAuth.Clone(),
 		Storage:          c.Storage.Clone(),
 		MFA:              c.MFA.Clone(),
+		Notification:     c.Notification.Clone(),
+		Chat:             c.Chat.Clone(),
+		RateLimit:        c.RateLimit.Clone(),
+		Group:            c.Group.Clone(),
+		Friend:           c.Friend.Clone(),
+		Mail:             c.Mail.Clone(),
+		Account:          c.Account.Clone(),
+		Lockout:          c.Lockout.Clone(),
 		Limit:            c.Limit,
 	}
 
@@ -626,6 +869,38 @@ func (c *config) GetMFA() *MFAConfig {
 	return c.MFA
 }
 
+func (c *config) GetNotification() *NotificationConfig {
+	return c.Notification
+}
+
+func (c *config) GetChat() *ChatConfig {
+	return c.Chat
+}
+
+func (c *config) GetRateLimit() *RateLimitConfig {
+	return c.RateLimit
+}
+
+func (c *config) GetGroup() *GroupConfig {
+	return c.Group
+}
+
+func (c *config) GetFriend() *FriendConfig {
+	return c.Friend
+}
+
+func (c *config) GetMail() *MailConfig {
+	return c.Mail
+}
+
+func (c *config) GetAccount() *AccountConfig {
+	return c.Account
+}
+
+func (c *config) GetLockout() *LockoutConfig {
+	return c.Lockout
+}
+
 func (c *config) GetRuntimeConfig() (runtime.Config, error) {
 	clone, err := c.Clone()
 	if err != nil {
@@ -746,6 +1021,13 @@ type SessionConfig struct {
 	SingleMatch           bool   `yaml:"single_match" json:"single_match" usage:"Only allow one match per user. Older matches receive a leave. Requires single socket to enable. Default false."`
 	SingleParty           bool   `yaml:"single_party" json:"single_party" usage:"Only allow one party per user. Older parties receive a leave. Requires single socket to enable. Default false."`
 	SingleSession         bool   `yaml:"single_session" json:"single_session" usage:"Only allow one session token per user. Older session tokens are invalidated in the session cache. Default false."`
+	Cache                 string `yaml:"cache" json:"cache" usage:"Where invalidated session and refresh tokens are tracked. 'local' keeps them in memory only, 'database' also persists them so they remain invalid after a restart. Default 'local'."`
+	RefreshTokenRotation  bool   `yaml:"refresh_token_rotation" json:"refresh_token_rotation" usage:"Issue a new refresh token on every session refresh and invalidate the one presented. Presenting a refresh token that was already rotated logs out the session it belongs to. Default false."`
+	SigningMethod         string `yaml:"signing_method" json:"signing_method" usage:"Algorithm session tokens are signed with. 'HS256' uses the encryption key. 'RS256', 'ES256' and 'EdDSA' use the private key in the signing key file, and publish public keys at /.well-known/jwks.json so other services can verify session tokens. Default 'HS256'."`
+	SigningKeyFile        string `yaml:"signing_key_file" json:"signing_key_file" usage:"Path to a PEM encoded private key session tokens are signed with, when using an asymmetric signing method."`
+	// Listing the previous signing key here when switching to a new one keeps tokens it signed valid until they expire.
+	VerificationKeyFiles []string            `yaml:"verification_key_files" json:"verification_key_files" usage:"Paths to PEM encoded keys that previously signed session tokens, which are still accepted and published when using an asymmetric signing method."`
+	SigningKeys          *SessionSigningKeys `yaml:"-" json:"-"`
 }
 
 func (cfg *SessionConfig) GetEncryptionKey() string {
@@ -786,6 +1068,10 @@ func (cfg *SessionConfig) Clone() *SessionConfig {
 	}
 
 	cfgCopy := *cfg
+	if cfg.VerificationKeyFiles != nil {
+		cfgCopy.VerificationKeyFiles = make([]string, len(cfg.VerificationKeyFiles))
+		copy(cfgCopy.VerificationKeyFiles, cfg.VerificationKeyFiles)
+	}
 	return &cfgCopy
 }
 
@@ -795,6 +1081,9 @@ func NewSessionConfig() *SessionConfig {
 		TokenExpirySec:        60,
 		RefreshEncryptionKey:  "defaultrefreshencryptionkey",
 		RefreshTokenExpirySec: 3600,
+		Cache:                 SessionCacheLocal,
+		SigningMethod:         SessionSigningMethodHS256,
+		VerificationKeyFiles:  make([]string, 0),
 	}
 }
 
@@ -944,6 +1233,7 @@ type SocialConfig struct {
 	FacebookInstantGame  *SocialConfigFacebookInstantGame  `yaml:"facebook_instant_game" json:"facebook_instant_game" usage:"Facebook Instant Game configuration."`
 	FacebookLimitedLogin *SocialConfigFacebookLimitedLogin `yaml:"facebook_limited_login" json:"facebook_limited_login" usage:"Facebook Limited Login configuration."`
 	Apple                *SocialConfigApple                `yaml:"apple" json:"apple" usage:"Apple Sign In configuration."`
+	OIDC                 map[string]*SocialConfigOIDC      `yaml:"oidc" json:"oidc" usage:"OpenID Connect identity providers, keyed by the provider name clients authenticate with."`
 }
 
 func (cfg *SocialConfig) GetSteam() runtime.SocialConfigSteam {
@@ -985,6 +1275,17 @@ func (cfg *SocialConfig) Clone() *SocialConfig {
 		c := *(cfg.Apple)
 		cfgCopy.Apple = &c
 	}
+	if cfg.OIDC != nil {
+		cfgCopy.OIDC = make(map[string]*SocialConfigOIDC, len(cfg.OIDC))
+		for name, provider := range cfg.OIDC {
+			if provider == nil {
+				continue
+			}
+			c := *provider
+			c.ClientIDs = slices.Clone(provider.ClientIDs)
+			cfgCopy.OIDC[name] = &c
+		}
+	}
 
 	return &cfgCopy
 }
@@ -1038,6 +1339,18 @@ func (s SocialConfigApple) GetBundleId() string {
 	return s.BundleId
 }
 
+// SocialConfigOIDC is configuration relevant to a generic OpenID Connect identity provider. Claim names default to
+// the standard OpenID Connect claims when not set.
+type SocialConfigOIDC struct {
+	Issuer           string   `yaml:"issuer" json:"issuer" usage:"Expected issuer of ID tokens, must match the 'iss' claim exactly."`
+	ClientIDs        []string `yaml:"client_ids" json:"client_ids" usage:"Client IDs ID tokens may be issued to, one of which must match the 'aud' claim."`
+	JWKSURL          string   `yaml:"jwks_url" json:"jwks_url" usage:"URL of the JSON Web Key Set used to verify ID token signatures."`
+	SubjectClaim     string   `yaml:"subject_claim" json:"subject_claim" usage:"Claim holding the user's unique ID at the provider. Default 'sub'."`
+	EmailClaim       string   `yaml:"email_claim" json:"email_claim" usage:"Claim holding the user's email address, imported when verified. Default 'email'."`
+	DisplayNameClaim string   `yaml:"display_name_claim" json:"display_name_claim" usage:"Claim holding the user's display name. Default 'name'."`
+	AvatarURLClaim   string   `yaml:"avatar_url_claim" json:"avatar_url_claim" usage:"Claim holding the user's avatar URL. Default 'picture'."`
+}
+
 func NewSocialConfig() *SocialConfig {
 	return &SocialConfig{
 		Steam: &SocialConfigSteam{
@@ -1590,6 +1903,9 @@ func NewStorageConfig() *StorageConfig {
 type MFAConfig struct {
 	StorageEncryptionKey string `yaml:"storage_encryption_key" json:"storage_encryption_key" usage:"The encryption key to be used when persisting MFA related data. Has to be 32 bytes long."`
 	AdminAccountOn       bool   `yaml:"admin_account_enabled" json:"admin_account_enabled" usage:"Require MFA for the Console Admin account."`
+	Issuer               string `yaml:"issuer" json:"issuer" usage:"Name shown for player accounts in authenticator apps. Default 'Nakama'."`
+	EnrollmentExpirySec  int    `yaml:"enrollment_expiry_sec" json:"enrollment_expiry_sec" usage:"Number of seconds a player has to confirm MFA enrollment with a code from their authenticator app. Default 900, 15 minutes."`
+	ChallengeExpirySec   int    `yaml:"challenge_expiry_sec" json:"challenge_expiry_sec" usage:"Number of seconds a player has to complete the second authentication step with an MFA code after giving their password. Default 300, 5 minutes."`
 }
 
 func (cfg *MFAConfig) Clone() *MFAConfig {
@@ -1605,5 +1921,352 @@ func NewMFAConfig() *MFAConfig {
 	return &MFAConfig{
 		StorageEncryptionKey: "the-key-has-to-be-32-bytes-long!", // Has to be 32 bit long.
 		AdminAccountOn:       false,
+		Issuer:               "Nakama",
+		EnrollmentExpirySec:  900,
+		ChallengeExpirySec:   300,
+	}
+}
+
+type NotificationConfig struct {
+	ExpiryCleanupSec   int                     `yaml:"expiry_cleanup_sec" json:"expiry_cleanup_sec" usage:"Interval in seconds between removals of expired notifications. Default 300."`
+	ScheduleIntervalMs int                     `yaml:"schedule_interval_ms" json:"schedule_interval_ms" usage:"Interval in milliseconds between checks for scheduled notifications that are due to be sent. Default 1000."`
+	ScheduleBatchSize  int                     `yaml:"schedule_batch_size" json:"schedule_batch_size" usage:"Maximum number of due scheduled notifications sent in each check. Default 100."`
+	PushQueueSize      int                     `yaml:"push_queue_size" json:"push_queue_size" usage:"Size of the push notification delivery queue buffer. Default 1024."`
+	PushQueueWorkers   int                     `yaml:"push_queue_workers" json:"push_queue_workers" usage:"Number of workers to use for concurrent delivery of push notifications. Default 4."`
+	MaxPushDevices     int                     `yaml:"max_push_devices" json:"max_push_devices" usage:"Maximum number of push notification device tokens each user may register. The least recently registered tokens are removed first. Default 10."`
+	Fcm                *NotificationFcmConfig  `yaml:"fcm" json:"fcm" usage:"Firebase Cloud Messaging push notification delivery configuration."`
+	Apns               *NotificationApnsConfig `yaml:"apns" json:"apns" usage:"Apple Push Notification service push notification delivery configuration."`
+}
+
+func (cfg *NotificationConfig) Clone() *NotificationConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+
+	if cfg.Fcm != nil {
+		c := *(cfg.Fcm)
+		cfgCopy.Fcm = &c
+	}
+	if cfg.Apns != nil {
+		c := *(cfg.Apns)
+		cfgCopy.Apns = &c
+	}
+
+	return &cfgCopy
+}
+
+func NewNotificationConfig() *NotificationConfig {
+	return &NotificationConfig{
+		ExpiryCleanupSec:   300,
+		ScheduleIntervalMs: 1000,
+		ScheduleBatchSize:  100,
+		PushQueueSize:      1024,
+		PushQueueWorkers:   4,
+		MaxPushDevices:     10,
+		Fcm: &NotificationFcmConfig{
+			Url:      "https://fcm.googleapis.com",
+			TokenUrl: "https://oauth2.googleapis.com/token",
+		},
+		Apns: &NotificationApnsConfig{
+			Url: "https://api.push.apple.com",
+		},
+	}
+}
+
+type NotificationFcmConfig struct {
+	ProjectId   string `yaml:"project_id" json:"project_id" usage:"Firebase project ID."`
+	ClientEmail string `yaml:"client_email" json:"client_email" usage:"Google Service Account client email."`
+	PrivateKey  string `yaml:"private_key" json:"private_key" usage:"Google Service Account private key."`
+	Url         string `yaml:"url" json:"url" usage:"Firebase Cloud Messaging API base URL. Default 'https://fcm.googleapis.com'."`
+	TokenUrl    string `yaml:"token_url" json:"token_url" usage:"Google OAuth2 token URL used to authorize Firebase Cloud Messaging requests. Default 'https://oauth2.googleapis.com/token'."`
+}
+
+func (cfg *NotificationFcmConfig) Enabled() bool {
+	return cfg.ProjectId != "" && cfg.ClientEmail != "" && cfg.PrivateKey != ""
+}
+
+type NotificationApnsConfig struct {
+	KeyId      string `yaml:"key_id" json:"key_id" usage:"Apple Push Notification service authentication key ID."`
+	TeamId     string `yaml:"team_id" json:"team_id" usage:"Apple developer team ID."`
+	BundleId   string `yaml:"bundle_id" json:"bundle_id" usage:"App bundle ID, used as the push notification topic."`
+	PrivateKey string `yaml:"private_key" json:"private_key" usage:"Apple Push Notification service authentication private key, PEM encoded."`
+	Url        string `yaml:"url" json:"url" usage:"Apple Push Notification service base URL. Use 'https://api.sandbox.push.apple.com' for development builds. Default 'https://api.push.apple.com'."`
+}
+
+func (cfg *NotificationApnsConfig) Enabled() bool {
+	return cfg.KeyId != "" && cfg.TeamId != "" && cfg.BundleId != "" && cfg.PrivateKey != ""
+}
+
+type ChatConfig struct {
+	Moderation *ChatModerationConfig `yaml:"moderation" json:"moderation" usage:"Chat message moderation settings."`
+	Retention  *ChatRetentionConfig  `yaml:"retention" json:"retention" usage:"Chat message history retention settings."`
+}
+
+func (cfg *ChatConfig) Clone() *ChatConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+
+	if cfg.Moderation != nil {
+		c := *(cfg.Moderation)
+		c.Words = slices.Clone(cfg.Moderation.Words)
+		cfgCopy.Moderation = &c
+	}
+	if cfg.Retention != nil {
+		c := *(cfg.Retention)
+		cfgCopy.Retention = &c
+	}
+
+	return &cfgCopy
+}
+
+func NewChatConfig() *ChatConfig {
+	return &ChatConfig{
+		Moderation: &ChatModerationConfig{
+			Leetspeak:      true,
+			RoomPolicy:     "mask",
+			GroupPolicy:    "mask",
+			DirectPolicy:   "mask",
+			MaskCharacter:  "*",
+			MuteRefreshSec: 10,
+		},
+		Retention: &ChatRetentionConfig{
+			IntervalSec: 3600,
+			BatchSize:   1000,
+		},
+	}
+}
+
+type ChatModerationConfig struct {
+	Words          []string `yaml:"words" json:"words" usage:"Words filtered from chat messages sent by clients, matched case-insensitively against whole words. A '*' matches any sequence of characters."`
+	WordsFile      string   `yaml:"words_file" json:"words_file" usage:"Path to a file of additional filtered words, one per line. Lines starting with '#' are ignored."`
+	Leetspeak      bool     `yaml:"leetspeak" json:"leetspeak" usage:"Also match filtered words written with common character substitutions such as '4' for 'a' or '$' for 's'. Default true."`
+	RoomPolicy     string   `yaml:"room_policy" json:"room_policy" usage:"Action taken on room chat messages containing filtered words: 'none', 'mask', 'reject' or 'shadow'. Shadowed messages are only delivered back to their sender. Default 'mask'."`
+	GroupPolicy    string   `yaml:"group_policy" json:"group_policy" usage:"Action taken on group chat messages containing filtered words: 'none', 'mask', 'reject' or 'shadow'. Default 'mask'."`
+	DirectPolicy   string   `yaml:"direct_policy" json:"direct_policy" usage:"Action taken on direct chat messages containing filtered words: 'none', 'mask', 'reject' or 'shadow'. Default 'mask'."`
+	MaskCharacter  string   `yaml:"mask_character" json:"mask_character" usage:"Character used to replace each character of a masked word. Default '*'."`
+	MuteRefreshSec int      `yaml:"mute_refresh_sec" json:"mute_refresh_sec" usage:"Interval in seconds between reloads of muted users, so mutes made on other nodes take effect. Default 10."`
+}
+
+type ChatRetentionConfig struct {
+	RoomMaxAgeSec     int `yaml:"room_max_age_sec" json:"room_max_age_sec" usage:"Maximum age in seconds of persisted room chat messages before they are removed. Default 0, keep messages forever."`
+	RoomMaxMessages   int `yaml:"room_max_messages" json:"room_max_messages" usage:"Maximum number of persisted messages kept in each chat room, older messages are removed first. Default 0, no limit."`
+	GroupMaxAgeSec    int `yaml:"group_max_age_sec" json:"group_max_age_sec" usage:"Maximum age in seconds of persisted group chat messages before they are removed. Default 0, keep messages forever."`
+	GroupMaxMessages  int `yaml:"group_max_messages" json:"group_max_messages" usage:"Maximum number of persisted messages kept in each group chat, older messages are removed first. Default 0, no limit."`
+	DirectMaxAgeSec   int `yaml:"direct_max_age_sec" json:"direct_max_age_sec" usage:"Maximum age in seconds of persisted direct chat messages before they are removed. Default 0, keep messages forever."`
+	DirectMaxMessages int `yaml:"direct_max_messages" json:"direct_max_messages" usage:"Maximum number of persisted messages kept in each direct chat, older messages are removed first. Default 0, no limit."`
+	IntervalSec       int `yaml:"interval_sec" json:"interval_sec" usage:"Interval in seconds between removals of chat messages outside their retention policy. Default 3600."`
+	BatchSize         int `yaml:"batch_size" json:"batch_size" usage:"Maximum number of chat messages removed in each database operation. Default 1000."`
+}
+
+type RateLimitConfig struct {
+	Realtime *RealtimeRateLimitConfig `yaml:"realtime" json:"realtime" usage:"Per-session rate limits for realtime socket messages."`
+	Api      *ApiRateLimitConfig      `yaml:"api" json:"api" usage:"Rate limits for HTTP and gRPC API requests, including RPCs."`
+}
+
+func (cfg *RateLimitConfig) Clone() *RateLimitConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+
+	if cfg.Realtime != nil {
+		c := *(cfg.Realtime)
+		cfgCopy.Realtime = &c
+	}
+	if cfg.Api != nil {
+		c := *(cfg.Api)
+		cfgCopy.Api = &c
+	}
+
+	return &cfgCopy
+}
+
+func NewRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Realtime: &RealtimeRateLimitConfig{
+			ChatBurst:        10,
+			DataBurst:        100,
+			RpcBurst:         20,
+			OtherBurst:       20,
+			ChannelChatBurst: 50,
+			CloseWindowSec:   10,
+		},
+		Api: &ApiRateLimitConfig{
+			IpBurst:     100,
+			MethodBurst: 10,
+		},
+	}
+}
+
+type RealtimeRateLimitConfig struct {
+	ChatRate         float64 `yaml:"chat_rate" json:"chat_rate" usage:"Sustained number of channel messages sent, updated or removed per second allowed for each session. Default 0, unlimited."`
+	ChatBurst        int     `yaml:"chat_burst" json:"chat_burst" usage:"Number of channel messages a session may send at once before the chat rate applies. Default 10."`
+	DataRate         float64 `yaml:"data_rate" json:"data_rate" usage:"Sustained number of match and party data messages per second allowed for each session. Default 0, unlimited."`
+	DataBurst        int     `yaml:"data_burst" json:"data_burst" usage:"Number of match and party data messages a session may send at once before the data rate applies. Default 100."`
+	RpcRate          float64 `yaml:"rpc_rate" json:"rpc_rate" usage:"Sustained number of RPC calls per second allowed for each session over the socket. Default 0, unlimited."`
+	RpcBurst         int     `yaml:"rpc_burst" json:"rpc_burst" usage:"Number of RPC calls a session may make at once before the RPC rate applies. Default 20."`
+	OtherRate        float64 `yaml:"other_rate" json:"other_rate" usage:"Sustained number of all other realtime messages per second allowed for each session, excluding pings and pongs. Default 0, unlimited."`
+	OtherBurst       int     `yaml:"other_burst" json:"other_burst" usage:"Number of other realtime messages a session may send at once before the other rate applies. Default 20."`
+	ChannelChatRate  float64 `yaml:"channel_chat_rate" json:"channel_chat_rate" usage:"Sustained number of channel messages sent, updated or removed per second allowed in each channel, across all sessions on this node. Default 0, unlimited."`
+	ChannelChatBurst int     `yaml:"channel_chat_burst" json:"channel_chat_burst" usage:"Number of channel messages a channel may receive at once before the channel chat rate applies. Default 50."`
+	CloseThreshold   int     `yaml:"close_threshold" json:"close_threshold" usage:"Number of rate limited messages within the close window after which a session is disconnected. Default 0, never disconnect."`
+	CloseWindowSec   int     `yaml:"close_window_sec" json:"close_window_sec" usage:"Window in seconds over which rate limited messages are counted towards the close threshold. Default 10."`
+}
+
+type ApiRateLimitConfig struct {
+	IpRate      float64 `yaml:"ip_rate" json:"ip_rate" usage:"Sustained number of API requests per second allowed from each client IP address, across all methods. Default 0, unlimited."`
+	IpBurst     int     `yaml:"ip_burst" json:"ip_burst" usage:"Number of API requests a client IP address may make at once before the IP rate applies. Default 100."`
+	MethodRate  float64 `yaml:"method_rate" json:"method_rate" usage:"Sustained number of requests per second allowed to each API method or RPC function, per user ID if authenticated or client IP address otherwise. RPC functions may override this when registered. Default 0, unlimited."`
+	MethodBurst int     `yaml:"method_burst" json:"method_burst" usage:"Number of requests a caller may make at once to each API method or RPC function before the method rate applies. Default 10."`
+}
+
+type GroupConfig struct {
+	InviteExpirySec   int `yaml:"invite_expiry_sec" json:"invite_expiry_sec" usage:"Number of seconds a group invite remains valid before it expires. Default 604800, 7 days."`
+	MaxPendingInvites int `yaml:"max_pending_invites" json:"max_pending_invites" usage:"Maximum number of pending invites each group may have outstanding at once. Default 100, 0 for no limit."`
+}
+
+func (cfg *GroupConfig) Clone() *GroupConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+	return &cfgCopy
+}
+
+func NewGroupConfig() *GroupConfig {
+	return &GroupConfig{
+		InviteExpirySec:   604800,
+		MaxPendingInvites: 100,
+	}
+}
+
+type FriendConfig struct {
+	InviteExpirySec    int `yaml:"invite_expiry_sec" json:"invite_expiry_sec" usage:"Number of seconds a pending friend invite remains valid before it expires. Default 0, invites never expire."`
+	InviteCleanupSec   int `yaml:"invite_cleanup_sec" json:"invite_cleanup_sec" usage:"Interval in seconds between removals of expired friend invites, when invites expire. Default 300."`
+	MaxPendingOutgoing int `yaml:"max_pending_outgoing" json:"max_pending_outgoing" usage:"Maximum number of pending friend invites each user may have sent at once. Default 0, no limit."`
+	MaxPendingIncoming int `yaml:"max_pending_incoming" json:"max_pending_incoming" usage:"Maximum number of pending friend invites each user may have received at once, further invites to them are rejected. Default 0, no limit."`
+	MaxMessageLength   int `yaml:"max_message_length" json:"max_message_length" usage:"Maximum length in characters of the message attached to a friend invite. Default 256."`
+
+	RecommendationCacheSec       int     `yaml:"recommendation_cache_sec" json:"recommendation_cache_sec" usage:"Number of seconds a user's computed friend recommendations are cached for. Default 300."`
+	RecommendationMatchWindowSec int     `yaml:"recommendation_match_window_sec" json:"recommendation_match_window_sec" usage:"Number of seconds users who joined the same match are considered for each other's friend recommendations. Default 604800, 7 days. 0 to disable."`
+	RecommendationMaxGroupSize   int     `yaml:"recommendation_max_group_size" json:"recommendation_max_group_size" usage:"Groups with more members than this are not considered when recommending friends. Default 100."`
+	RecommendationMutualWeight   float64 `yaml:"recommendation_mutual_weight" json:"recommendation_mutual_weight" usage:"Score added to a friend recommendation for each mutual friend. Default 1.0."`
+	RecommendationGroupWeight    float64 `yaml:"recommendation_group_weight" json:"recommendation_group_weight" usage:"Score added to a friend recommendation for each shared group. Default 0.5."`
+	RecommendationMatchWeight    float64 `yaml:"recommendation_match_weight" json:"recommendation_match_weight" usage:"Score added to a friend recommendation for each recent match played together. Default 0.5."`
+}
+
+func (cfg *FriendConfig) Clone() *FriendConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+	return &cfgCopy
+}
+
+func NewFriendConfig() *FriendConfig {
+	return &FriendConfig{
+		InviteExpirySec:    0,
+		InviteCleanupSec:   300,
+		MaxPendingOutgoing: 0,
+		MaxPendingIncoming: 0,
+		MaxMessageLength:   256,
+
+		RecommendationCacheSec:       300,
+		RecommendationMatchWindowSec: 604800,
+		RecommendationMaxGroupSize:   100,
+		RecommendationMutualWeight:   1.0,
+		RecommendationGroupWeight:    0.5,
+		RecommendationMatchWeight:    0.5,
+	}
+}
+
+type MailConfig struct {
+	Sender                 string `yaml:"sender" json:"sender" usage:"How account emails are delivered. 'log' writes them to the server log for local testing, 'smtp' sends them through an SMTP server, and 'runtime' passes them to runtime event handlers to deliver. Default empty, account emails and the email verification and password reset APIs are disabled."`
+	From                   string `yaml:"from" json:"from" usage:"Sender address of account emails."`
+	SmtpHost               string `yaml:"smtp_host" json:"smtp_host" usage:"Host name of the SMTP server to send account emails through."`
+	SmtpPort               int    `yaml:"smtp_port" json:"smtp_port" usage:"Port of the SMTP server to send account emails through. Default 587."`
+	SmtpUsername           string `yaml:"smtp_username" json:"smtp_username" usage:"Username to authenticate with the SMTP server, if it requires authentication."`
+	SmtpPassword           string `yaml:"smtp_password" json:"smtp_password" usage:"Password to authenticate with the SMTP server, if it requires authentication."`
+	VerificationURL        string `yaml:"verification_url" json:"verification_url" usage:"Link included in email verification emails, with the verification code added as a 'code' query parameter. Only the code is sent if not set."`
+	PasswordResetURL       string `yaml:"password_reset_url" json:"password_reset_url" usage:"Link included in password reset emails, with the reset code added as a 'code' query parameter. Only the code is sent if not set."`
+	VerificationExpirySec  int    `yaml:"verification_expiry_sec" json:"verification_expiry_sec" usage:"Number of seconds an email verification code remains valid. Default 86400, 24 hours."`
+	PasswordResetExpirySec int    `yaml:"password_reset_expiry_sec" json:"password_reset_expiry_sec" usage:"Number of seconds a password reset code remains valid. Default 3600, 1 hour."`
+	ResendIntervalSec      int    `yaml:"resend_interval_sec" json:"resend_interval_sec" usage:"Minimum number of seconds before another code of the same kind is sent to a user. Default 60."`
+}
+
+func (cfg *MailConfig) Clone() *MailConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+	return &cfgCopy
+}
+
+func NewMailConfig() *MailConfig {
+	return &MailConfig{
+		Sender:                 MailSenderNone,
+		SmtpPort:               587,
+		VerificationExpirySec:  86400,
+		PasswordResetExpirySec: 3600,
+		ResendIntervalSec:      60,
+	}
+}
+
+type AccountConfig struct {
+	DeletionGracePeriodSec int `yaml:"deletion_grace_period_sec" json:"deletion_grace_period_sec" usage:"Number of seconds accounts deleted by their owner stay disabled before they are permanently deleted, during which they can be restored from the console. Default 0, accounts are deleted immediately."`
+	DeletionIntervalSec    int `yaml:"deletion_interval_sec" json:"deletion_interval_sec" usage:"Interval in seconds between checks for accounts whose deletion grace period has passed. Default 60."`
+}
+
+func (cfg *AccountConfig) Clone() *AccountConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+	return &cfgCopy
+}
+
+func NewAccountConfig() *AccountConfig {
+	return &AccountConfig{
+		DeletionGracePeriodSec: 0,
+		DeletionIntervalSec:    60,
+	}
+}
+
+type LockoutConfig struct {
+	AccountMaxAttempts int `yaml:"account_max_attempts" json:"account_max_attempts" usage:"Number of failed login attempts for an account within the account window that locks the account out. Set to 0 to disable account lockouts. Default 5."`
+	AccountWindowSec   int `yaml:"account_window_sec" json:"account_window_sec" usage:"Number of seconds failed login attempts for an account are counted towards a lockout. Default 60."`
+	AccountLockoutSec  int `yaml:"account_lockout_sec" json:"account_lockout_sec" usage:"Number of seconds an account stays locked out. Default 60."`
+	IpMaxAttempts      int `yaml:"ip_max_attempts" json:"ip_max_attempts" usage:"Number of failed login attempts from an IP address within the IP window that locks the address out. Set to 0 to disable IP lockouts. Default 0."`
+	IpWindowSec        int `yaml:"ip_window_sec" json:"ip_window_sec" usage:"Number of seconds failed login attempts from an IP address are counted towards a lockout. Default 600."`
+	IpLockoutSec       int `yaml:"ip_lockout_sec" json:"ip_lockout_sec" usage:"Number of seconds an IP address stays locked out. Default 600."`
+}
+
+func (cfg *LockoutConfig) Clone() *LockoutConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	cfgCopy := *cfg
+	return &cfgCopy
+}
+
+func NewLockoutConfig() *LockoutConfig {
+	return &LockoutConfig{
+		AccountMaxAttempts: 5,
+		AccountWindowSec:   60,
+		AccountLockoutSec:  60,
+		IpMaxAttempts:      0,
+		IpWindowSec:        600,
+		IpLockoutSec:       600,
 	}
 }
@@ -49,17 +49,22 @@ var restrictedMethods = map[string]console.UserRole{
 	// Account
 	"/nakama.console.Console/BanAccount":         console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/UnbanAccount":       console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/ResetAccountMfa":    console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/RestoreAccount":     console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/DeleteAccount":      console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/DeleteAccounts":     console.UserRole_USER_ROLE_DEVELOPER,
 	"/nakama.console.Console/DeleteFriend":       console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/DeleteGroupUser":    console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/DeleteSession":      console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/DeleteWalletLedger": console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/ExportAccount":      console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/GetAccount":         console.UserRole_USER_ROLE_READONLY,
 	"/nakama.console.Console/GetFriends":         console.UserRole_USER_ROLE_READONLY,
 	"/nakama.console.Console/GetGroups":          console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/GetSessions":        console.UserRole_USER_ROLE_READONLY,
 	"/nakama.console.Console/GetWalletLedger":    console.UserRole_USER_ROLE_READONLY,
 	"/nakama.console.Console/ListAccounts":       console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/MergeAccounts":      console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/UpdateAccount":      console.UserRole_USER_ROLE_MAINTAINER,
 
 	// Authenticate
@@ -74,7 +79,12 @@ var restrictedMethods = map[string]console.UserRole{
 	"/nakama.console.Console/GetConfig":     console.UserRole_USER_ROLE_DEVELOPER,
 	"/nakama.console.Console/DeleteAllData": console.UserRole_USER_ROLE_DEVELOPER,
 
+	// Lockout
+	"/nakama.console.Console/ListLockouts":   console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/DeleteLockouts": console.UserRole_USER_ROLE_MAINTAINER,
+
 	// Group
+	"/nakama.console.Console/ListGroupEvents":    console.UserRole_USER_ROLE_READONLY,
 	"/nakama.console.Console/ListGroups":         console.UserRole_USER_ROLE_READONLY,
 	"/nakama.console.Console/DeleteGroup":        console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/GetGroup":           console.UserRole_USER_ROLE_READONLY,
@@ -99,10 +109,18 @@ var restrictedMethods = map[string]console.UserRole{
 	"/nakama.console.Console/ListChannelMessages":   console.UserRole_USER_ROLE_READONLY,
 	"/nakama.console.Console/DeleteChannelMessages": console.UserRole_USER_ROLE_MAINTAINER,
 
+	// Chat moderation
+	"/nakama.console.Console/ListChatModerationAudit": console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/ListChatMutes":           console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/AddChatMute":             console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/DeleteChatMute":          console.UserRole_USER_ROLE_MAINTAINER,
+
 	// Notifications
-	"/nakama.console.Console/GetNotification":    console.UserRole_USER_ROLE_READONLY,
-	"/nakama.console.Console/ListNotifications":  console.UserRole_USER_ROLE_READONLY,
-	"/nakama.console.Console/DeleteNotification": console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/GetNotification":             console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/ListNotifications":           console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/DeleteNotification":          console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/ListScheduledNotifications":  console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/DeleteScheduledNotification": console.UserRole_USER_ROLE_MAINTAINER,
 
 	// Purchase
 	"/nakama.console.Console/ListPurchases": console.UserRole_USER_ROLE_READONLY,
@@ -155,6 +173,7 @@ type ConsoleServer struct {
 	config               Config
 	tracker              Tracker
 	router               MessageRouter
+	chatModerator        ChatModerator
 	streamManager        StreamManager
 	metrics              Metrics
 	sessionCache         SessionCache
@@ -165,6 +184,7 @@ type ConsoleServer struct {
 	matchRegistry        MatchRegistry
 	statusHandler        StatusHandler
 	storageIndex         StorageIndex
+	groupIndex           GroupIndex
 	runtimeInfo          *RuntimeInfo
 	configWarnings       map[string]string
 	serverVersion        string
@@ -180,7 +200,7 @@ type ConsoleServer struct {
 	httpClient           *http.Client
 }
 
-func StartConsoleServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, config Config, tracker Tracker, router MessageRouter, streamManager StreamManager, metrics Metrics, sessionRegistry SessionRegistry, sessionCache SessionCache, consoleSessionCache SessionCache, loginAttemptCache LoginAttemptCache, statusRegistry StatusRegistry, statusHandler StatusHandler, runtimeInfo *RuntimeInfo, matchRegistry MatchRegistry, configWarnings map[string]string, serverVersion string, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, storageIndex StorageIndex, api *ApiServer, runtime *Runtime, cookie string) *ConsoleServer {
+func StartConsoleServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, config Config, tracker Tracker, router MessageRouter, chatModerator ChatModerator, streamManager StreamManager, metrics Metrics, sessionRegistry SessionRegistry, sessionCache SessionCache, consoleSessionCache SessionCache, loginAttemptCache LoginAttemptCache, statusRegistry StatusRegistry, statusHandler StatusHandler, runtimeInfo *RuntimeInfo, matchRegistry MatchRegistry, configWarnings map[string]string, serverVersion string, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, storageIndex StorageIndex, groupIndex GroupIndex, api *ApiServer, runtime *Runtime, cookie string) *ConsoleServer {
 	var gatewayContextTimeoutMs string
 	if config.GetConsole().IdleTimeoutMs > 500 {
 		// Ensure the GRPC Gateway timeout is just under the idle timeout (if possible) to ensure it has priority.
@@ -204,6 +224,7 @@ func StartConsoleServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.D
 		config:               config,
 		tracker:              tracker,
 		router:               router,
+		chatModerator:        chatModerator,
 		streamManager:        streamManager,
 		metrics:              metrics,
 		sessionRegistry:      sessionRegistry,
@@ -222,6 +243,7 @@ func StartConsoleServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.D
 		leaderboardRankCache: leaderboardRankCache,
 		leaderboardScheduler: leaderboardScheduler,
 		storageIndex:         storageIndex,
+		groupIndex:           groupIndex,
 		api:                  api,
 		cookie:               cookie,
 		httpClient:           &http.Client{Timeout: 5 * time.Second},
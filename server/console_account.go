@@ -71,6 +71,9 @@ func (s *ConsoleServer) UnbanAccount(ctx context.Context, in *console.AccountId)
 	}
 
 	if err := UnbanUsers(ctx, s.logger, s.db, s.sessionCache, []uuid.UUID{userID}); err != nil {
+		if err == ErrAccountDeletionPending {
+			return nil, status.Error(codes.FailedPrecondition, "Account is scheduled for deletion, restore it instead.")
+		}
 		// Error logged in the core function above.
 		return nil, status.Error(codes.Internal, "An error occurred while trying to unban the user.")
 	}
@@ -78,13 +81,77 @@ func (s *ConsoleServer) UnbanAccount(ctx context.Context, in *console.AccountId)
 	return &emptypb.Empty{}, nil
 }
 
+func (s *ConsoleServer) ResetAccountMfa(ctx context.Context, in *console.AccountId) (*emptypb.Empty, error) {
+	userID, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
+	}
+	if userID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "Cannot reset MFA for the system user.")
+	}
+
+	if err = ResetMFA(ctx, s.logger, s.db, userID); err != nil {
+		// Error logged in the core function above.
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleServer) RestoreAccount(ctx context.Context, in *console.AccountId) (*emptypb.Empty, error) {
+	userID, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
+	}
+
+	if err = RestoreAccount(ctx, s.logger, s.db, s.sessionCache, userID); err != nil {
+		if err == ErrAccountDeletionNotFound {
+			return nil, status.Error(codes.FailedPrecondition, "Account is not scheduled for deletion.")
+		}
+		// Error logged in the core function above.
+		return nil, status.Error(codes.Internal, "An error occurred while trying to restore the user.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleServer) MergeAccounts(ctx context.Context, in *console.AccountMergeRequest) (*emptypb.Empty, error) {
+	sourceID, err := uuid.FromString(in.SourceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid source user ID.")
+	}
+	targetID, err := uuid.FromString(in.TargetId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid target user ID.")
+	}
+	if sourceID == targetID {
+		return nil, status.Error(codes.InvalidArgument, "Cannot merge an account into itself.")
+	}
+	if sourceID == uuid.Nil || targetID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "Cannot merge the system user.")
+	}
+
+	if err = MergeAccounts(ctx, s.logger, s.db, s.config, s.leaderboardCache, s.leaderboardRankCache, s.sessionRegistry, s.sessionCache, s.tracker, s.storageIndex, s.groupIndex, sourceID, targetID, int(in.StorageConflict)); err != nil {
+		switch err {
+		case ErrAccountNotFound:
+			return nil, status.Error(codes.NotFound, "Account not found.")
+		case ErrAccountMergeStorageConflict:
+			return nil, status.Error(codes.FailedPrecondition, "Both accounts own storage objects with the same collection and key.")
+		}
+		// Error logged in the core function above.
+		return nil, status.Error(codes.Internal, "An error occurred while trying to merge the accounts.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *ConsoleServer) DeleteAccount(ctx context.Context, in *console.AccountDeleteRequest) (*emptypb.Empty, error) {
 	userID, err := uuid.FromString(in.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
 	}
 
-	if err = DeleteAccount(ctx, s.logger, s.db, s.config, s.leaderboardCache, s.leaderboardRankCache, s.sessionRegistry, s.sessionCache, s.tracker, userID, in.RecordDeletion != nil && in.RecordDeletion.Value); err != nil {
+	if err = DeleteAccount(ctx, s.logger, s.db, s.config, s.leaderboardCache, s.leaderboardRankCache, s.groupIndex, s.sessionRegistry, s.sessionCache, s.tracker, userID, in.RecordDeletion != nil && in.RecordDeletion.Value); err != nil {
 		// Error already logged in function above.
 		return nil, status.Error(codes.Internal, "An error occurred while trying to delete the user.")
 	}
@@ -120,6 +187,26 @@ func (s *ConsoleServer) DeleteFriend(ctx context.Context, in *console.DeleteFrie
 	return &emptypb.Empty{}, nil
 }
 
+func (s *ConsoleServer) DeleteSession(ctx context.Context, in *console.DeleteSessionRequest) (*emptypb.Empty, error) {
+	userID, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
+	}
+	if in.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid session ID.")
+	}
+
+	if err = SessionRevoke(s.sessionCache, userID, in.SessionId); err != nil {
+		if err == ErrActiveSessionNotFound {
+			return nil, status.Error(codes.NotFound, "Session not found.")
+		}
+		s.logger.Error("Error revoking session.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to revoke the session.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *ConsoleServer) DeleteGroupUser(ctx context.Context, in *console.DeleteGroupUserRequest) (*emptypb.Empty, error) {
 	userID, err := uuid.FromString(in.Id)
 	if err != nil {
@@ -130,7 +217,7 @@ func (s *ConsoleServer) DeleteGroupUser(ctx context.Context, in *console.DeleteG
 		return nil, status.Error(codes.InvalidArgument, "Requires a valid group ID.")
 	}
 
-	if err = KickGroupUsers(ctx, s.logger, s.db, s.tracker, s.router, s.streamManager, uuid.Nil, groupID, []uuid.UUID{userID}, true); err != nil {
+	if err = KickGroupUsers(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, s.streamManager, uuid.Nil, groupID, []uuid.UUID{userID}, true); err != nil {
 		// Error already logged in function above.
 		if err == ErrEmptyMemberKick {
 			return nil, status.Error(codes.FailedPrecondition, "Cannot kick user from group.")
@@ -191,10 +278,20 @@ func (s *ConsoleServer) GetAccount(ctx context.Context, in *console.AccountId) (
 		return nil, status.Error(codes.Internal, "An error occurred while trying to retrieve user account.")
 	}
 
-	return &console.Account{
+	deleteTime, err := GetAccountDeletionTime(ctx, s.db, userID)
+	if err != nil {
+		s.logger.Error("Error retrieving account deletion time.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to retrieve user account.")
+	}
+
+	consoleAccount := &console.Account{
 		Account:     account,
 		DisableTime: account.DisableTime,
-	}, nil
+	}
+	if deleteTime != nil {
+		consoleAccount.DeleteTime = timestamppb.New(*deleteTime)
+	}
+	return consoleAccount, nil
 }
 
 func (s *ConsoleServer) GetFriends(ctx context.Context, in *console.AccountId) (*api.FriendList, error) {
@@ -227,6 +324,32 @@ func (s *ConsoleServer) GetGroups(ctx context.Context, in *console.AccountId) (*
 	return groups, nil
 }
 
+func (s *ConsoleServer) GetSessions(ctx context.Context, in *console.AccountId) (*console.AccountSessionList, error) {
+	userID, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
+	}
+
+	sessions := s.sessionCache.List(userID)
+	list := &console.AccountSessionList{Sessions: make([]*console.AccountSession, 0, len(sessions))}
+	for _, active := range sessions {
+		session := &console.AccountSession{
+			Id:         active.TokenID,
+			AuthMethod: active.AuthMethod,
+			ClientIp:   active.ClientIP,
+			UserAgent:  active.UserAgent,
+			CreateTime: &timestamppb.Timestamp{Seconds: active.IssueTime},
+			ExpireTime: &timestamppb.Timestamp{Seconds: max(active.SessionExpiry, active.RefreshExpiry)},
+		}
+		if active.RefreshTime > 0 {
+			session.RefreshTime = &timestamppb.Timestamp{Seconds: active.RefreshTime}
+		}
+		list.Sessions = append(list.Sessions, session)
+	}
+
+	return list, nil
+}
+
 func (s *ConsoleServer) GetWalletLedger(ctx context.Context, in *console.GetWalletLedgerRequest) (*console.WalletLedgerList, error) {
 	userID, err := uuid.FromString(in.AccountId)
 	if err != nil {
@@ -703,7 +826,8 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
      OR steam_id IS NOT NULL
      OR email IS NOT NULL
      OR custom_id IS NOT NULL))
-   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1))`
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 				res, err := tx.ExecContext(ctx, query, userID, oldDeviceID)
 				if err != nil {
@@ -736,14 +860,16 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
 		}
 
 		if removeCustomID && removeEmail {
-			query := `UPDATE users SET custom_id = NULL, email = NULL, update_time = now()
+			query := `UPDATE users SET custom_id = NULL, email = NULL, verify_time = '1970-01-01 00:00:00 UTC', update_time = now()
 WHERE id = $1
 AND ((facebook_id IS NOT NULL
       OR google_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR steam_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 			res, err := tx.ExecContext(ctx, query, userID)
 			if err != nil {
@@ -761,7 +887,9 @@ AND ((facebook_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 			res, err := tx.ExecContext(ctx, query, userID)
 			if err != nil {
@@ -771,7 +899,7 @@ AND ((facebook_id IS NOT NULL
 				return StatusError(codes.InvalidArgument, "Cannot unlink custom ID when there are no other identifiers.", ErrRowsAffectedCount)
 			}
 		} else if removeEmail {
-			query := `UPDATE users SET email = NULL, password = NULL, update_time = now()
+			query := `UPDATE users SET email = NULL, password = NULL, verify_time = '1970-01-01 00:00:00 UTC', update_time = now()
 WHERE id = $1
 AND ((facebook_id IS NOT NULL
       OR google_id IS NOT NULL
@@ -779,7 +907,9 @@ AND ((facebook_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR custom_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 			res, err := tx.ExecContext(ctx, query, userID)
 			if err != nil {
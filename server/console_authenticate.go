@@ -259,7 +259,7 @@ func (s *ConsoleServer) Authenticate(ctx context.Context, in *console.Authentica
 	key := []byte(s.config.GetConsole().SigningKey)
 	signedToken, _ := token.SignedString(key)
 
-	s.consoleSessionCache.Add(userId, exp, signedToken, 0, "")
+	s.consoleSessionCache.Add(userId, exp, signedToken, 0, "", nil)
 
 	return &console.ConsoleSession{Token: signedToken, MfaCode: mfaCode}, nil
 }
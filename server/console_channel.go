@@ -34,6 +34,31 @@ func (s *ConsoleServer) ListChannelMessages(ctx context.Context, in *console.Lis
 		return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
 	}
 
+	if in.Query != "" || in.SenderId != "" || in.StartTime != nil || in.EndTime != nil {
+		filter := &ChannelMessageSearchFilter{Query: in.Query}
+		if in.SenderId != "" {
+			if filter.SenderID, err = uuid.FromString(in.SenderId); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "Invalid sender ID format.")
+			}
+		}
+		if in.StartTime != nil {
+			filter.StartTime = in.StartTime.AsTime()
+		}
+		if in.EndTime != nil {
+			filter.EndTime = in.EndTime.AsTime()
+		}
+
+		messageList, err := ChannelMessagesSearch(ctx, s.logger, s.db, uuid.Nil, *stream, channelId, filter, limit, cursor)
+		if err == errChannelMessageSearchInvalid {
+			return nil, status.Error(codes.InvalidArgument, "Search query has no words to match.")
+		} else if err == runtime.ErrChannelCursorInvalid {
+			return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
+		} else if err != nil {
+			return nil, status.Error(codes.Internal, "Error searching messages in channel.")
+		}
+		return messageList, nil
+	}
+
 	messageList, err := ChannelMessagesList(ctx, s.logger, s.db, uuid.Nil, *stream, channelId, limit, false, cursor)
 	if err == runtime.ErrChannelCursorInvalid {
 		return nil, status.Error(codes.InvalidArgument, "Cursor is invalid or expired.")
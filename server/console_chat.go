@@ -0,0 +1,267 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama/v3/console"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type chatModerationAuditCursor struct {
+	ID         []byte
+	CreateTime int64
+}
+
+type chatMutesCursor struct {
+	UserID     []byte
+	CreateTime int64
+}
+
+func (s *ConsoleServer) AddChatMute(ctx context.Context, in *console.AddChatMuteRequest) (*emptypb.Empty, error) {
+	userID, err := uuid.FromString(in.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "expects a valid user ID")
+	}
+	if len(in.Reason) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "expects a reason of at most 255 characters")
+	}
+
+	var expiryTime time.Time
+	if in.DurationSec > 0 {
+		expiryTime = time.Now().Add(time.Duration(in.DurationSec) * time.Second)
+	}
+
+	if err := s.chatModerator.Mute(ctx, s.logger, userID, in.Reason, expiryTime); err != nil {
+		return nil, status.Error(codes.Internal, "failed to mute user")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleServer) DeleteChatMute(ctx context.Context, in *console.DeleteChatMuteRequest) (*emptypb.Empty, error) {
+	userID, err := uuid.FromString(in.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "expects a valid user ID")
+	}
+
+	if err := s.chatModerator.Unmute(ctx, s.logger, userID); err != nil {
+		if errors.Is(err, ErrChatMuteNotFound) {
+			return nil, status.Error(codes.NotFound, "Chat mute not found.")
+		}
+		return nil, status.Error(codes.Internal, "failed to unmute user")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleServer) ListChatModerationAudit(ctx context.Context, in *console.ListChatModerationAuditRequest) (*console.ChatModerationAuditList, error) {
+	if in.Limit < 1 || in.Limit > 100 {
+		return nil, status.Error(codes.InvalidArgument, "expects a limit value between 1 and 100")
+	}
+
+	var nc *chatModerationAuditCursor
+	if in.Cursor != "" {
+		nc = &chatModerationAuditCursor{}
+		cb, err := base64.URLEncoding.DecodeString(in.Cursor)
+		if err != nil {
+			s.logger.Warn("Could not base64 decode chat moderation audit cursor.", zap.String("cursor", in.Cursor))
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		}
+		if err = gob.NewDecoder(bytes.NewReader(cb)).Decode(nc); err != nil {
+			s.logger.Warn("Could not decode chat moderation audit cursor.", zap.String("cursor", in.Cursor))
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		}
+	}
+
+	params := make([]any, 0, 4)
+	predicates := make([]string, 0, 2)
+	if in.UserId != "" {
+		userID, err := uuid.FromString(in.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expects a valid user ID filter")
+		}
+		params = append(params, userID)
+		predicates = append(predicates, fmt.Sprintf("user_id = $%d", len(params)))
+	}
+	if nc != nil {
+		params = append(params, &pgtype.Timestamptz{Time: time.Unix(0, nc.CreateTime).UTC(), Valid: true}, uuid.FromBytesOrNil(nc.ID))
+		predicates = append(predicates, fmt.Sprintf("(create_time, id) < ($%d, $%d)", len(params)-1, len(params)))
+	}
+	params = append(params, in.Limit+1)
+
+	query := "SELECT id, user_id, channel_id, message_id, action, content, matched_words, create_time FROM chat_moderation_audit"
+	if len(predicates) > 0 {
+		query += " WHERE " + strings.Join(predicates, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY create_time DESC, id DESC LIMIT $%d", len(params))
+
+	rows, err := s.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		s.logger.Error("Could not retrieve chat moderation audit entries.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list chat moderation audit entries")
+	}
+	defer rows.Close()
+
+	var nextCursor *chatModerationAuditCursor
+	entries := make([]*console.ChatModerationAuditEntry, 0, in.Limit)
+	for rows.Next() {
+		var (
+			id         uuid.UUID
+			userID     uuid.UUID
+			messageID  uuid.UUID
+			createTime pgtype.Timestamptz
+		)
+		entry := &console.ChatModerationAuditEntry{}
+		if err := rows.Scan(&id, &userID, &entry.ChannelId, &messageID, &entry.Action, &entry.Content, &entry.MatchedWords, &createTime); err != nil {
+			s.logger.Error("Could not scan chat moderation audit entry from database.", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list chat moderation audit entries")
+		}
+
+		if len(entries) >= int(in.Limit) {
+			last := entries[len(entries)-1]
+			nextCursor = &chatModerationAuditCursor{
+				ID:         uuid.FromStringOrNil(last.Id).Bytes(),
+				CreateTime: last.CreateTime.AsTime().UnixNano(),
+			}
+			break
+		}
+
+		entry.Id = id.String()
+		entry.UserId = userID.String()
+		entry.MessageId = messageID.String()
+		entry.CreateTime = timestamppb.New(createTime.Time)
+		entries = append(entries, entry)
+	}
+	if err = rows.Err(); err != nil {
+		s.logger.Error("Error retrieving chat moderation audit entries.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list chat moderation audit entries")
+	}
+
+	var nextCursorStr string
+	if nextCursor != nil {
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(nextCursor); err != nil {
+			s.logger.Error("Error creating chat moderation audit list cursor", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list chat moderation audit entries")
+		}
+		nextCursorStr = base64.URLEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	return &console.ChatModerationAuditList{
+		Entries:    entries,
+		NextCursor: nextCursorStr,
+	}, nil
+}
+
+func (s *ConsoleServer) ListChatMutes(ctx context.Context, in *console.ListChatMutesRequest) (*console.ChatMuteList, error) {
+	if in.Limit < 1 || in.Limit > 100 {
+		return nil, status.Error(codes.InvalidArgument, "expects a limit value between 1 and 100")
+	}
+
+	var nc *chatMutesCursor
+	if in.Cursor != "" {
+		nc = &chatMutesCursor{}
+		cb, err := base64.URLEncoding.DecodeString(in.Cursor)
+		if err != nil {
+			s.logger.Warn("Could not base64 decode chat mutes cursor.", zap.String("cursor", in.Cursor))
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		}
+		if err = gob.NewDecoder(bytes.NewReader(cb)).Decode(nc); err != nil {
+			s.logger.Warn("Could not decode chat mutes cursor.", zap.String("cursor", in.Cursor))
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		}
+	}
+
+	params := make([]any, 0, 3)
+	query := "SELECT user_id, reason, create_time, expiry_time FROM chat_mute WHERE (expiry_time IS NULL OR expiry_time > now())"
+	if nc != nil {
+		params = append(params, &pgtype.Timestamptz{Time: time.Unix(0, nc.CreateTime).UTC(), Valid: true}, uuid.FromBytesOrNil(nc.UserID))
+		query += " AND (create_time, user_id) < ($1, $2)"
+	}
+	params = append(params, in.Limit+1)
+	query += fmt.Sprintf(" ORDER BY create_time DESC, user_id DESC LIMIT $%d", len(params))
+
+	rows, err := s.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		s.logger.Error("Could not retrieve chat mutes.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list chat mutes")
+	}
+	defer rows.Close()
+
+	var nextCursor *chatMutesCursor
+	mutes := make([]*console.ChatMute, 0, in.Limit)
+	for rows.Next() {
+		var (
+			userID     uuid.UUID
+			createTime pgtype.Timestamptz
+			expiryTime pgtype.Timestamptz
+		)
+		mute := &console.ChatMute{}
+		if err := rows.Scan(&userID, &mute.Reason, &createTime, &expiryTime); err != nil {
+			s.logger.Error("Could not scan chat mute from database.", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list chat mutes")
+		}
+
+		if len(mutes) >= int(in.Limit) {
+			last := mutes[len(mutes)-1]
+			nextCursor = &chatMutesCursor{
+				UserID:     uuid.FromStringOrNil(last.UserId).Bytes(),
+				CreateTime: last.CreateTime.AsTime().UnixNano(),
+			}
+			break
+		}
+
+		mute.UserId = userID.String()
+		mute.CreateTime = timestamppb.New(createTime.Time)
+		if expiryTime.Valid {
+			mute.ExpiryTime = timestamppb.New(expiryTime.Time)
+		}
+		mutes = append(mutes, mute)
+	}
+	if err = rows.Err(); err != nil {
+		s.logger.Error("Error retrieving chat mutes.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list chat mutes")
+	}
+
+	var nextCursorStr string
+	if nextCursor != nil {
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(nextCursor); err != nil {
+			s.logger.Error("Error creating chat mutes list cursor", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list chat mutes")
+		}
+		nextCursorStr = base64.URLEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	return &console.ChatMuteList{
+		Mutes:      mutes,
+		NextCursor: nextCursorStr,
+	}, nil
+}
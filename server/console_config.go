@@ -62,6 +62,13 @@ func (s *ConsoleServer) GetConfig(ctx context.Context, in *emptypb.Empty) (*cons
 	cfg.GetSocial().FacebookInstantGame.AppSecret = ObfuscationString
 	cfg.GetIAP().FacebookInstant.AppSecret = ObfuscationString
 
+	cfg.GetNotification().Fcm.PrivateKey = ObfuscationString
+	cfg.GetNotification().Apns.PrivateKey = ObfuscationString
+
+	if cfg.GetMail().SmtpPassword != "" {
+		cfg.GetMail().SmtpPassword = ObfuscationString
+	}
+
 	cfgBytes, err := json.Marshal(cfg)
 	if err != nil {
 		s.logger.Error("Error encoding config.", zap.Error(err))
@@ -85,7 +92,9 @@ func (s *ConsoleServer) GetConfig(ctx context.Context, in *emptypb.Empty) (*cons
 
 func (s *ConsoleServer) DeleteAllData(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
 	query := `TRUNCATE TABLE users, user_edge, user_device, user_tombstone, wallet_ledger, storage, purchase,
-			subscription, notification, message, leaderboard, leaderboard_record, groups, group_edge`
+			subscription, notification, notification_schedule, message, message_reaction, leaderboard, leaderboard_record, groups, group_edge,
+			user_push_device, user_push_preference, chat_mute, chat_moderation_audit, channel_retention,
+			group_role, group_user_role, group_event, friend_recommendation_signal, friend_recommendation_cache, session_revoked_token, session_user_invalidation, session_active, user_identity, user_email_code, user_deletion`
 	if _, err := s.db.ExecContext(ctx, query); err != nil {
 		s.logger.Debug("Could not cleanup data.", zap.Error(err))
 		return nil, status.Error(codes.Internal, "An error occurred while trying to truncate tables.")
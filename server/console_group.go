@@ -170,7 +170,7 @@ func (s *ConsoleServer) DeleteGroup(ctx context.Context, in *console.DeleteGroup
 		return nil, status.Error(codes.InvalidArgument, "Requires a valid group ID.")
 	}
 
-	if err = DeleteGroup(ctx, s.logger, s.db, s.tracker, groupID, uuid.Nil); err != nil {
+	if err = DeleteGroup(ctx, s.logger, s.db, s.groupIndex, s.tracker, groupID, uuid.Nil); err != nil {
 		// Error already logged in function above.
 		return nil, status.Error(codes.Internal, "An error occurred while trying to delete the user.")
 	}
@@ -234,7 +234,7 @@ func (s *ConsoleServer) UpdateGroup(ctx context.Context, in *console.UpdateGroup
 		maxCount = int(in.MaxCount.Value)
 	}
 
-	err = UpdateGroup(ctx, s.logger, s.db, groupID, uuid.Nil, uuid.Nil, in.Name, in.LangTag, in.Description, in.AvatarUrl, in.Metadata, in.Open, maxCount)
+	err = UpdateGroup(ctx, s.logger, s.db, s.groupIndex, groupID, uuid.Nil, uuid.Nil, in.Name, in.LangTag, in.Description, in.AvatarUrl, in.Metadata, in.Open, maxCount)
 	if err != nil {
 		return nil, err
 	}
@@ -256,6 +256,40 @@ func (s *ConsoleServer) GetMembers(ctx context.Context, in *console.GroupId) (*a
 	return users, nil
 }
 
+func (s *ConsoleServer) ListGroupEvents(ctx context.Context, in *console.ListGroupEventsRequest) (*console.GroupEventList, error) {
+	groupID, err := uuid.FromString(in.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid group ID.")
+	}
+
+	if in.Limit < 1 || in.Limit > 100 {
+		return nil, status.Error(codes.InvalidArgument, "expects a limit value between 1 and 100")
+	}
+
+	list, err := GroupEventsList(ctx, s.logger, s.db, groupID, int(in.Limit), in.Cursor)
+	if err != nil {
+		if err == runtime.ErrGroupUserInvalidCursor {
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		}
+		return nil, status.Error(codes.Internal, "An error occurred while trying to list group events.")
+	}
+
+	events := make([]*console.GroupEvent, 0, len(list.Events))
+	for _, event := range list.Events {
+		events = append(events, &console.GroupEvent{
+			Id:         event.Id,
+			GroupId:    event.GroupId,
+			Type:       event.Type,
+			ActorId:    event.ActorId,
+			TargetId:   event.TargetId,
+			Data:       event.Data,
+			CreateTime: event.CreateTime,
+		})
+	}
+
+	return &console.GroupEventList{Events: events, NextCursor: list.Cursor}, nil
+}
+
 func (s *ConsoleServer) DemoteGroupMember(ctx context.Context, in *console.UpdateGroupUserStateRequest) (*emptypb.Empty, error) {
 	userID, err := uuid.FromString(in.Id)
 	if err != nil {
@@ -376,6 +410,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 				logger.Debug("Could not insert group demote channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 				return err
 			}
+			if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventDemote, uuid.Nil, uid, map[string]any{"state": newState.Int64}); err != nil {
+				return err
+			}
 			return nil
 		}); err != nil {
 			if err != ErrEmptyMemberDemote {
@@ -516,6 +553,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 				logger.Debug("Could not insert group demote channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 				return err
 			}
+			if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventPromote, uuid.Nil, uid, map[string]any{"state": newState.Int64}); err != nil {
+				return err
+			}
 			return nil
 		}); err != nil {
 			if err != ErrEmptyMemberPromote {
@@ -565,12 +605,12 @@ func (s *ConsoleServer) AddGroupUsers(ctx context.Context, in *console.AddGroupU
 				s.logger.Debug("Could not retrieve username to join user to group.", zap.Error(err), zap.String("user_id", uid.String()))
 				return nil, status.Error(codes.Internal, "An error occurred while trying to join the user to the group. Refresh the page to see any updates.")
 			}
-			if err = JoinGroup(ctx, s.logger, s.db, s.tracker, s.router, groupUid, uid, username.String); err != nil {
+			if err = JoinGroup(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, groupUid, uid, username.String); err != nil {
 				return nil, status.Error(codes.Internal, "An error occurred while trying to join an user to the group, refresh the page: "+err.Error()+". Refresh the page to see any updates.")
 			}
 		}
 	} else {
-		if err = AddGroupUsers(ctx, s.logger, s.db, s.tracker, s.router, uuid.Nil, groupUid, uuids); err != nil {
+		if err = AddGroupUsers(ctx, s.logger, s.db, s.groupIndex, s.tracker, s.router, uuid.Nil, groupUid, uuids); err != nil {
 			return nil, status.Error(codes.Internal, "An error occurred while trying to add the users: "+err.Error())
 		}
 	}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/heroiclabs/nakama/v3/console"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ConsoleServer) ListLockouts(ctx context.Context, in *console.LockoutRequest) (*console.LockoutList, error) {
+	lockouts := s.loginAttemptCache.List(in.Account, in.Ip)
+
+	list := &console.LockoutList{Lockouts: make([]*console.Lockout, 0, len(lockouts))}
+	for _, lockout := range lockouts {
+		lockoutType := console.Lockout_ACCOUNT
+		if lockout.Type == LockoutTypeIp {
+			lockoutType = console.Lockout_IP
+		}
+		list.Lockouts = append(list.Lockouts, &console.Lockout{
+			Type:        lockoutType,
+			Key:         lockout.Key,
+			LockedUntil: timestamppb.New(lockout.LockedUntil),
+		})
+	}
+
+	return list, nil
+}
+
+func (s *ConsoleServer) DeleteLockouts(ctx context.Context, in *console.LockoutRequest) (*emptypb.Empty, error) {
+	if in.Account == "" && in.Ip == "" {
+		return nil, status.Error(codes.InvalidArgument, "Requires an account or IP address.")
+	}
+
+	if err := s.loginAttemptCache.Clear(in.Account, in.Ip); err != nil {
+		s.logger.Error("Error clearing login lockouts.", zap.Error(err), zap.String("account", in.Account), zap.String("ip", in.Ip))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to clear lockouts.")
+	}
+
+	return &emptypb.Empty{}, nil
+}
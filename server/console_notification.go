@@ -20,6 +20,8 @@ import (
 	"database/sql"
 	"encoding/base64"
 	"encoding/gob"
+	"errors"
+	"fmt"
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama/v3/console"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -29,6 +31,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"slices"
+	"strings"
 	"time"
 )
 
@@ -273,3 +276,123 @@ func (s *ConsoleServer) DeleteNotification(ctx context.Context, in *console.Dele
 
 	return &emptypb.Empty{}, nil
 }
+
+type scheduledNotificationsCursor struct {
+	ID       []byte
+	SendTime int64
+}
+
+func (s *ConsoleServer) ListScheduledNotifications(ctx context.Context, in *console.ListScheduledNotificationsRequest) (*console.ScheduledNotificationList, error) {
+	if in.Limit < 1 || in.Limit > 100 {
+		return nil, status.Error(codes.InvalidArgument, "expects a limit value between 1 and 100")
+	}
+
+	var nc *scheduledNotificationsCursor
+	if in.Cursor != "" {
+		nc = &scheduledNotificationsCursor{}
+		cb, err := base64.URLEncoding.DecodeString(in.Cursor)
+		if err != nil {
+			s.logger.Warn("Could not base64 decode scheduled notification cursor.", zap.String("cursor", in.Cursor))
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		}
+		if err = gob.NewDecoder(bytes.NewReader(cb)).Decode(nc); err != nil {
+			s.logger.Warn("Could not decode scheduled notification cursor.", zap.String("cursor", in.Cursor))
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		}
+	}
+
+	params := make([]any, 0, 4)
+	predicates := make([]string, 0, 2)
+	if in.UserId != "" {
+		userID, err := uuid.FromString(in.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expects a valid user ID filter")
+		}
+		params = append(params, userID)
+		predicates = append(predicates, fmt.Sprintf("user_id = $%d", len(params)))
+	}
+	if nc != nil {
+		params = append(params, &pgtype.Timestamptz{Time: time.Unix(0, nc.SendTime).UTC(), Valid: true}, uuid.FromBytesOrNil(nc.ID))
+		predicates = append(predicates, fmt.Sprintf("(send_time, id) > ($%d, $%d)", len(params)-1, len(params)))
+	}
+	params = append(params, in.Limit+1)
+
+	query := "SELECT id, user_id, subject, content, code, sender_id, persistent, expiry_sec, send_time, create_time FROM notification_schedule"
+	if len(predicates) > 0 {
+		query += " WHERE " + strings.Join(predicates, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY send_time ASC, id ASC LIMIT $%d", len(params))
+
+	rows, err := s.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		s.logger.Error("Could not retrieve scheduled notifications.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list scheduled notifications")
+	}
+	defer rows.Close()
+
+	var nextCursor *scheduledNotificationsCursor
+	notifications := make([]*console.ScheduledNotification, 0, in.Limit)
+	for rows.Next() {
+		var (
+			id         uuid.UUID
+			userID     uuid.UUID
+			sendTime   pgtype.Timestamptz
+			createTime pgtype.Timestamptz
+		)
+		no := &console.ScheduledNotification{}
+		if err := rows.Scan(&id, &userID, &no.Subject, &no.Content, &no.Code, &no.SenderId, &no.Persistent, &no.ExpirySec, &sendTime, &createTime); err != nil {
+			s.logger.Error("Could not scan scheduled notification from database.", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list scheduled notifications")
+		}
+
+		if len(notifications) >= int(in.Limit) {
+			last := notifications[len(notifications)-1]
+			nextCursor = &scheduledNotificationsCursor{
+				ID:       uuid.FromStringOrNil(last.Id).Bytes(),
+				SendTime: last.SendTime.AsTime().UnixNano(),
+			}
+			break
+		}
+
+		no.Id = id.String()
+		no.UserId = userID.String()
+		no.SendTime = timestamppb.New(sendTime.Time)
+		no.CreateTime = timestamppb.New(createTime.Time)
+		notifications = append(notifications, no)
+	}
+	if err = rows.Err(); err != nil {
+		s.logger.Error("Error retrieving scheduled notifications.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list scheduled notifications")
+	}
+
+	var nextCursorStr string
+	if nextCursor != nil {
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(nextCursor); err != nil {
+			s.logger.Error("Error creating scheduled notifications list cursor", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list scheduled notifications")
+		}
+		nextCursorStr = base64.URLEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	return &console.ScheduledNotificationList{
+		Notifications: notifications,
+		NextCursor:    nextCursorStr,
+	}, nil
+}
+
+func (s *ConsoleServer) DeleteScheduledNotification(ctx context.Context, in *console.DeleteScheduledNotificationRequest) (*emptypb.Empty, error) {
+	id, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "expects a valid scheduled notification ID")
+	}
+
+	if err := NotificationScheduleCancel(ctx, s.logger, s.db, id); err != nil {
+		if errors.Is(err, ErrNotificationScheduleNotFound) {
+			return nil, status.Error(codes.NotFound, "Scheduled notification not found.")
+		}
+		return nil, status.Error(codes.Internal, "failed to cancel scheduled notification")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -43,7 +43,9 @@ AND ((custom_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -74,7 +76,9 @@ AND ((apple_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -108,7 +112,8 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
      OR steam_id IS NOT NULL
      OR email IS NOT NULL
      OR custom_id IS NOT NULL))
-   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1))`
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 		res, err := tx.ExecContext(ctx, query, userID, in.DeviceId)
 		if err != nil {
@@ -148,7 +153,7 @@ func (s *ConsoleServer) UnlinkEmail(ctx context.Context, in *console.AccountId)
 		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
 	}
 
-	query := `UPDATE users SET email = NULL, password = NULL, update_time = now()
+	query := `UPDATE users SET email = NULL, password = NULL, verify_time = '1970-01-01 00:00:00 UTC', update_time = now()
 WHERE id = $1
 AND email IS NOT NULL
 AND ((apple_id IS NOT NULL
@@ -159,7 +164,9 @@ AND ((apple_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR custom_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -190,7 +197,9 @@ AND ((apple_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -221,7 +230,9 @@ AND ((apple_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -252,7 +263,9 @@ AND ((apple_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -283,7 +296,9 @@ AND ((apple_id IS NOT NULL
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -314,7 +329,9 @@ AND ((apple_id IS NOT NULL
       OR google_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
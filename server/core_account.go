@@ -408,7 +408,7 @@ func ExportAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, userID u
 	}
 
 	// Notifications.
-	notifications, err := NotificationList(ctx, logger, db, userID, 0, "", true)
+	notifications, err := NotificationList(ctx, logger, db, userID, 0, "", true, nil)
 	if err != nil {
 		logger.Error("Could not fetch notifications", zap.Error(err), zap.String("user_id", userID.String()))
 		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
@@ -463,7 +463,7 @@ func ExportAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, userID u
 	return export, nil
 }
 
-func DeleteAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, sessionCache SessionCache, tracker Tracker, userID uuid.UUID, recorded bool) error {
+func DeleteAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, groupIndex GroupIndex, sessionRegistry SessionRegistry, sessionCache SessionCache, tracker Tracker, userID uuid.UUID, recorded bool) error {
 	if userID == uuid.Nil {
 		return errors.New("cannot delete the system user")
 	}
@@ -471,55 +471,72 @@ func DeleteAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, config C
 	ts := time.Now().UTC().Unix()
 
 	var deleted bool
+	var groupIDs []uuid.UUID
 	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
-		count, err := DeleteUser(ctx, tx, userID)
-		if err != nil {
-			logger.Debug("Could not delete user", zap.Error(err), zap.String("user_id", userID.String()))
-			return err
-		} else if count == 0 {
-			logger.Info("No user was found to delete. Skipping blacklist.", zap.String("user_id", userID.String()))
-			return nil
-		}
+		var err error
+		deleted, groupIDs, err = deleteAccountTx(ctx, logger, tx, leaderboardCache, leaderboardRankCache, userID, recorded, ts)
+		return err
+	}); err != nil {
+		logger.Error("Error occurred while trying to delete the user.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
 
-		err = LeaderboardRecordsDeleteAll(ctx, logger, leaderboardCache, leaderboardRankCache, tx, userID, ts)
-		if err != nil {
-			logger.Debug("Could not delete leaderboard records.", zap.Error(err), zap.String("user_id", userID.String()))
-			return err
-		}
+	if len(groupIDs) > 0 {
+		groupIndex.Refresh(ctx, groupIDs)
+	}
 
-		err = GroupDeleteAll(ctx, logger, tx, userID)
-		if err != nil {
-			logger.Debug("Could not delete groups and relationships.", zap.Error(err), zap.String("user_id", userID.String()))
-			return err
-		}
+	if deleted {
+		return disconnectDeletedAccount(ctx, config, sessionRegistry, sessionCache, tracker, userID)
+	}
 
-		if recorded {
-			_, err = tx.ExecContext(ctx, `INSERT INTO user_tombstone (user_id) VALUES ($1) ON CONFLICT(user_id) DO NOTHING`, userID)
-			if err != nil {
-				logger.Debug("Could not insert user ID into tombstone", zap.Error(err), zap.String("user_id", userID.String()))
-				return err
-			}
+	return nil
+}
+
+// Delete a user along with their leaderboard records and group memberships, optionally recording the deletion in the
+// user tombstone table. Returns false if the user did not exist, and the groups changed or deleted that need to be
+// refreshed in the group index once the deletion is committed.
+func deleteAccountTx(ctx context.Context, logger *zap.Logger, tx *sql.Tx, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, userID uuid.UUID, recorded bool, ts int64) (bool, []uuid.UUID, error) {
+	count, err := DeleteUser(ctx, tx, userID)
+	if err != nil {
+		logger.Debug("Could not delete user", zap.Error(err), zap.String("user_id", userID.String()))
+		return false, nil, err
+	} else if count == 0 {
+		logger.Info("No user was found to delete. Skipping blacklist.", zap.String("user_id", userID.String()))
+		return false, nil, nil
+	}
+
+	err = LeaderboardRecordsDeleteAll(ctx, logger, leaderboardCache, leaderboardRankCache, tx, userID, ts)
+	if err != nil {
+		logger.Debug("Could not delete leaderboard records.", zap.Error(err), zap.String("user_id", userID.String()))
+		return false, nil, err
+	}
+
+	groupIDs, err := GroupDeleteAll(ctx, logger, tx, userID)
+	if err != nil {
+		logger.Debug("Could not delete groups and relationships.", zap.Error(err), zap.String("user_id", userID.String()))
+		return false, nil, err
+	}
+
+	if recorded {
+		_, err = tx.ExecContext(ctx, `INSERT INTO user_tombstone (user_id) VALUES ($1) ON CONFLICT(user_id) DO NOTHING`, userID)
+		if err != nil {
+			logger.Debug("Could not insert user ID into tombstone", zap.Error(err), zap.String("user_id", userID.String()))
+			return false, nil, err
 		}
+	}
 
-		deleted = true
+	return true, groupIDs, nil
+}
 
-		return nil
-	}); err != nil {
-		logger.Error("Error occurred while trying to delete the user.", zap.Error(err), zap.String("user_id", userID.String()))
+// Logout and disconnect a user whose account has been deleted.
+func disconnectDeletedAccount(ctx context.Context, config Config, sessionRegistry SessionRegistry, sessionCache SessionCache, tracker Tracker, userID uuid.UUID) error {
+	if err := SessionLogout(config, sessionCache, userID, "", ""); err != nil {
 		return err
 	}
-
-	if deleted {
-		// Logout and disconnect.
-		if err := SessionLogout(config, sessionCache, userID, "", ""); err != nil {
+	for _, presence := range tracker.ListPresenceIDByStream(PresenceStream{Mode: StreamModeNotifications, Subject: userID}) {
+		if err := sessionRegistry.Disconnect(ctx, presence.SessionID, false); err != nil {
 			return err
 		}
-		for _, presence := range tracker.ListPresenceIDByStream(PresenceStream{Mode: StreamModeNotifications, Subject: userID}) {
-			if err := sessionRegistry.Disconnect(ctx, presence.SessionID, false); err != nil {
-				return err
-			}
-		}
 	}
-
 	return nil
 }
@@ -0,0 +1,161 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrAccountDeletionNotFound = errors.New("account is not scheduled for deletion")
+	ErrAccountDeletionExists   = errors.New("account is already scheduled for deletion")
+	ErrAccountDeletionPending  = errors.New("account is scheduled for deletion and must be restored instead")
+)
+
+// ScheduleAccountDeletion disables an account and logs it out, then leaves it to be permanently deleted by the account
+// deletion scheduler once the configured grace period has passed. Until then it can be restored with RestoreAccount.
+func ScheduleAccountDeletion(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sessionRegistry SessionRegistry, sessionCache SessionCache, tracker Tracker, userID uuid.UUID, recorded bool) error {
+	if userID == uuid.Nil {
+		return errors.New("cannot delete the system user")
+	}
+
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		// Keep the account's disable time as it was, so restoring it doesn't lift an earlier ban.
+		res, err := tx.ExecContext(ctx, `
+INSERT INTO user_deletion (user_id, recorded, disable_time, delete_time)
+SELECT id, $2, disable_time, now() + make_interval(secs => $3)
+FROM users WHERE id = $1
+ON CONFLICT (user_id) DO NOTHING`, userID, recorded, config.GetAccount().DeletionGracePeriodSec)
+		if err != nil {
+			return err
+		}
+		if count, _ := res.RowsAffected(); count == 0 {
+			var exists bool
+			if err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM user_deletion WHERE user_id = $1)", userID).Scan(&exists); err != nil {
+				return err
+			}
+			if exists {
+				return ErrAccountDeletionExists
+			}
+			return ErrAccountNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE users SET disable_time = now() WHERE id = $1", userID)
+		return err
+	}); err != nil {
+		if err != ErrAccountNotFound && err != ErrAccountDeletionExists {
+			logger.Error("Error scheduling account deletion.", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+		return err
+	}
+
+	sessionCache.Ban([]uuid.UUID{userID})
+
+	// Disconnect.
+	for _, presence := range tracker.ListPresenceIDByStream(PresenceStream{Mode: StreamModeNotifications, Subject: userID}) {
+		if err := sessionRegistry.Disconnect(ctx, presence.SessionID, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RestoreAccount cancels a scheduled account deletion and enables the account again, unless it was already disabled
+// before its deletion was scheduled.
+func RestoreAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, sessionCache SessionCache, userID uuid.UUID) error {
+	var disableTime time.Time
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		if err := tx.QueryRowContext(ctx, "DELETE FROM user_deletion WHERE user_id = $1 RETURNING disable_time", userID).Scan(&disableTime); err != nil {
+			if err == sql.ErrNoRows {
+				return ErrAccountDeletionNotFound
+			}
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, "UPDATE users SET disable_time = $2 WHERE id = $1", userID, disableTime)
+		return err
+	}); err != nil {
+		if err != ErrAccountDeletionNotFound {
+			logger.Error("Error restoring account.", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+		return err
+	}
+
+	if disableTime.Unix() == 0 {
+		sessionCache.Unban([]uuid.UUID{userID})
+	}
+
+	return nil
+}
+
+// GetAccountDeletionTime returns when an account scheduled for deletion will be permanently deleted, or nil if it is
+// not scheduled for deletion.
+func GetAccountDeletionTime(ctx context.Context, db *sql.DB, userID uuid.UUID) (*time.Time, error) {
+	var deleteTime time.Time
+	if err := db.QueryRowContext(ctx, "SELECT delete_time FROM user_deletion WHERE user_id = $1", userID).Scan(&deleteTime); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &deleteTime, nil
+}
+
+// DeleteScheduledAccounts permanently deletes up to limit accounts whose deletion grace period has passed, and
+// returns how many were deleted.
+func DeleteScheduledAccounts(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, groupIndex GroupIndex, sessionRegistry SessionRegistry, sessionCache SessionCache, tracker Tracker, limit int) (int, error) {
+	rows, err := db.QueryContext(ctx, "SELECT user_id, recorded FROM user_deletion WHERE delete_time <= now() ORDER BY delete_time LIMIT $1", limit)
+	if err != nil {
+		logger.Error("Error listing scheduled account deletions.", zap.Error(err))
+		return 0, err
+	}
+	type deletion struct {
+		userID   uuid.UUID
+		recorded bool
+	}
+	deletions := make([]deletion, 0, limit)
+	for rows.Next() {
+		var d deletion
+		if err = rows.Scan(&d.userID, &d.recorded); err != nil {
+			_ = rows.Close()
+			logger.Error("Error reading scheduled account deletions.", zap.Error(err))
+			return 0, err
+		}
+		deletions = append(deletions, d)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		logger.Error("Error reading scheduled account deletions.", zap.Error(err))
+		return 0, err
+	}
+
+	var count int
+	for _, d := range deletions {
+		// The deletion record is removed along with the user.
+		if err = DeleteAccount(ctx, logger, db, config, leaderboardCache, leaderboardRankCache, groupIndex, sessionRegistry, sessionCache, tracker, d.userID, d.recorded); err != nil {
+			// Error logged in the function above, retried on the next check.
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
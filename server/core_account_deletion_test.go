@@ -0,0 +1,119 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	cfg := NewConfig(logger)
+	cfg.Account.DeletionGracePeriodSec = 3600
+	leaderboardCache := NewLocalLeaderboardCache(ctx, logger, logger, db)
+	leaderboardRankCache := NewLocalLeaderboardRankCache(ctx, logger, db, cfg.Leaderboard, leaderboardCache)
+	groupIndex, err := NewLocalGroupIndex(logger, db)
+	require.NoError(t, err)
+	sessionRegistry := NewLocalSessionRegistry(metrics)
+	sessionCache := NewLocalSessionCache(3_600, 7_200)
+	tracker := &LocalTracker{}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	disabled := func(userID uuid.UUID) bool {
+		var disableTime time.Time
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT disable_time FROM users WHERE id = $1", userID).Scan(&disableTime))
+		return disableTime.Unix() != 0
+	}
+	exists := func(userID uuid.UUID) bool {
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM users WHERE id = $1", userID).Scan(&count))
+		return count != 0
+	}
+
+	t.Run("schedule and restore", func(t *testing.T) {
+		userID := newUser()
+
+		require.NoError(t, ScheduleAccountDeletion(ctx, logger, db, cfg, sessionRegistry, sessionCache, tracker, userID, false))
+		assert.True(t, disabled(userID))
+		assert.Equal(t, ErrAccountDeletionExists, ScheduleAccountDeletion(ctx, logger, db, cfg, sessionRegistry, sessionCache, tracker, userID, false))
+		deleteTime, err := GetAccountDeletionTime(ctx, db, userID)
+		require.NoError(t, err)
+		require.NotNil(t, deleteTime)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *deleteTime, time.Minute)
+
+		// Unbanning would enable an account that is still going to be deleted.
+		assert.Equal(t, ErrAccountDeletionPending, UnbanUsers(ctx, logger, db, sessionCache, []uuid.UUID{userID}))
+		assert.True(t, disabled(userID))
+
+		// Not deleted before the grace period has passed.
+		_, err = DeleteScheduledAccounts(ctx, logger, db, cfg, leaderboardCache, leaderboardRankCache, groupIndex, sessionRegistry, sessionCache, tracker, 100)
+		require.NoError(t, err)
+		assert.True(t, exists(userID))
+
+		require.NoError(t, RestoreAccount(ctx, logger, db, sessionCache, userID))
+		assert.False(t, disabled(userID))
+		deleteTime, err = GetAccountDeletionTime(ctx, db, userID)
+		require.NoError(t, err)
+		assert.Nil(t, deleteTime)
+		assert.Equal(t, ErrAccountDeletionNotFound, RestoreAccount(ctx, logger, db, sessionCache, userID))
+	})
+
+	t.Run("restore keeps bans", func(t *testing.T) {
+		bannedBefore, bannedDuring := newUser(), newUser()
+		require.NoError(t, BanUsers(ctx, logger, db, cfg, sessionCache, sessionRegistry, tracker, []uuid.UUID{bannedBefore}))
+		require.NoError(t, ScheduleAccountDeletion(ctx, logger, db, cfg, sessionRegistry, sessionCache, tracker, bannedBefore, false))
+		require.NoError(t, ScheduleAccountDeletion(ctx, logger, db, cfg, sessionRegistry, sessionCache, tracker, bannedDuring, false))
+		require.NoError(t, BanUsers(ctx, logger, db, cfg, sessionCache, sessionRegistry, tracker, []uuid.UUID{bannedDuring}))
+
+		require.NoError(t, RestoreAccount(ctx, logger, db, sessionCache, bannedBefore))
+		require.NoError(t, RestoreAccount(ctx, logger, db, sessionCache, bannedDuring))
+		assert.True(t, disabled(bannedBefore))
+		assert.True(t, disabled(bannedDuring))
+
+		// Once restored they can be unbanned as usual.
+		require.NoError(t, UnbanUsers(ctx, logger, db, sessionCache, []uuid.UUID{bannedBefore, bannedDuring}))
+		assert.False(t, disabled(bannedBefore))
+		assert.False(t, disabled(bannedDuring))
+	})
+
+	t.Run("scheduler deletes due accounts", func(t *testing.T) {
+		userID := newUser()
+		require.NoError(t, ScheduleAccountDeletion(ctx, logger, db, cfg, sessionRegistry, sessionCache, tracker, userID, true))
+		_, err := db.ExecContext(ctx, "UPDATE user_deletion SET delete_time = now() - INTERVAL '1 second' WHERE user_id = $1", userID)
+		require.NoError(t, err)
+
+		count, err := DeleteScheduledAccounts(ctx, logger, db, cfg, leaderboardCache, leaderboardRankCache, groupIndex, sessionRegistry, sessionCache, tracker, 100)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, count, 1)
+		assert.False(t, exists(userID))
+
+		var tombstones, deletions int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT (SELECT count(*) FROM user_tombstone WHERE user_id = $1), (SELECT count(*) FROM user_deletion WHERE user_id = $1)", userID).Scan(&tombstones, &deletions))
+		assert.Equal(t, 1, tombstones, "deletion recorded")
+		assert.Zero(t, deletions)
+	})
+}
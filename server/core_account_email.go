@@ -0,0 +1,295 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Purposes of the single-use codes sent to account email addresses.
+const (
+	EmailCodePurposeVerification  = "email_verification"
+	EmailCodePurposePasswordReset = "password_reset"
+)
+
+var errEmailCodeTooSoon = errors.New("email code requested too soon")
+
+// RequestEmailVerification sends a code to the user's email address that confirms they own it when passed to VerifyEmail.
+func RequestEmailVerification(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sender MailSender, userID uuid.UUID) error {
+	var email sql.NullString
+	var verifyTime time.Time
+	err := db.QueryRowContext(ctx, "SELECT email, verify_time FROM users WHERE id = $1", userID).Scan(&email, &verifyTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "User account not found.")
+		}
+		logger.Error("Could not read user email.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error requesting email verification.")
+	}
+	if email.String == "" {
+		return status.Error(codes.FailedPrecondition, "User account has no email address.")
+	}
+	if verifyTime.Unix() != 0 {
+		return status.Error(codes.FailedPrecondition, "Email address is already verified.")
+	}
+
+	code, err := issueEmailCode(ctx, db, config, userID, EmailCodePurposeVerification, email.String, config.GetMail().VerificationExpirySec)
+	if err != nil {
+		if err == errEmailCodeTooSoon {
+			return status.Error(codes.ResourceExhausted, "Email verification was requested too recently.")
+		}
+		logger.Error("Could not issue email verification code.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error requesting email verification.")
+	}
+
+	msg := newEmailCodeMessage(EmailCodePurposeVerification, userID, email.String, code, config.GetMail().VerificationURL, config.GetMail().VerificationExpirySec)
+	if err = sender.Send(ctx, msg); err != nil {
+		logger.Error("Could not send email verification.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error sending email verification.")
+	}
+	return nil
+}
+
+// VerifyEmail consumes an email verification code and marks the address it was sent to as verified, returning the
+// user the address belongs to.
+func VerifyEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, code string) (uuid.UUID, error) {
+	if code == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "Verification code is required.")
+	}
+
+	var userID uuid.UUID
+	err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		var email string
+		var err error
+		userID, email, err = consumeEmailCode(ctx, tx, config, EmailCodePurposeVerification, code)
+		if err != nil {
+			return err
+		}
+
+		// The account must still have the address the code was sent to.
+		res, err := tx.ExecContext(ctx, "UPDATE users SET verify_time = now(), update_time = now() WHERE id = $1 AND email = $2", userID, email)
+		if err != nil {
+			return err
+		}
+		if count, _ := res.RowsAffected(); count == 0 {
+			return StatusError(codes.InvalidArgument, "Verification code is invalid or expired.", nil)
+		}
+		return nil
+	})
+	if err != nil {
+		var statusErr *statusError
+		if errors.As(err, &statusErr) {
+			return uuid.Nil, statusErr.Status()
+		}
+		logger.Error("Could not verify email.", zap.Error(err))
+		return uuid.Nil, status.Error(codes.Internal, "Error verifying email.")
+	}
+	return userID, nil
+}
+
+// RequestPasswordReset sends a code to the given email address that allows the password of the account it belongs to
+// be changed with ResetPassword. The result does not reveal whether an account exists for the address.
+func RequestPasswordReset(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sender MailSender, email string) error {
+	if email == "" {
+		return status.Error(codes.InvalidArgument, "Email address is required.")
+	} else if invalidCharsRegex.MatchString(email) {
+		return status.Error(codes.InvalidArgument, "Invalid email address, no spaces or control characters allowed.")
+	} else if !emailRegex.MatchString(email) {
+		return status.Error(codes.InvalidArgument, "Invalid email address format.")
+	} else if len(email) < 10 || len(email) > 255 {
+		return status.Error(codes.InvalidArgument, "Invalid email address, must be 10-255 bytes.")
+	}
+
+	cleanEmail := strings.ToLower(email)
+
+	var userID uuid.UUID
+	var disableTime time.Time
+	err := db.QueryRowContext(ctx, "SELECT id, disable_time FROM users WHERE email = $1", cleanEmail).Scan(&userID, &disableTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		logger.Error("Could not look up user by email.", zap.Error(err))
+		return status.Error(codes.Internal, "Error requesting password reset.")
+	}
+	if disableTime.Unix() != 0 {
+		return nil
+	}
+
+	code, err := issueEmailCode(ctx, db, config, userID, EmailCodePurposePasswordReset, cleanEmail, config.GetMail().PasswordResetExpirySec)
+	if err != nil {
+		if err == errEmailCodeTooSoon {
+			// Don't tell the caller, the previous code is still valid.
+			return nil
+		}
+		logger.Error("Could not issue password reset code.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error requesting password reset.")
+	}
+
+	msg := newEmailCodeMessage(EmailCodePurposePasswordReset, userID, cleanEmail, code, config.GetMail().PasswordResetURL, config.GetMail().PasswordResetExpirySec)
+	if err = sender.Send(ctx, msg); err != nil {
+		// Failures are not reported to the caller, it would reveal the account exists.
+		logger.Error("Could not send password reset.", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+	return nil
+}
+
+// ResetPassword consumes a password reset code and sets a new password on the account it was issued for, returning
+// the user whose password was changed. Receiving the code also proves ownership of the email address.
+func ResetPassword(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, code, password string) (uuid.UUID, error) {
+	if code == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "Password reset code is required.")
+	} else if len(password) < 8 {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "Password must be at least 8 characters long.")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("Error hashing password.", zap.Error(err))
+		return uuid.Nil, status.Error(codes.Internal, "Error resetting password.")
+	}
+
+	var userID uuid.UUID
+	err = ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		var email string
+		var err error
+		userID, email, err = consumeEmailCode(ctx, tx, config, EmailCodePurposePasswordReset, code)
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, `
+UPDATE users
+SET password = $3, verify_time = CASE WHEN verify_time = '1970-01-01 00:00:00 UTC' THEN now() ELSE verify_time END, update_time = now()
+WHERE id = $1 AND email = $2 AND disable_time = '1970-01-01 00:00:00 UTC'`, userID, email, hashedPassword)
+		if err != nil {
+			return err
+		}
+		if count, _ := res.RowsAffected(); count == 0 {
+			return StatusError(codes.InvalidArgument, "Password reset code is invalid or expired.", nil)
+		}
+		return nil
+	})
+	if err != nil {
+		var statusErr *statusError
+		if errors.As(err, &statusErr) {
+			return uuid.Nil, statusErr.Status()
+		}
+		logger.Error("Could not reset password.", zap.Error(err))
+		return uuid.Nil, status.Error(codes.Internal, "Error resetting password.")
+	}
+	return userID, nil
+}
+
+// Store a new code for the user, replacing any earlier code with the same purpose. Codes are not replaced if the
+// previous one was issued within the configured resend interval.
+func issueEmailCode(ctx context.Context, db *sql.DB, config Config, userID uuid.UUID, purpose, email string, expirySec int) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := base64.RawURLEncoding.EncodeToString(b)
+
+	res, err := db.ExecContext(ctx, `
+INSERT INTO user_email_code (user_id, purpose, code_hash, email, expiry_time)
+VALUES ($1, $2, $3, $4, now() + make_interval(secs => $5))
+ON CONFLICT (user_id, purpose) DO UPDATE
+SET code_hash = $3, email = $4, create_time = now(), expiry_time = now() + make_interval(secs => $5)
+WHERE user_email_code.create_time <= now() - make_interval(secs => $6)`,
+		userID, purpose, emailCodeHash(config, code), email, expirySec, config.GetMail().ResendIntervalSec)
+	if err != nil {
+		return "", err
+	}
+	if count, _ := res.RowsAffected(); count == 0 {
+		return "", errEmailCodeTooSoon
+	}
+	return code, nil
+}
+
+// Remove a code so it can't be used again, returning the user and address it was issued for.
+func consumeEmailCode(ctx context.Context, tx *sql.Tx, config Config, purpose, code string) (uuid.UUID, string, error) {
+	var userID uuid.UUID
+	var email string
+	var expiryTime time.Time
+	err := tx.QueryRowContext(ctx, "DELETE FROM user_email_code WHERE code_hash = $1 AND purpose = $2 RETURNING user_id, email, expiry_time", emailCodeHash(config, code), purpose).Scan(&userID, &email, &expiryTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, "", StatusError(codes.InvalidArgument, "Code is invalid or expired.", err)
+		}
+		return uuid.Nil, "", err
+	}
+	if expiryTime.Before(time.Now()) {
+		return uuid.Nil, "", StatusError(codes.InvalidArgument, "Code is invalid or expired.", nil)
+	}
+	return userID, email, nil
+}
+
+// Codes are stored as a keyed hash so a copy of the database can't be used to take over accounts.
+func emailCodeHash(config Config, code string) []byte {
+	mac := hmac.New(sha256.New, []byte(config.GetSession().EncryptionKey))
+	mac.Write([]byte(code))
+	return mac.Sum(nil)
+}
+
+func newEmailCodeMessage(purpose string, userID uuid.UUID, email, code, baseURL string, expirySec int) *MailMessage {
+	msg := &MailMessage{
+		Type:   purpose,
+		UserID: userID.String(),
+		To:     email,
+		Code:   code,
+	}
+	if baseURL != "" {
+		if u, err := url.Parse(baseURL); err == nil {
+			q := u.Query()
+			q.Set("code", code)
+			u.RawQuery = q.Encode()
+			msg.URL = u.String()
+		}
+	}
+
+	var b strings.Builder
+	switch purpose {
+	case EmailCodePurposeVerification:
+		msg.Subject = "Verify your email address"
+		b.WriteString("Use the code below to verify your email address.\n\n")
+	case EmailCodePurposePasswordReset:
+		msg.Subject = "Reset your password"
+		b.WriteString("Use the code below to reset your password. If you did not ask to reset your password you can ignore this email.\n\n")
+	}
+	b.WriteString(code)
+	b.WriteString("\n")
+	if msg.URL != "" {
+		fmt.Fprintf(&b, "\nOr open this link:\n%s\n", msg.URL)
+	}
+	fmt.Fprintf(&b, "\nThe code expires in %v.\n", time.Duration(expirySec)*time.Second)
+	msg.Body = b.String()
+	return msg
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailCodeMessage(t *testing.T) {
+	userID := uuid.Must(uuid.NewV4())
+
+	msg := newEmailCodeMessage(EmailCodePurposePasswordReset, userID, "player@example.com", "abc-123_", "https://example.com/reset?lang=en", 3600)
+	assert.Equal(t, EmailCodePurposePasswordReset, msg.Type)
+	assert.Equal(t, userID.String(), msg.UserID)
+	assert.Equal(t, "player@example.com", msg.To)
+	assert.Equal(t, "https://example.com/reset?code=abc-123_&lang=en", msg.URL)
+	assert.Contains(t, msg.Body, "abc-123_")
+	assert.Contains(t, msg.Body, msg.URL)
+	assert.Contains(t, msg.Body, "1h0m0s")
+
+	msg = newEmailCodeMessage(EmailCodePurposeVerification, userID, "player@example.com", "abc-123_", "", 86400)
+	assert.Empty(t, msg.URL)
+	assert.Contains(t, msg.Body, "abc-123_")
+}
+
+func TestEmailCodeHash(t *testing.T) {
+	config := NewConfig(loggerForTest(t))
+	hash := emailCodeHash(config, "abc")
+	assert.Len(t, hash, 32)
+	assert.Equal(t, hash, emailCodeHash(config, "abc"))
+	assert.NotEqual(t, hash, emailCodeHash(config, "abd"))
+
+	config.GetSession().EncryptionKey = "anotherkey"
+	assert.NotEqual(t, hash, emailCodeHash(config, "abc"))
+}
@@ -0,0 +1,568 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// How storage objects are handled when the source and target of an account merge both own an object with the same
+// collection and key.
+const (
+	// Keep the target's object, the source's object is deleted with the source account.
+	AccountMergeStorageKeepTarget = iota
+	// Replace the target's object with the source's object.
+	AccountMergeStorageKeepSource
+	// Abort the merge.
+	AccountMergeStorageFail
+)
+
+// Names of the storage policies, as accepted by the runtime.
+var accountMergeStoragePolicies = map[string]int{
+	"target": AccountMergeStorageKeepTarget,
+	"source": AccountMergeStorageKeepSource,
+	"fail":   AccountMergeStorageFail,
+}
+
+var (
+	ErrAccountMergeSameUser        = errors.New("cannot merge an account into itself")
+	ErrAccountMergeStorageConflict = errors.New("source and target accounts both own storage objects with the same collection and key")
+)
+
+// Social identity columns of the users table moved by an account merge. Email is handled separately as it moves along
+// with its password.
+var accountMergeIdentityColumns = []string{"apple_id", "facebook_id", "facebook_instant_game_id", "google_id", "gamecenter_id", "steam_id", "custom_id"}
+
+type accountMergeRecord struct {
+	leaderboardID string
+	expiryUnix    int64
+	score         int64
+	subscore      int64
+	numScore      int32
+}
+
+// MergeAccounts moves everything a source user owns into a target user and then deletes the source user, recording it
+// in the user tombstone table, all in one transaction.
+//
+// Linked identities, devices and email move unless the target already has one of the same kind. Storage objects move
+// according to the storage policy. Wallet balances are added together and wallet ledger entries, purchases and
+// subscriptions move. Friends, blocks and pending invites move unless the target already has a relationship with the
+// same user. Group memberships move, keeping the better state when both users are in the same group and never
+// lifting a ban on either of them. Leaderboard records move, keeping the better of the two records when both users
+// have one.
+func MergeAccounts(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, sessionCache SessionCache, tracker Tracker, storageIndex StorageIndex, groupIndex GroupIndex, sourceID, targetID uuid.UUID, storagePolicy int) error {
+	if sourceID == uuid.Nil || targetID == uuid.Nil {
+		return errors.New("cannot merge the system user")
+	}
+	if sourceID == targetID {
+		return ErrAccountMergeSameUser
+	}
+
+	ts := time.Now().UTC().Unix()
+	var storageDeletes StorageOpDeletes
+	var storageWrites []*api.StorageObject
+	var movedRecords []*accountMergeRecord
+	var replacedRecords []*accountMergeRecord
+	var groupIDs []uuid.UUID
+
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		storageDeletes, storageWrites, movedRecords, replacedRecords, groupIDs = nil, nil, nil, nil, nil
+
+		// Lock both users, in a consistent order.
+		rows, err := tx.QueryContext(ctx, "SELECT id, username, wallet FROM users WHERE id IN ($1, $2) ORDER BY id FOR UPDATE", sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		var targetUsername string
+		wallets := make(map[uuid.UUID]map[string]int64, 2)
+		for rows.Next() {
+			var id uuid.UUID
+			var username string
+			var wallet sql.NullString
+			if err = rows.Scan(&id, &username, &wallet); err != nil {
+				_ = rows.Close()
+				return err
+			}
+			walletMap := make(map[string]int64)
+			if wallet.String != "" {
+				if err = json.Unmarshal([]byte(wallet.String), &walletMap); err != nil {
+					_ = rows.Close()
+					return err
+				}
+			}
+			wallets[id] = walletMap
+			if id == targetID {
+				targetUsername = username
+			}
+		}
+		_ = rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		if len(wallets) != 2 {
+			return ErrAccountNotFound
+		}
+
+		if err = accountMergeIdentities(ctx, tx, sourceID, targetID); err != nil {
+			return err
+		}
+
+		if storageDeletes, storageWrites, err = accountMergeStorage(ctx, tx, sourceID, targetID, storagePolicy); err != nil {
+			return err
+		}
+
+		// Wallet ledger entries move with the balances they account for.
+		for k, v := range wallets[sourceID] {
+			wallets[targetID][k] += v
+		}
+		wallet, err := json.Marshal(wallets[targetID])
+		if err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, "UPDATE users SET wallet = $2, update_time = now() WHERE id = $1", targetID, wallet); err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, "UPDATE wallet_ledger SET user_id = $2 WHERE user_id = $1", sourceID, targetID); err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, "UPDATE purchase SET user_id = $2 WHERE user_id = $1", sourceID, targetID); err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, "UPDATE subscription SET user_id = $2 WHERE user_id = $1", sourceID, targetID); err != nil {
+			return err
+		}
+
+		if err = accountMergeFriends(ctx, tx, sourceID, targetID); err != nil {
+			return err
+		}
+
+		mergedGroupIDs, err := accountMergeGroups(ctx, tx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+
+		if movedRecords, replacedRecords, err = accountMergeLeaderboardRecords(ctx, tx, leaderboardCache, sourceID, targetID, targetUsername); err != nil {
+			return err
+		}
+
+		// Anything left behind is deleted with the source account.
+		_, groupIDs, err = deleteAccountTx(ctx, logger, tx, leaderboardCache, leaderboardRankCache, sourceID, true, ts)
+		groupIDs = append(groupIDs, mergedGroupIDs...)
+		return err
+	}); err != nil {
+		if err != ErrAccountNotFound && err != ErrAccountMergeStorageConflict {
+			logger.Error("Error merging accounts.", zap.Error(err), zap.String("source_id", sourceID.String()), zap.String("target_id", targetID.String()))
+		}
+		return err
+	}
+
+	if len(storageDeletes) > 0 {
+		storageIndex.Delete(ctx, storageDeletes)
+	}
+	if len(storageWrites) > 0 {
+		storageIndex.Write(ctx, storageWrites)
+	}
+	if len(groupIDs) > 0 {
+		groupIndex.Refresh(ctx, groupIDs)
+	}
+
+	for _, record := range replacedRecords {
+		leaderboardRankCache.Delete(record.leaderboardID, record.expiryUnix, targetID)
+	}
+	for _, record := range movedRecords {
+		leaderboardRankCache.Delete(record.leaderboardID, record.expiryUnix, sourceID)
+		if record.expiryUnix != 0 && record.expiryUnix <= ts {
+			// Expired ranks are handled by the rank cache itself.
+			continue
+		}
+		if leaderboard := leaderboardCache.Get(record.leaderboardID); leaderboard != nil {
+			leaderboardRankCache.Insert(record.leaderboardID, leaderboard.SortOrder, record.score, record.subscore, record.numScore, record.expiryUnix, targetID, leaderboard.EnableRanks)
+		}
+	}
+
+	return disconnectDeletedAccount(ctx, config, sessionRegistry, sessionCache, tracker, sourceID)
+}
+
+func accountMergeIdentities(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID) error {
+	// Identities are unique across users, so each is cleared from the source before being set on the target.
+	for _, column := range accountMergeIdentityColumns {
+		var sourceValue, targetValue sql.NullString
+		if err := tx.QueryRowContext(ctx, "SELECT (SELECT "+column+" FROM users WHERE id = $1), (SELECT "+column+" FROM users WHERE id = $2)", sourceID, targetID).Scan(&sourceValue, &targetValue); err != nil {
+			return err
+		}
+		if !sourceValue.Valid || targetValue.Valid {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET "+column+" = NULL WHERE id = $1", sourceID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET "+column+" = $2, update_time = now() WHERE id = $1", targetID, sourceValue.String); err != nil {
+			return err
+		}
+	}
+
+	// The email address moves with its password and verification state.
+	var email, targetEmail sql.NullString
+	var password []byte
+	var verifyTime time.Time
+	if err := tx.QueryRowContext(ctx, "SELECT email, password, verify_time, (SELECT email FROM users WHERE id = $2) FROM users WHERE id = $1", sourceID, targetID).Scan(&email, &password, &verifyTime, &targetEmail); err != nil {
+		return err
+	}
+	if email.Valid && !targetEmail.Valid {
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET email = NULL, password = NULL WHERE id = $1", sourceID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET email = $2, password = $3, verify_time = $4, update_time = now() WHERE id = $1", targetID, email.String, password, verifyTime); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE user_device SET user_id = $2 WHERE user_id = $1", sourceID, targetID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE user_identity SET user_id = $2, update_time = now() WHERE user_id = $1 AND provider NOT IN (SELECT provider FROM user_identity WHERE user_id = $2)", sourceID, targetID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Move storage objects from the source to the target, returning the storage index changes to apply once the merge is
+// committed.
+func accountMergeStorage(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID, storagePolicy int) (StorageOpDeletes, []*api.StorageObject, error) {
+	switch storagePolicy {
+	case AccountMergeStorageKeepSource:
+		if _, err := tx.ExecContext(ctx, `
+DELETE FROM storage t USING storage s
+WHERE t.user_id = $2 AND s.user_id = $1 AND t.collection = s.collection AND t.key = s.key`, sourceID, targetID); err != nil {
+			return nil, nil, err
+		}
+	case AccountMergeStorageFail:
+		var conflict bool
+		if err := tx.QueryRowContext(ctx, `
+SELECT EXISTS (
+	SELECT 1 FROM storage s JOIN storage t ON t.collection = s.collection AND t.key = s.key
+	WHERE s.user_id = $1 AND t.user_id = $2
+)`, sourceID, targetID).Scan(&conflict); err != nil {
+			return nil, nil, err
+		}
+		if conflict {
+			return nil, nil, ErrAccountMergeStorageConflict
+		}
+	}
+
+	var deletes StorageOpDeletes
+	rows, err := tx.QueryContext(ctx, "SELECT collection, key FROM storage WHERE user_id = $1", sourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for rows.Next() {
+		var collection, key string
+		if err = rows.Scan(&collection, &key); err != nil {
+			_ = rows.Close()
+			return nil, nil, err
+		}
+		deletes = append(deletes, &StorageOpDelete{
+			OwnerID:  sourceID.String(),
+			ObjectID: &api.DeleteStorageObjectId{Collection: collection, Key: key},
+		})
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var writes []*api.StorageObject
+	rows, err = tx.QueryContext(ctx, `
+UPDATE storage SET user_id = $2
+WHERE user_id = $1 AND (collection, key) NOT IN (SELECT collection, key FROM storage WHERE user_id = $2)
+RETURNING collection, key, value, version, read, write, create_time, update_time`, sourceID, targetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for rows.Next() {
+		object := &api.StorageObject{UserId: targetID.String()}
+		var read, write int32
+		var createTime, updateTime pgtype.Timestamptz
+		if err = rows.Scan(&object.Collection, &object.Key, &object.Value, &object.Version, &read, &write, &createTime, &updateTime); err != nil {
+			_ = rows.Close()
+			return nil, nil, err
+		}
+		object.PermissionRead = read
+		object.PermissionWrite = write
+		object.CreateTime = timestamppb.New(createTime.Time)
+		object.UpdateTime = timestamppb.New(updateTime.Time)
+		writes = append(writes, object)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return deletes, writes, nil
+}
+
+func accountMergeFriends(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID) error {
+	// Any relationship between the two accounts themselves goes away.
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_edge WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", sourceID, targetID); err != nil {
+		return err
+	}
+
+	// Move both directions of each relationship the target does not already have with the same user.
+	if _, err := tx.ExecContext(ctx, `
+UPDATE user_edge SET source_id = $2
+WHERE source_id = $1 AND destination_id NOT IN (SELECT destination_id FROM user_edge WHERE source_id = $2)`, sourceID, targetID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `
+UPDATE user_edge SET destination_id = $2
+WHERE destination_id = $1 AND source_id NOT IN (SELECT source_id FROM user_edge WHERE destination_id = $2)`, sourceID, targetID); err != nil {
+		return err
+	}
+
+	// Remove what is left, then recount the edges of every user affected. The recount is a separate statement as a
+	// data-modifying CTE's changes would not be visible to it.
+	rows, err := tx.QueryContext(ctx, "DELETE FROM user_edge WHERE source_id = $1 OR destination_id = $1 RETURNING source_id", sourceID)
+	if err != nil {
+		return err
+	}
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err = rows.Scan(&userID); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		if userID != sourceID {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+UPDATE users SET edge_count = (SELECT count(*) FROM user_edge WHERE source_id = users.id), update_time = now()
+WHERE id = $1 OR id = ANY($2) OR id IN (SELECT source_id FROM user_edge WHERE destination_id = $1)`, targetID, userIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Move group memberships, join requests, bans and invites to the target. Where both users have a relationship with
+// the same group the target keeps the state chosen by accountMergeGroupState, so merging a group's owner into one of
+// its members doesn't leave the group to be deleted with the source account. Returns the groups whose member count
+// changed.
+func accountMergeGroups(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID) ([]uuid.UUID, error) {
+	// Move memberships, join requests and bans in groups the target has no relationship with yet.
+	rows, err := tx.QueryContext(ctx, `
+UPDATE group_edge SET source_id = $2
+WHERE source_id = $1 AND destination_id NOT IN (SELECT destination_id FROM group_edge WHERE source_id = $2)
+RETURNING destination_id`, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	var groupIDs []uuid.UUID
+	for rows.Next() {
+		var groupID uuid.UUID
+		if err = rows.Scan(&groupID); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(groupIDs) > 0 {
+		if _, err = tx.ExecContext(ctx, "UPDATE group_edge SET destination_id = $2 WHERE destination_id = $1 AND source_id = ANY($3)", sourceID, targetID, groupIDs); err != nil {
+			return nil, err
+		}
+		if _, err = tx.ExecContext(ctx, "UPDATE group_user_role SET user_id = $2 WHERE user_id = $1 AND group_id = ANY($3)", sourceID, targetID, groupIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Whatever is left are groups both users have a relationship with.
+	rows, err = tx.QueryContext(ctx, `
+SELECT s.destination_id, s.state, t.state
+FROM group_edge s
+JOIN group_edge t ON t.source_id = $2 AND t.destination_id = s.destination_id
+WHERE s.source_id = $1`, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	type sharedGroup struct {
+		groupID     uuid.UUID
+		sourceState int
+		targetState int
+	}
+	var shared []*sharedGroup
+	for rows.Next() {
+		g := &sharedGroup{}
+		if err = rows.Scan(&g.groupID, &g.sourceState, &g.targetState); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		shared = append(shared, g)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var changedGroupIDs []uuid.UUID
+	for _, g := range shared {
+		// Remove the source's edges first so the target can take over their state and position.
+		var position int64
+		if err = tx.QueryRowContext(ctx, "DELETE FROM group_edge WHERE source_id = $1 AND destination_id = $2 RETURNING position", g.groupID, sourceID).Scan(&position); err != nil {
+			return nil, err
+		}
+		if _, err = tx.ExecContext(ctx, "DELETE FROM group_edge WHERE source_id = $1 AND destination_id = $2", sourceID, g.groupID); err != nil {
+			return nil, err
+		}
+
+		state := accountMergeGroupState(g.sourceState, g.targetState)
+		if state != g.targetState {
+			if _, err = tx.ExecContext(ctx, "UPDATE group_edge SET state = $3, position = $4, inviter_id = NULL, invite_expire_time = NULL, update_time = now() WHERE source_id = $1 AND destination_id = $2", g.groupID, targetID, state, position); err != nil {
+				return nil, err
+			}
+			if _, err = tx.ExecContext(ctx, "UPDATE group_edge SET state = $3, inviter_id = NULL, invite_expire_time = NULL, update_time = now() WHERE source_id = $1 AND destination_id = $2", targetID, g.groupID, state); err != nil {
+				return nil, err
+			}
+		}
+
+		// The target keeps its own role, or takes the source's if it had none.
+		if _, err = tx.ExecContext(ctx, `
+UPDATE group_user_role SET user_id = $2
+WHERE group_id = $3 AND user_id = $1 AND NOT EXISTS (SELECT 1 FROM group_user_role WHERE group_id = $3 AND user_id = $2)`, sourceID, targetID, g.groupID); err != nil {
+			return nil, err
+		}
+		if _, err = tx.ExecContext(ctx, "DELETE FROM group_user_role WHERE group_id = $1 AND user_id = $2", g.groupID, sourceID); err != nil {
+			return nil, err
+		}
+		if state > int(api.GroupUserList_GroupUser_MEMBER) {
+			// Only members hold roles.
+			if _, err = tx.ExecContext(ctx, "DELETE FROM group_user_role WHERE group_id = $1 AND user_id = $2", g.groupID, targetID); err != nil {
+				return nil, err
+			}
+		}
+
+		// Both users may have counted towards the group's members, now only the target may.
+		isMember := func(state int) int {
+			if state <= int(api.GroupUserList_GroupUser_MEMBER) {
+				return 1
+			}
+			return 0
+		}
+		if delta := isMember(state) - isMember(g.sourceState) - isMember(g.targetState); delta != 0 {
+			if _, err = tx.ExecContext(ctx, "UPDATE groups SET edge_count = edge_count + $2, update_time = now() WHERE id = $1", g.groupID, delta); err != nil {
+				return nil, err
+			}
+			changedGroupIDs = append(changedGroupIDs, g.groupID)
+		}
+	}
+
+	return changedGroupIDs, nil
+}
+
+// Pick the state the target keeps in a group both users have a relationship with. A ban on either account always
+// stands, otherwise the better of the superadmin, admin, member and join request states is kept. Invites only count
+// when the other account has nothing better.
+func accountMergeGroupState(sourceState, targetState int) int {
+	switch {
+	case sourceState == BANNED_CODE || targetState == BANNED_CODE:
+		return BANNED_CODE
+	case sourceState == INVITED_CODE:
+		return targetState
+	case targetState == INVITED_CODE:
+		return sourceState
+	default:
+		return min(sourceState, targetState)
+	}
+}
+
+// Move leaderboard records to the target, returning the records moved and the target records they replaced so the
+// rank cache can be updated once the merge is committed.
+func accountMergeLeaderboardRecords(ctx context.Context, tx *sql.Tx, leaderboardCache LeaderboardCache, sourceID, targetID uuid.UUID, targetUsername string) ([]*accountMergeRecord, []*accountMergeRecord, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT s.leaderboard_id, s.expiry_time, s.score, s.subscore, s.num_score, t.score, t.subscore
+FROM leaderboard_record s
+LEFT JOIN leaderboard_record t ON t.owner_id = $2 AND t.leaderboard_id = s.leaderboard_id AND t.expiry_time = s.expiry_time
+WHERE s.owner_id = $1`, sourceID, targetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var moved, replaced []*accountMergeRecord
+	for rows.Next() {
+		record := &accountMergeRecord{}
+		var expiryTime pgtype.Timestamptz
+		var targetScore, targetSubscore sql.NullInt64
+		if err = rows.Scan(&record.leaderboardID, &expiryTime, &record.score, &record.subscore, &record.numScore, &targetScore, &targetSubscore); err != nil {
+			_ = rows.Close()
+			return nil, nil, err
+		}
+		record.expiryUnix = expiryTime.Time.Unix()
+
+		if targetScore.Valid {
+			sortOrder := LeaderboardSortOrderDescending
+			if leaderboard := leaderboardCache.Get(record.leaderboardID); leaderboard != nil {
+				sortOrder = leaderboard.SortOrder
+			}
+			if !accountMergeRecordBetter(sortOrder, record.score, record.subscore, targetScore.Int64, targetSubscore.Int64) {
+				// The target's record stands, the source's is deleted with the source account.
+				continue
+			}
+			replaced = append(replaced, record)
+		}
+		moved = append(moved, record)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, record := range replaced {
+		if _, err = tx.ExecContext(ctx, "DELETE FROM leaderboard_record WHERE owner_id = $1 AND leaderboard_id = $2 AND expiry_time = $3", targetID, record.leaderboardID, time.Unix(record.expiryUnix, 0).UTC()); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, record := range moved {
+		if _, err = tx.ExecContext(ctx, "UPDATE leaderboard_record SET owner_id = $2, username = $3 WHERE owner_id = $1 AND leaderboard_id = $4 AND expiry_time = $5", sourceID, targetID, targetUsername, record.leaderboardID, time.Unix(record.expiryUnix, 0).UTC()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return moved, replaced, nil
+}
+
+func accountMergeRecordBetter(sortOrder int, score, subscore, otherScore, otherSubscore int64) bool {
+	if sortOrder == LeaderboardSortOrderAscending {
+		return score < otherScore || (score == otherScore && subscore < otherSubscore)
+	}
+	return score > otherScore || (score == otherScore && subscore > otherSubscore)
+}
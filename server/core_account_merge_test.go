@@ -0,0 +1,275 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMergeAccountsInvalid(t *testing.T) {
+	userID := uuid.Must(uuid.NewV4())
+
+	// Rejected before the database is touched.
+	err := MergeAccounts(context.Background(), zap.NewNop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, userID, userID, AccountMergeStorageKeepTarget)
+	assert.Equal(t, ErrAccountMergeSameUser, err)
+	err = MergeAccounts(context.Background(), zap.NewNop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, userID, uuid.Nil, AccountMergeStorageKeepTarget)
+	assert.Error(t, err)
+}
+
+func TestMergeAccountsRecordBetter(t *testing.T) {
+	assert.True(t, accountMergeRecordBetter(LeaderboardSortOrderDescending, 10, 0, 9, 5))
+	assert.True(t, accountMergeRecordBetter(LeaderboardSortOrderDescending, 10, 2, 10, 1))
+	assert.False(t, accountMergeRecordBetter(LeaderboardSortOrderDescending, 10, 1, 10, 1))
+	assert.False(t, accountMergeRecordBetter(LeaderboardSortOrderDescending, 9, 5, 10, 0))
+
+	assert.True(t, accountMergeRecordBetter(LeaderboardSortOrderAscending, 9, 5, 10, 0))
+	assert.True(t, accountMergeRecordBetter(LeaderboardSortOrderAscending, 10, 1, 10, 2))
+	assert.False(t, accountMergeRecordBetter(LeaderboardSortOrderAscending, 10, 1, 10, 1))
+	assert.False(t, accountMergeRecordBetter(LeaderboardSortOrderAscending, 10, 0, 9, 5))
+}
+
+func TestMergeAccountsGroupState(t *testing.T) {
+	assert.Equal(t, 0, accountMergeGroupState(0, 2))
+	assert.Equal(t, 1, accountMergeGroupState(3, 1))
+	assert.Equal(t, 2, accountMergeGroupState(2, 3))
+	assert.Equal(t, BANNED_CODE, accountMergeGroupState(2, BANNED_CODE), "bans are never lifted")
+	assert.Equal(t, BANNED_CODE, accountMergeGroupState(BANNED_CODE, 0))
+	assert.Equal(t, BANNED_CODE, accountMergeGroupState(INVITED_CODE, BANNED_CODE))
+	assert.Equal(t, 3, accountMergeGroupState(INVITED_CODE, 3))
+	assert.Equal(t, 2, accountMergeGroupState(2, INVITED_CODE))
+	assert.Equal(t, INVITED_CODE, accountMergeGroupState(INVITED_CODE, INVITED_CODE))
+}
+
+func TestMergeAccounts(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	leaderboardCache := NewLocalLeaderboardCache(ctx, logger, logger, db)
+	leaderboardRankCache := NewLocalLeaderboardRankCache(ctx, logger, db, cfg.Leaderboard, leaderboardCache)
+	groupIndex, err := NewLocalGroupIndex(logger, db)
+	require.NoError(t, err)
+	sessionRegistry := NewLocalSessionRegistry(metrics)
+	sessionCache := NewLocalSessionCache(3_600, 7_200)
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	exec := func(query string, args ...any) {
+		_, err := db.ExecContext(ctx, query, args...)
+		require.NoError(t, err)
+	}
+	source, target, friend, mutual := newUser(), newUser(), newUser(), newUser()
+
+	// Identities.
+	customID := uuid.Must(uuid.NewV4()).String()
+	deviceID := uuid.Must(uuid.NewV4()).String()
+	exec("UPDATE users SET custom_id = $2 WHERE id = $1", source, customID)
+	exec("INSERT INTO user_device (id, user_id) VALUES ($1, $2)", deviceID, source)
+
+	// Storage, with one object both users have.
+	exec("INSERT INTO storage (collection, key, user_id, value, version) VALUES ('merge', 'shared', $1, '{\"from\": \"source\"}', 'a'), ('merge', 'source', $1, '{}', 'b')", source)
+	exec("INSERT INTO storage (collection, key, user_id, value, version) VALUES ('merge', 'shared', $1, '{\"from\": \"target\"}', 'c')", target)
+
+	// Wallets.
+	exec("UPDATE users SET wallet = '{\"coins\": 10}' WHERE id = $1", source)
+	exec("UPDATE users SET wallet = '{\"coins\": 5, \"gems\": 1}' WHERE id = $1", target)
+
+	// The source is friends with another user, and both accounts are friends with a third.
+	position := time.Now().UTC().UnixNano()
+	exec("INSERT INTO user_edge (source_id, destination_id, state, position) VALUES ($1, $2, 0, $3), ($2, $1, 0, $3)", source, friend, position)
+	exec("INSERT INTO user_edge (source_id, destination_id, state, position) VALUES ($1, $2, 0, $3), ($2, $1, 0, $3)", source, mutual, position+1)
+	exec("INSERT INTO user_edge (source_id, destination_id, state, position) VALUES ($1, $2, 0, $3), ($2, $1, 0, $3)", target, mutual, position+2)
+	exec("UPDATE users SET edge_count = 2 WHERE id IN ($1, $2)", source, mutual)
+	exec("UPDATE users SET edge_count = 1 WHERE id IN ($1, $2)", target, friend)
+
+	// The source owns a group the target has asked to join, both are members of a group owned by someone else where
+	// the source holds a role, and the source is a member of a group the target is banned from.
+	owned, err := CreateGroup(ctx, logger, db, groupIndex, source, source, uuid.Must(uuid.NewV4()).String(), "en", "", "", "", false, 100)
+	require.NoError(t, err)
+	ownedID := uuid.Must(uuid.FromString(owned.Id))
+	require.NoError(t, JoinGroup(ctx, logger, db, groupIndex, tracker, router, ownedID, target, target.String()))
+	shared, err := CreateGroup(ctx, logger, db, groupIndex, friend, friend, uuid.Must(uuid.NewV4()).String(), "en", "", "", "", true, 100)
+	require.NoError(t, err)
+	sharedID := uuid.Must(uuid.FromString(shared.Id))
+	require.NoError(t, AddGroupUsers(ctx, logger, db, groupIndex, tracker, router, friend, sharedID, []uuid.UUID{source, target}))
+	require.NoError(t, GroupRoleSet(ctx, logger, db, sharedID, "officer", GroupPermissionKick))
+	require.NoError(t, GroupUserRoleAssign(ctx, logger, db, sharedID, source, "officer"))
+	banned, err := CreateGroup(ctx, logger, db, groupIndex, friend, friend, uuid.Must(uuid.NewV4()).String(), "en", "", "", "", true, 100)
+	require.NoError(t, err)
+	bannedID := uuid.Must(uuid.FromString(banned.Id))
+	require.NoError(t, AddGroupUsers(ctx, logger, db, groupIndex, tracker, router, friend, bannedID, []uuid.UUID{source, target}))
+	require.NoError(t, BanGroupUsers(ctx, logger, db, groupIndex, tracker, router, nil, friend, bannedID, []uuid.UUID{target}))
+
+	// Leaderboard records, with leaderboards where the source or the target has the better record.
+	sourceBetterLeaderboard, targetBetterLeaderboard, onlySourceLeaderboard := uuid.Must(uuid.NewV4()).String(), uuid.Must(uuid.NewV4()).String(), uuid.Must(uuid.NewV4()).String()
+	for _, id := range []string{sourceBetterLeaderboard, targetBetterLeaderboard, onlySourceLeaderboard} {
+		_, _, err = leaderboardCache.Create(ctx, id, false, LeaderboardSortOrderDescending, LeaderboardOperatorBest, "", "", true)
+		require.NoError(t, err)
+	}
+	writeRecord := func(leaderboardID string, ownerID uuid.UUID, score int64) {
+		_, err := LeaderboardRecordWrite(ctx, logger, db, leaderboardCache, leaderboardRankCache, uuid.Nil, leaderboardID, ownerID.String(), ownerID.String(), score, 0, "", api.Operator_NO_OVERRIDE)
+		require.NoError(t, err)
+	}
+	writeRecord(sourceBetterLeaderboard, source, 10)
+	writeRecord(sourceBetterLeaderboard, target, 5)
+	writeRecord(targetBetterLeaderboard, source, 7)
+	writeRecord(targetBetterLeaderboard, target, 20)
+	writeRecord(onlySourceLeaderboard, source, 3)
+
+	require.NoError(t, MergeAccounts(ctx, logger, db, cfg, leaderboardCache, leaderboardRankCache, sessionRegistry, sessionCache, tracker, storageIdx, groupIndex, source, target, AccountMergeStorageKeepTarget))
+
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM users WHERE id = $1", source).Scan(&count))
+	assert.Zero(t, count, "source deleted")
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM user_tombstone WHERE user_id = $1", source).Scan(&count))
+	assert.Equal(t, 1, count, "source recorded in the tombstone table")
+
+	var dbCustomID sql.NullString
+	var wallet string
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT custom_id, wallet FROM users WHERE id = $1", target).Scan(&dbCustomID, &wallet))
+	assert.Equal(t, customID, dbCustomID.String)
+	assert.JSONEq(t, `{"coins": 15, "gems": 1}`, wallet)
+	var deviceUserID uuid.UUID
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT user_id FROM user_device WHERE id = $1", deviceID).Scan(&deviceUserID))
+	assert.Equal(t, target, deviceUserID)
+
+	var value string
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT value FROM storage WHERE collection = 'merge' AND key = 'shared' AND user_id = $1", target).Scan(&value))
+	assert.JSONEq(t, `{"from": "target"}`, value, "target kept its own object")
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM storage WHERE collection = 'merge' AND key = 'source' AND user_id = $1", target).Scan(&count))
+	assert.Equal(t, 1, count, "source object moved")
+
+	var edgeCount int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM user_edge WHERE source_id = $1 AND destination_id = $2 AND state = 0", target, friend).Scan(&count))
+	assert.Equal(t, 1, count, "friendship moved")
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT edge_count FROM users WHERE id = $1", friend).Scan(&edgeCount))
+	assert.Equal(t, 1, edgeCount)
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM user_edge WHERE source_id = $1 AND destination_id = $2", mutual, target).Scan(&count))
+	assert.Equal(t, 1, count, "mutual friendship kept once")
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT edge_count FROM users WHERE id = $1", mutual).Scan(&edgeCount))
+	assert.Equal(t, 1, edgeCount, "mutual friend no longer counts the source")
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT edge_count FROM users WHERE id = $1", target).Scan(&edgeCount))
+	assert.Equal(t, 2, edgeCount)
+
+	groupState := func(groupID uuid.UUID) int {
+		var state int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT state FROM group_edge WHERE source_id = $1 AND destination_id = $2", groupID, target).Scan(&state))
+		return state
+	}
+	groupEdgeCount := func(groupID uuid.UUID) int {
+		var edgeCount int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT edge_count FROM groups WHERE id = $1", groupID).Scan(&edgeCount))
+		return edgeCount
+	}
+	assert.Equal(t, int(api.GroupUserList_GroupUser_SUPERADMIN), groupState(ownedID), "target took over the owned group")
+	assert.Equal(t, 1, groupEdgeCount(ownedID))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), groupState(sharedID))
+	assert.Equal(t, 2, groupEdgeCount(sharedID))
+	var role string
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT role FROM group_user_role WHERE group_id = $1 AND user_id = $2", sharedID, target).Scan(&role))
+	assert.Equal(t, "officer", role)
+	assert.Equal(t, BANNED_CODE, groupState(bannedID), "merge does not lift a ban")
+	assert.Equal(t, 1, groupEdgeCount(bannedID))
+
+	recordScore := func(leaderboardID string) int64 {
+		var score int64
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT score FROM leaderboard_record WHERE leaderboard_id = $1 AND owner_id = $2", leaderboardID, target).Scan(&score))
+		return score
+	}
+	assert.EqualValues(t, 10, recordScore(sourceBetterLeaderboard), "better source record kept")
+	assert.EqualValues(t, 20, recordScore(targetBetterLeaderboard), "better target record kept")
+	assert.EqualValues(t, 3, recordScore(onlySourceLeaderboard))
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM leaderboard_record WHERE owner_id = $1", source).Scan(&count))
+	assert.Zero(t, count)
+}
+
+func TestMergeAccountsKeepSource(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	leaderboardCache := NewLocalLeaderboardCache(ctx, logger, logger, db)
+	leaderboardRankCache := NewLocalLeaderboardRankCache(ctx, logger, db, cfg.Leaderboard, leaderboardCache)
+	groupIndex, err := NewLocalGroupIndex(logger, db)
+	require.NoError(t, err)
+	storageIndex, err := NewLocalStorageIndex(logger, db, &StorageConfig{}, metrics)
+	require.NoError(t, err)
+	collection := "merge_" + uuid.Must(uuid.NewV4()).String()
+	indexName := collection + "_index"
+	require.NoError(t, storageIndex.CreateIndex(ctx, indexName, collection, "", []string{"from"}, []string{}, 100, false))
+
+	source, target := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	InsertUser(t, db, source)
+	InsertUser(t, db, target)
+
+	write := func(ownerID uuid.UUID, key, from string) {
+		_, _, err := StorageWriteObjects(ctx, logger, db, metrics, storageIndex, true, StorageOpWrites{&StorageOpWrite{
+			OwnerID: ownerID.String(),
+			Object:  &api.WriteStorageObject{Collection: collection, Key: key, Value: `{"from": "` + from + `"}`},
+		}})
+		require.NoError(t, err)
+	}
+	write(source, "shared", "source")
+	write(source, "source", "source")
+	write(target, "shared", "target")
+	write(target, "target", "target")
+
+	// Conflicting objects are rejected without changing anything.
+	err = MergeAccounts(ctx, logger, db, cfg, leaderboardCache, leaderboardRankCache, NewLocalSessionRegistry(metrics), NewLocalSessionCache(3_600, 7_200), &LocalTracker{}, storageIndex, groupIndex, source, target, AccountMergeStorageFail)
+	assert.Equal(t, ErrAccountMergeStorageConflict, err)
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM storage WHERE collection = $1 AND user_id = $2", collection, source).Scan(&count))
+	assert.Equal(t, 2, count)
+
+	require.NoError(t, MergeAccounts(ctx, logger, db, cfg, leaderboardCache, leaderboardRankCache, NewLocalSessionRegistry(metrics), NewLocalSessionCache(3_600, 7_200), &LocalTracker{}, storageIndex, groupIndex, source, target, AccountMergeStorageKeepSource))
+
+	values := make(map[string]string, 3)
+	rows, err := db.QueryContext(ctx, "SELECT key, value FROM storage WHERE collection = $1 AND user_id = $2", collection, target)
+	require.NoError(t, err)
+	for rows.Next() {
+		var key, value string
+		require.NoError(t, rows.Scan(&key, &value))
+		values[key] = value
+	}
+	require.NoError(t, rows.Err())
+	_ = rows.Close()
+	require.Len(t, values, 3)
+	assert.JSONEq(t, `{"from": "source"}`, values["shared"], "source object replaced the target's")
+	assert.JSONEq(t, `{"from": "source"}`, values["source"])
+	assert.JSONEq(t, `{"from": "target"}`, values["target"])
+
+	// The index no longer holds the source's objects, and holds the moved ones under the target.
+	entries, _, err := storageIndex.List(ctx, uuid.Nil, indexName, "", 100, []string{}, "")
+	require.NoError(t, err)
+	owners := make(map[string]string, len(entries.Objects))
+	for _, object := range entries.Objects {
+		assert.Equal(t, target.String(), object.UserId)
+		owners[object.Key] = object.Value
+	}
+	assert.Len(t, owners, 3)
+	assert.JSONEq(t, `{"from": "source"}`, owners["shared"])
+}
@@ -824,6 +824,126 @@ func AuthenticateSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, clie
 	return userID, username, steamID, true, nil
 }
 
+// Resolve the named generic OpenID Connect provider from the server configuration.
+func oidcProvider(config Config, name string) (*social.OIDCProvider, error) {
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "OpenID Connect provider is required.")
+	}
+	cfg, found := config.GetSocial().OIDC[name]
+	if !found || cfg == nil {
+		return nil, status.Error(codes.FailedPrecondition, "OpenID Connect provider is not configured.")
+	}
+	return &social.OIDCProvider{
+		Issuer:           cfg.Issuer,
+		ClientIDs:        cfg.ClientIDs,
+		JWKSURL:          cfg.JWKSURL,
+		SubjectClaim:     cfg.SubjectClaim,
+		EmailClaim:       cfg.EmailClaim,
+		DisplayNameClaim: cfg.DisplayNameClaim,
+		AvatarURLClaim:   cfg.AvatarURLClaim,
+	}, nil
+}
+
+func AuthenticateOIDC(ctx context.Context, logger *zap.Logger, db *sql.DB, client *social.Client, config Config, providerName, token, username string, create bool) (string, string, bool, error) {
+	provider, err := oidcProvider(config, providerName)
+	if err != nil {
+		return "", "", false, err
+	}
+	oidcProfile, err := client.CheckOIDCToken(ctx, provider, token)
+	if err != nil {
+		logger.Info("Could not authenticate OpenID Connect profile.", zap.Error(err), zap.String("provider", providerName))
+		return "", "", false, status.Error(codes.Unauthenticated, "Could not authenticate OpenID Connect profile.")
+	}
+	if len(oidcProfile.Subject) > 255 {
+		logger.Info("OpenID Connect subject exceeds max length of 255 chars.", zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject))
+		return "", "", false, status.Error(codes.Unauthenticated, "Could not authenticate OpenID Connect profile.")
+	}
+	found := true
+
+	// Look for an existing account.
+	query := "SELECT u.id, u.username, u.disable_time FROM user_identity AS ui JOIN users AS u ON u.id = ui.user_id WHERE ui.provider = $1 AND ui.subject = $2"
+	var dbUserID string
+	var dbUsername string
+	var dbDisableTime pgtype.Timestamptz
+	err = db.QueryRowContext(ctx, query, providerName, oidcProfile.Subject).Scan(&dbUserID, &dbUsername, &dbDisableTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			found = false
+		} else {
+			logger.Error("Error looking up user by OpenID Connect identity.", zap.Error(err), zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.Internal, "Error finding user account.")
+		}
+	}
+
+	// Existing account found.
+	if found {
+		// Check if it's disabled.
+		if dbDisableTime.Valid && dbDisableTime.Time.Unix() != 0 {
+			logger.Info("User account is disabled.", zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.PermissionDenied, "User account banned.")
+		}
+
+		return dbUserID, dbUsername, false, nil
+	}
+
+	if !create {
+		// No user account found, and creation is not allowed.
+		return "", "", false, status.Error(codes.NotFound, "User account not found.")
+	}
+
+	displayName := oidcProfile.DisplayName
+	if len(displayName) > 255 {
+		logger.Warn("Skipping display_name: value received from OpenID Connect provider longer than max length of 255 chars.", zap.String("display_name", displayName))
+		displayName = ""
+	}
+	avatarURL := oidcProfile.AvatarURL
+	if len(avatarURL) > 512 {
+		logger.Warn("Skipping avatar_url: value received from OpenID Connect provider longer than max length of 512 chars.", zap.String("avatar_url", avatarURL))
+		avatarURL = ""
+	}
+
+	// Create a new account.
+	userID := uuid.Must(uuid.NewV4()).String()
+	err = ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO users (id, username, display_name, avatar_url, create_time, update_time) VALUES ($1, $2, $3, $4, now(), now())", userID, username, displayName, avatarURL); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "INSERT INTO user_identity (provider, subject, user_id) VALUES ($1, $2, $3)", providerName, oidcProfile.Subject, userID)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation {
+			if strings.Contains(pgErr.Message, "users_username_key") {
+				// Username is already in use by a different account.
+				return "", "", false, status.Error(codes.AlreadyExists, "Username is already in use.")
+			} else if strings.Contains(pgErr.Message, "user_identity_pkey") {
+				// A concurrent write has inserted this identity.
+				logger.Info("Did not insert new user as OpenID Connect identity already exists.", zap.Error(err), zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("username", username), zap.Bool("create", create))
+				return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
+			}
+		}
+		logger.Error("Cannot find or create user with OpenID Connect identity.", zap.Error(err), zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("username", username), zap.Bool("create", create))
+		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
+	}
+
+	// Import the email address, if the provider has verified it.
+	if oidcProfile.Email != "" {
+		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1 WHERE id = $2", oidcProfile.Email, userID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "users_email_key") {
+				logger.Warn("Skipping OpenID Connect email import as it is already set in another user.", zap.Error(err), zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("created_user_id", userID))
+			} else {
+				logger.Error("Failed to import OpenID Connect email.", zap.Error(err), zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("created_user_id", userID))
+				return "", "", false, status.Error(codes.Internal, "Error importing OpenID Connect email.")
+			}
+		}
+	}
+
+	return userID, username, true, nil
+}
+
 func importSteamFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, messageRouter MessageRouter, client *social.Client, userID uuid.UUID, username, publisherKey, steamId string, reset bool) error {
 	logger = logger.With(zap.String("userID", userID.String()))
 
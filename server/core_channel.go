@@ -90,31 +90,13 @@ func ChannelMessagesList(ctx context.Context, logger *zap.Logger, db *sql.DB, ca
 	}
 
 	// Check channel permissions for non-authoritative calls.
-	if caller != uuid.Nil {
-		switch stream.Mode {
-		case StreamModeGroup:
-			// If it's a group, check membership.
-			allowed, err := groupCheckUserPermission(ctx, logger, db, stream.Subject, caller, 2)
-			if err != nil {
-				return nil, err
-			}
-			if !allowed {
-				return nil, runtime.ErrChannelGroupNotFound
-			}
-		case StreamModeDM:
-			// If it's a DM chat, check that the user is one of the chat participants.
-			if stream.Subject != caller && stream.Subcontext != caller {
-				return nil, runtime.ErrChannelIDInvalid
-			}
-		case StreamModeChannel:
-			fallthrough
-		default:
-			// No
-		}
+	if err := channelCheckCallerPermission(ctx, logger, db, caller, stream); err != nil {
+		return nil, err
 	}
 
+	// Thread replies are listed separately through their parent message.
 	query := `SELECT id, code, sender_id, username, content, create_time, update_time FROM message
-WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4`
+WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4 AND parent_id IS NULL`
 	if incomingCursor == nil {
 		if forward {
 			query += " ORDER BY create_time ASC, id ASC"
@@ -290,7 +272,37 @@ WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3:
 	}, nil
 }
 
-func ChannelMessageSend(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, channelStream PresenceStream, channelId, content, senderId, senderUsername string, persist bool) (*rtapi.ChannelMessageAck, error) {
+// Check that a caller may read from and interact with messages in a channel. Authoritative calls use a nil caller and are always allowed.
+func channelCheckCallerPermission(ctx context.Context, logger *zap.Logger, db *sql.DB, caller uuid.UUID, stream PresenceStream) error {
+	if caller == uuid.Nil {
+		return nil
+	}
+
+	switch stream.Mode {
+	case StreamModeGroup:
+		// If it's a group, check membership.
+		allowed, err := groupCheckUserPermission(ctx, logger, db, stream.Subject, caller, 2)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return runtime.ErrChannelGroupNotFound
+		}
+	case StreamModeDM:
+		// If it's a DM chat, check that the user is one of the chat participants.
+		if stream.Subject != caller && stream.Subcontext != caller {
+			return runtime.ErrChannelIDInvalid
+		}
+	case StreamModeChannel:
+		fallthrough
+	default:
+		// No
+	}
+
+	return nil
+}
+
+func ChannelMessageSend(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, moderator ChatModerator, channelStream PresenceStream, channelId, content, senderId, senderUsername string, persist bool) (*rtapi.ChannelMessageAck, error) {
 	ts := time.Now().Unix()
 	message := &api.ChannelMessage{
 		ChannelId:  channelId,
@@ -323,6 +335,21 @@ func ChannelMessageSend(ctx context.Context, logger *zap.Logger, db *sql.DB, rou
 		message.UserIdTwo, ack.UserIdTwo = channelStream.Subcontext.String(), channelStream.Subcontext.String()
 	}
 
+	// Messages sent by the runtime have no moderator, they are authoritative and not moderated.
+	if moderator != nil {
+		content, shadow, err := moderator.Moderate(ctx, logger, channelStream, channelId, message.MessageId, senderId, message.Content)
+		if err != nil {
+			return nil, err
+		}
+		if shadow {
+			// Shadowed messages are never persisted, and are only delivered back to the sender's own sessions.
+			senderStream := PresenceStream{Mode: StreamModeNotifications, Subject: uuid.FromStringOrNil(senderId)}
+			router.SendToStream(logger, senderStream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
+			return ack, nil
+		}
+		message.Content = content
+	}
+
 	if persist {
 		query := `INSERT INTO message (id, code, sender_id, username, stream_mode, stream_subject, stream_descriptor, stream_label, content, create_time, update_time)
 VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
@@ -339,7 +366,7 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 	return ack, nil
 }
 
-func ChannelMessageUpdate(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, channelStream PresenceStream, channelId, messageId, content, senderId, senderUsername string, persist bool) (*rtapi.ChannelMessageAck, error) {
+func ChannelMessageUpdate(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, moderator ChatModerator, channelStream PresenceStream, channelId, messageId, content, senderId, senderUsername string, persist bool) (*rtapi.ChannelMessageAck, error) {
 	ts := time.Now().Unix()
 	message := &api.ChannelMessage{
 		ChannelId:  channelId,
@@ -373,6 +400,21 @@ func ChannelMessageUpdate(ctx context.Context, logger *zap.Logger, db *sql.DB, r
 		message.UserIdTwo, ack.UserIdTwo = channelStream.Subcontext.String(), channelStream.Subcontext.String()
 	}
 
+	// Messages sent by the runtime have no moderator, they are authoritative and not moderated.
+	if moderator != nil {
+		content, shadow, err := moderator.Moderate(ctx, logger, channelStream, channelId, message.MessageId, senderId, message.Content)
+		if err != nil {
+			return nil, err
+		}
+		if shadow {
+			// Shadowed messages are never persisted, and are only delivered back to the sender's own sessions.
+			senderStream := PresenceStream{Mode: StreamModeNotifications, Subject: uuid.FromStringOrNil(senderId)}
+			router.SendToStream(logger, senderStream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
+			return ack, nil
+		}
+		message.Content = content
+	}
+
 	if persist {
 		// First find and update the referenced message.
 		var dbCreateTime pgtype.Timestamptz
@@ -432,7 +474,21 @@ func ChannelMessageRemove(ctx context.Context, logger *zap.Logger, db *sql.DB, r
 		// First find and remove the referenced message.
 		var dbCreateTime pgtype.Timestamptz
 		query := "DELETE FROM message WHERE id = $1 AND sender_id = $2 RETURNING create_time"
-		err := db.QueryRowContext(ctx, query, messageId, message.SenderId).Scan(&dbCreateTime)
+		params := []interface{}{messageId, message.SenderId}
+		if channelStream.Mode == StreamModeGroup {
+			// Group members allowed to manage chat may remove any message in the group channel, not just their own.
+			if senderUUID := uuid.FromStringOrNil(senderId); senderUUID != uuid.Nil {
+				allowed, err := GroupUserPermissionCheck(ctx, logger, db, channelStream.Subject, senderUUID, GroupPermissionManageChat)
+				if err != nil {
+					return nil, errChannelMessagePersist
+				}
+				if allowed {
+					query = "DELETE FROM message WHERE id = $1 AND stream_mode = $2 AND stream_subject = $3::UUID RETURNING create_time"
+					params = []interface{}{messageId, channelStream.Mode, channelStream.Subject}
+				}
+			}
+		}
+		err := db.QueryRowContext(ctx, query, params...).Scan(&dbCreateTime)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				return nil, errChannelMessageNotFound
@@ -0,0 +1,179 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Maximum number of characters in a reaction key, matches the database column.
+const channelMessageReactionMaxLength = 64
+
+var errChannelMessageReactionInvalid = errors.New("invalid reaction")
+
+// A reaction key must be a short, printable token such as an emoji or a shortcode.
+func channelMessageReactionValid(reaction string) bool {
+	if reaction == "" || !utf8.ValidString(reaction) || utf8.RuneCountInString(reaction) > channelMessageReactionMaxLength {
+		return false
+	}
+	return strings.IndexFunc(reaction, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}) == -1
+}
+
+// Look up the parent of a persisted message, and ensure the message belongs to the given channel stream.
+func channelMessageParent(ctx context.Context, db *sql.DB, stream PresenceStream, messageID uuid.UUID) (uuid.NullUUID, error) {
+	var parentID uuid.NullUUID
+	query := "SELECT parent_id FROM message WHERE id = $1 AND stream_mode = $2 AND stream_subject = $3::UUID AND stream_descriptor = $4::UUID AND stream_label = $5"
+	if err := db.QueryRowContext(ctx, query, messageID, stream.Mode, stream.Subject, stream.Subcontext, stream.Label).Scan(&parentID); err != nil {
+		if err == sql.ErrNoRows {
+			return parentID, errChannelMessageNotFound
+		}
+		return parentID, err
+	}
+	return parentID, nil
+}
+
+// ChannelMessageReact toggles a user's reaction on a persisted message and broadcasts the updated reaction counts to the channel.
+func ChannelMessageReact(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, stream PresenceStream, channelID string, messageID, userID uuid.UUID, username, reaction string) (*apigrpc.ChannelMessageReactions, error) {
+	if !channelMessageReactionValid(reaction) {
+		return nil, errChannelMessageReactionInvalid
+	}
+
+	if _, err := channelMessageParent(ctx, db, stream, messageID); err != nil {
+		if err != errChannelMessageNotFound {
+			logger.Error("Error looking up channel message to react to", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, "DELETE FROM message_reaction WHERE message_id = $1 AND reaction = $2 AND user_id = $3", messageID, reaction, userID)
+		if err != nil {
+			return err
+		}
+		if rowsAffected, _ := result.RowsAffected(); rowsAffected > 0 {
+			// The user already had this reaction, toggle it off.
+			return nil
+		}
+		_, err = tx.ExecContext(ctx, "INSERT INTO message_reaction (message_id, reaction, user_id) VALUES ($1, $2, $3)", messageID, reaction, userID)
+		return err
+	}); err != nil {
+		logger.Error("Error persisting channel message reaction", zap.Error(err))
+		return nil, errChannelMessagePersist
+	}
+
+	list, err := ChannelMessageReactionsList(ctx, logger, db, userID, stream, []uuid.UUID{messageID})
+	if err != nil {
+		return nil, err
+	}
+	reactions := &apigrpc.ChannelMessageReactions{MessageId: messageID.String(), Reactions: []*apigrpc.ChannelMessageReaction{}}
+	if len(list.Messages) > 0 {
+		reactions = list.Messages[0]
+	}
+
+	counts := make(map[string]int32, len(reactions.Reactions))
+	for _, r := range reactions.Reactions {
+		counts[r.Reaction] = r.Count
+	}
+	content, err := json.Marshal(map[string]any{
+		"message_id": reactions.MessageId,
+		"reactions":  counts,
+	})
+	if err != nil {
+		logger.Error("Error encoding channel message reactions", zap.Error(err))
+		return nil, err
+	}
+
+	ts := time.Now().Unix()
+	message := &api.ChannelMessage{
+		ChannelId:  channelID,
+		MessageId:  uuid.Must(uuid.NewV4()).String(),
+		Code:       &wrapperspb.Int32Value{Value: ChannelMessageTypeReaction},
+		SenderId:   userID.String(),
+		Username:   username,
+		Content:    string(content),
+		CreateTime: &timestamppb.Timestamp{Seconds: ts},
+		UpdateTime: &timestamppb.Timestamp{Seconds: ts},
+		Persistent: &wrapperspb.BoolValue{Value: false},
+	}
+	switch stream.Mode {
+	case StreamModeChannel:
+		message.RoomName = stream.Label
+	case StreamModeGroup:
+		message.GroupId = stream.Subject.String()
+	case StreamModeDM:
+		message.UserIdOne = stream.Subject.String()
+		message.UserIdTwo = stream.Subcontext.String()
+	}
+	router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
+
+	return reactions, nil
+}
+
+// ChannelMessageReactionsList aggregates reactions on the given messages, only messages in the given channel stream are included.
+func ChannelMessageReactionsList(ctx context.Context, logger *zap.Logger, db *sql.DB, caller uuid.UUID, stream PresenceStream, messageIDs []uuid.UUID) (*apigrpc.ChannelMessageReactionsList, error) {
+	query := `
+SELECT r.message_id, r.reaction, count(*), bool_or(r.user_id = $6)
+FROM message_reaction r
+JOIN message m ON m.id = r.message_id
+WHERE r.message_id = ANY($1::UUID[])
+AND m.stream_mode = $2 AND m.stream_subject = $3::UUID AND m.stream_descriptor = $4::UUID AND m.stream_label = $5
+GROUP BY r.message_id, r.reaction
+ORDER BY r.message_id, count(*) DESC, r.reaction`
+	rows, err := db.QueryContext(ctx, query, messageIDs, stream.Mode, stream.Subject, stream.Subcontext, stream.Label, caller)
+	if err != nil {
+		logger.Error("Error listing channel message reactions", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := &apigrpc.ChannelMessageReactionsList{Messages: make([]*apigrpc.ChannelMessageReactions, 0, len(messageIDs))}
+	var current *apigrpc.ChannelMessageReactions
+	for rows.Next() {
+		var messageID string
+		reaction := &apigrpc.ChannelMessageReaction{}
+		if err := rows.Scan(&messageID, &reaction.Reaction, &reaction.Count, &reaction.Reacted); err != nil {
+			logger.Error("Error scanning channel message reactions", zap.Error(err))
+			return nil, err
+		}
+		if current == nil || current.MessageId != messageID {
+			current = &apigrpc.ChannelMessageReactions{MessageId: messageID}
+			list.Messages = append(list.Messages, current)
+		}
+		current.Reactions = append(current.Reactions, reaction)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error listing channel message reactions", zap.Error(err))
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMessageReactionValid(t *testing.T) {
+	assert.True(t, channelMessageReactionValid("👍"))
+	assert.True(t, channelMessageReactionValid(":thumbs_up:"))
+	assert.False(t, channelMessageReactionValid(""))
+	assert.False(t, channelMessageReactionValid("thumbs up"))
+	assert.False(t, channelMessageReactionValid("a\x00"))
+}
+
+func TestChannelMessageReact(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	router := &DummyMessageRouter{}
+
+	userA := uuid.Must(uuid.NewV4())
+	userB := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userA)
+	InsertUser(t, db, userB)
+
+	stream := PresenceStream{Mode: StreamModeChannel, Label: "react-" + userA.String()}
+	channelID := "2..." + stream.Label
+	ack, err := ChannelMessageSend(ctx, logger, db, router, nil, stream, channelID, `{"message":"hello"}`, userA.String(), "a", true)
+	require.NoError(t, err)
+	messageID := uuid.Must(uuid.FromString(ack.MessageId))
+	ack, err = ChannelMessageSend(ctx, logger, db, router, nil, stream, channelID, `{"message":"quiet"}`, userB.String(), "b", true)
+	require.NoError(t, err)
+	quietID := uuid.Must(uuid.FromString(ack.MessageId))
+
+	react := func(userID uuid.UUID, reaction string) *apigrpc.ChannelMessageReactions {
+		reactions, err := ChannelMessageReact(ctx, logger, db, router, stream, channelID, messageID, userID, userID.String(), reaction)
+		require.NoError(t, err)
+		return reactions
+	}
+
+	reactions := react(userA, "👍")
+	require.Len(t, reactions.Reactions, 1)
+	assert.Equal(t, int32(1), reactions.Reactions[0].Count)
+	assert.True(t, reactions.Reactions[0].Reacted)
+
+	react(userB, "👍")
+	reactions = react(userB, "🎉")
+	require.Len(t, reactions.Reactions, 2)
+	assert.Equal(t, "👍", reactions.Reactions[0].Reaction, "most used reaction first")
+	assert.Equal(t, int32(2), reactions.Reactions[0].Count)
+	assert.Equal(t, "🎉", reactions.Reactions[1].Reaction)
+	assert.Equal(t, int32(1), reactions.Reactions[1].Count)
+
+	// Reacting again with the same reaction takes it back.
+	reactions = react(userA, "👍")
+	require.Len(t, reactions.Reactions, 2)
+	assert.Equal(t, int32(1), reactions.Reactions[0].Count)
+	assert.False(t, reactions.Reactions[0].Reacted, "reacted is relative to the caller")
+
+	list, err := ChannelMessageReactionsList(ctx, logger, db, userB, stream, []uuid.UUID{messageID, quietID})
+	require.NoError(t, err)
+	require.Len(t, list.Messages, 1, "messages without reactions are left out")
+	assert.Equal(t, messageID.String(), list.Messages[0].MessageId)
+	for _, reaction := range list.Messages[0].Reactions {
+		assert.True(t, reaction.Reacted)
+	}
+
+	// Messages are only found in their own channel.
+	otherStream := PresenceStream{Mode: StreamModeChannel, Label: "other-" + userA.String()}
+	_, err = ChannelMessageReact(ctx, logger, db, router, otherStream, "2..."+otherStream.Label, messageID, userA, "a", "👍")
+	assert.ErrorIs(t, err, errChannelMessageNotFound)
+	list, err = ChannelMessageReactionsList(ctx, logger, db, userA, otherStream, []uuid.UUID{messageID})
+	require.NoError(t, err)
+	assert.Empty(t, list.Messages)
+
+	_, err = ChannelMessageReact(ctx, logger, db, router, stream, channelID, messageID, userA, "a", "thumbs up")
+	assert.ErrorIs(t, err, errChannelMessageReactionInvalid)
+}
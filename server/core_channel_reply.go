@@ -0,0 +1,248 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var errChannelMessageReplyNested = errors.New("cannot reply to a reply")
+
+type channelMessageReplyListCursor struct {
+	ParentID   string
+	CreateTime int64
+	Id         string
+}
+
+// ChannelMessageReply persists a reply to a top-level message in the same channel. The reply is broadcast to the
+// channel as a regular chat message, followed by a reply notice carrying the parent message ID and reply count.
+func ChannelMessageReply(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, moderator ChatModerator, stream PresenceStream, channelID string, parentID uuid.UUID, content, senderID, senderUsername string) (*api.ChannelMessage, error) {
+	grandparentID, err := channelMessageParent(ctx, db, stream, parentID)
+	if err != nil {
+		if err != errChannelMessageNotFound {
+			logger.Error("Error looking up channel message to reply to", zap.Error(err))
+		}
+		return nil, err
+	}
+	if grandparentID.Valid {
+		// Threads are only one level deep.
+		return nil, errChannelMessageReplyNested
+	}
+
+	now := time.Now().UTC()
+	message := &api.ChannelMessage{
+		ChannelId:  channelID,
+		MessageId:  uuid.Must(uuid.NewV4()).String(),
+		Code:       &wrapperspb.Int32Value{Value: ChannelMessageTypeChat},
+		SenderId:   senderID,
+		Username:   senderUsername,
+		Content:    content,
+		CreateTime: &timestamppb.Timestamp{Seconds: now.Unix()},
+		UpdateTime: &timestamppb.Timestamp{Seconds: now.Unix()},
+		Persistent: &wrapperspb.BoolValue{Value: true},
+	}
+	switch stream.Mode {
+	case StreamModeChannel:
+		message.RoomName = stream.Label
+	case StreamModeGroup:
+		message.GroupId = stream.Subject.String()
+	case StreamModeDM:
+		message.UserIdOne = stream.Subject.String()
+		message.UserIdTwo = stream.Subcontext.String()
+	}
+
+	if moderator != nil {
+		content, shadow, err := moderator.Moderate(ctx, logger, stream, channelID, message.MessageId, senderID, message.Content)
+		if err != nil {
+			return nil, err
+		}
+		if shadow {
+			// Shadowed replies are never persisted, and are only delivered back to the sender's own sessions.
+			senderStream := PresenceStream{Mode: StreamModeNotifications, Subject: uuid.FromStringOrNil(senderID)}
+			router.SendToStream(logger, senderStream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
+			return message, nil
+		}
+		message.Content = content
+	}
+
+	var replyCount int64
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		query := `INSERT INTO message (id, code, sender_id, username, stream_mode, stream_subject, stream_descriptor, stream_label, content, parent_id, create_time, update_time)
+VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $11, $11)`
+		if _, err := tx.ExecContext(ctx, query, message.MessageId, message.Code.Value, message.SenderId, message.Username, stream.Mode, stream.Subject, stream.Subcontext, stream.Label, message.Content, parentID, now); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, "SELECT count(*) FROM message WHERE parent_id = $1", parentID).Scan(&replyCount)
+	}); err != nil {
+		logger.Error("Error persisting channel message reply", zap.Error(err))
+		return nil, errChannelMessagePersist
+	}
+
+	router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
+
+	noticeContent, err := json.Marshal(map[string]any{
+		"message_id":  parentID.String(),
+		"reply_id":    message.MessageId,
+		"reply_count": replyCount,
+	})
+	if err != nil {
+		// The reply is already persisted and delivered, only the thread notice is lost.
+		logger.Error("Error encoding channel message reply notice", zap.Error(err))
+		return message, nil
+	}
+	notice := &api.ChannelMessage{
+		ChannelId:  message.ChannelId,
+		MessageId:  uuid.Must(uuid.NewV4()).String(),
+		Code:       &wrapperspb.Int32Value{Value: ChannelMessageTypeReply},
+		SenderId:   message.SenderId,
+		Username:   message.Username,
+		Content:    string(noticeContent),
+		CreateTime: message.CreateTime,
+		UpdateTime: message.UpdateTime,
+		Persistent: &wrapperspb.BoolValue{Value: false},
+		RoomName:   message.RoomName,
+		GroupId:    message.GroupId,
+		UserIdOne:  message.UserIdOne,
+		UserIdTwo:  message.UserIdTwo,
+	}
+	router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: notice}}, true)
+
+	return message, nil
+}
+
+// ChannelMessageRepliesList lists replies to a message in the given channel, oldest first.
+func ChannelMessageRepliesList(ctx context.Context, logger *zap.Logger, db *sql.DB, stream PresenceStream, channelID string, parentID uuid.UUID, limit int, cursor string) (*api.ChannelMessageList, error) {
+	var incomingCursor *channelMessageReplyListCursor
+	if cursor != "" {
+		cb, err := base64.StdEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, runtime.ErrChannelCursorInvalid
+		}
+		incomingCursor = &channelMessageReplyListCursor{}
+		if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(incomingCursor); err != nil {
+			return nil, runtime.ErrChannelCursorInvalid
+		}
+		if incomingCursor.ParentID != parentID.String() {
+			// Cursor is for a different thread.
+			return nil, runtime.ErrChannelCursorInvalid
+		}
+	}
+
+	if _, err := channelMessageParent(ctx, db, stream, parentID); err != nil {
+		if err != errChannelMessageNotFound {
+			logger.Error("Error looking up channel message to list replies", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	query := "SELECT id, code, sender_id, username, content, create_time, update_time FROM message WHERE parent_id = $1"
+	params := []interface{}{parentID, limit + 1}
+	if incomingCursor != nil {
+		query += " AND (create_time, id) > ($3, $4)"
+		params = append(params, time.Unix(0, incomingCursor.CreateTime).UTC(), incomingCursor.Id)
+	}
+	query += " ORDER BY create_time ASC, id ASC LIMIT $2"
+
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Error listing channel message replies", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*api.ChannelMessage, 0, limit)
+	var nextCursor *channelMessageReplyListCursor
+
+	var dbID string
+	var dbCode int32
+	var dbSenderID string
+	var dbUsername string
+	var dbContent string
+	var dbCreateTime pgtype.Timestamptz
+	var dbUpdateTime pgtype.Timestamptz
+	for rows.Next() {
+		if len(messages) >= limit {
+			nextCursor = &channelMessageReplyListCursor{
+				ParentID:   parentID.String(),
+				CreateTime: dbCreateTime.Time.UnixNano(),
+				Id:         dbID,
+			}
+			break
+		}
+
+		if err := rows.Scan(&dbID, &dbCode, &dbSenderID, &dbUsername, &dbContent, &dbCreateTime, &dbUpdateTime); err != nil {
+			logger.Error("Error parsing listed channel message replies", zap.Error(err))
+			return nil, err
+		}
+
+		message := &api.ChannelMessage{
+			ChannelId:  channelID,
+			MessageId:  dbID,
+			Code:       &wrapperspb.Int32Value{Value: dbCode},
+			SenderId:   dbSenderID,
+			Username:   dbUsername,
+			Content:    dbContent,
+			CreateTime: &timestamppb.Timestamp{Seconds: dbCreateTime.Time.Unix()},
+			UpdateTime: &timestamppb.Timestamp{Seconds: dbUpdateTime.Time.Unix()},
+			Persistent: &wrapperspb.BoolValue{Value: true},
+		}
+		switch stream.Mode {
+		case StreamModeChannel:
+			message.RoomName = stream.Label
+		case StreamModeGroup:
+			message.GroupId = stream.Subject.String()
+		case StreamModeDM:
+			message.UserIdOne = stream.Subject.String()
+			message.UserIdTwo = stream.Subcontext.String()
+		}
+
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error listing channel message replies", zap.Error(err))
+		return nil, err
+	}
+
+	var nextCursorStr string
+	if nextCursor != nil {
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(nextCursor); err != nil {
+			logger.Error("Error creating channel message replies list cursor", zap.Error(err))
+			return nil, err
+		}
+		nextCursorStr = base64.StdEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	return &api.ChannelMessageList{
+		Messages:   messages,
+		NextCursor: nextCursorStr,
+	}, nil
+}
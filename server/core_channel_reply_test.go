@@ -0,0 +1,83 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMessageReply(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	router := &DummyMessageRouter{}
+
+	userID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userID)
+
+	stream := PresenceStream{Mode: StreamModeChannel, Label: "reply-" + userID.String()}
+	channelID := "2..." + stream.Label
+	ack, err := ChannelMessageSend(ctx, logger, db, router, nil, stream, channelID, `{"message":"topic"}`, userID.String(), "a", true)
+	require.NoError(t, err)
+	parentID := uuid.Must(uuid.FromString(ack.MessageId))
+
+	replyIDs := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		reply, err := ChannelMessageReply(ctx, logger, db, router, nil, stream, channelID, parentID, `{"message":"reply"}`, userID.String(), "a")
+		require.NoError(t, err)
+		assert.True(t, reply.Persistent.Value)
+		replyIDs = append(replyIDs, reply.MessageId)
+	}
+
+	// Threads are only one level deep.
+	_, err = ChannelMessageReply(ctx, logger, db, router, nil, stream, channelID, uuid.Must(uuid.FromString(replyIDs[0])), `{"message":"nested"}`, userID.String(), "a")
+	assert.ErrorIs(t, err, errChannelMessageReplyNested)
+
+	otherStream := PresenceStream{Mode: StreamModeChannel, Label: "other-" + userID.String()}
+	_, err = ChannelMessageReply(ctx, logger, db, router, nil, otherStream, "2..."+otherStream.Label, parentID, `{"message":"elsewhere"}`, userID.String(), "a")
+	assert.ErrorIs(t, err, errChannelMessageNotFound)
+	_, err = ChannelMessageRepliesList(ctx, logger, db, otherStream, "2..."+otherStream.Label, parentID, 10, "")
+	assert.ErrorIs(t, err, errChannelMessageNotFound)
+
+	// Page through the replies oldest first.
+	listed := make([]string, 0, len(replyIDs))
+	cursor := ""
+	for page := 0; ; page++ {
+		require.Less(t, page, 3)
+		list, err := ChannelMessageRepliesList(ctx, logger, db, stream, channelID, parentID, 2, cursor)
+		require.NoError(t, err)
+		for _, message := range list.Messages {
+			listed = append(listed, message.MessageId)
+		}
+		if list.NextCursor == "" {
+			break
+		}
+		cursor = list.NextCursor
+	}
+	assert.Equal(t, replyIDs, listed)
+
+	// Cursors only apply to the thread they were issued for.
+	list, err := ChannelMessageRepliesList(ctx, logger, db, stream, channelID, parentID, 2, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, list.NextCursor)
+	_, err = ChannelMessageRepliesList(ctx, logger, db, stream, channelID, uuid.Must(uuid.FromString(replyIDs[0])), 2, list.NextCursor)
+	assert.ErrorIs(t, err, runtime.ErrChannelCursorInvalid)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// ChannelRetentionPolicy limits the persisted message history kept for a channel. Zero values are unlimited.
+type ChannelRetentionPolicy struct {
+	MaxAgeSec   int
+	MaxMessages int
+}
+
+// ChannelRetentionSet overrides the configured retention policy for a single channel.
+func ChannelRetentionSet(ctx context.Context, logger *zap.Logger, db *sql.DB, stream PresenceStream, policy *ChannelRetentionPolicy) error {
+	query := `
+INSERT INTO channel_retention (stream_mode, stream_subject, stream_descriptor, stream_label, max_age_sec, max_messages)
+VALUES ($1, $2::UUID, $3::UUID, $4, $5, $6)
+ON CONFLICT (stream_mode, stream_subject, stream_descriptor, stream_label)
+DO UPDATE SET max_age_sec = $5, max_messages = $6, update_time = now()`
+	if _, err := db.ExecContext(ctx, query, stream.Mode, stream.Subject, stream.Subcontext, stream.Label, policy.MaxAgeSec, policy.MaxMessages); err != nil {
+		logger.Error("Could not set channel retention policy.", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// ChannelRetentionGet returns the retention policy override for a channel, or nil if it uses the configured policy.
+func ChannelRetentionGet(ctx context.Context, logger *zap.Logger, db *sql.DB, stream PresenceStream) (*ChannelRetentionPolicy, error) {
+	policy := &ChannelRetentionPolicy{}
+	query := "SELECT max_age_sec, max_messages FROM channel_retention WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4"
+	if err := db.QueryRowContext(ctx, query, stream.Mode, stream.Subject, stream.Subcontext, stream.Label).Scan(&policy.MaxAgeSec, &policy.MaxMessages); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logger.Error("Could not get channel retention policy.", zap.Error(err))
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ChannelRetentionDelete removes a channel's retention policy override, so the configured policy applies again.
+func ChannelRetentionDelete(ctx context.Context, logger *zap.Logger, db *sql.DB, stream PresenceStream) error {
+	query := "DELETE FROM channel_retention WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4"
+	if _, err := db.ExecContext(ctx, query, stream.Mode, stream.Subject, stream.Subcontext, stream.Label); err != nil {
+		logger.Error("Could not delete channel retention policy.", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Remove persisted messages outside of their channel's retention policy, and return the number of messages removed.
+func channelRetentionPurge(ctx context.Context, logger *zap.Logger, db *sql.DB, config *ChatRetentionConfig) (int64, error) {
+	defaults := map[uint8]*ChannelRetentionPolicy{
+		StreamModeChannel: {MaxAgeSec: config.RoomMaxAgeSec, MaxMessages: config.RoomMaxMessages},
+		StreamModeGroup:   {MaxAgeSec: config.GroupMaxAgeSec, MaxMessages: config.GroupMaxMessages},
+		StreamModeDM:      {MaxAgeSec: config.DirectMaxAgeSec, MaxMessages: config.DirectMaxMessages},
+	}
+	now := time.Now().UTC()
+	var total int64
+
+	// Channels with an override only follow their own policy.
+	const notOverridden = `NOT EXISTS (SELECT 1 FROM channel_retention r WHERE r.stream_mode = m.stream_mode AND r.stream_subject = m.stream_subject AND r.stream_descriptor = m.stream_descriptor AND r.stream_label = m.stream_label)`
+
+	for mode, policy := range defaults {
+		if policy.MaxAgeSec > 0 {
+			query := `DELETE FROM message WHERE id IN (
+SELECT m.id FROM message m WHERE m.stream_mode = $1 AND m.create_time < $2 AND ` + notOverridden + ` LIMIT $3)`
+			cutoff := &pgtype.Timestamptz{Time: now.Add(-time.Duration(policy.MaxAgeSec) * time.Second), Valid: true}
+			count, err := channelRetentionDeleteBatches(ctx, db, config.BatchSize, query, mode, cutoff)
+			total += count
+			if err != nil {
+				return total, err
+			}
+		}
+
+		if policy.MaxMessages > 0 {
+			query := "SELECT m.stream_subject, m.stream_descriptor, m.stream_label FROM message m WHERE m.stream_mode = $1 AND " + notOverridden + " GROUP BY m.stream_subject, m.stream_descriptor, m.stream_label HAVING count(*) > $2"
+			streams, err := channelRetentionScanStreams(ctx, db, mode, query, mode, policy.MaxMessages)
+			if err != nil {
+				return total, err
+			}
+			for _, stream := range streams {
+				count, err := channelRetentionPurgeCount(ctx, db, config.BatchSize, stream, policy.MaxMessages)
+				total += count
+				if err != nil {
+					return total, err
+				}
+			}
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT stream_mode, stream_subject, stream_descriptor, stream_label, max_age_sec, max_messages FROM channel_retention WHERE max_age_sec > 0 OR max_messages > 0")
+	if err != nil {
+		return total, err
+	}
+	type override struct {
+		stream PresenceStream
+		policy ChannelRetentionPolicy
+	}
+	overrides := make([]*override, 0)
+	for rows.Next() {
+		o := &override{}
+		if err := rows.Scan(&o.stream.Mode, &o.stream.Subject, &o.stream.Subcontext, &o.stream.Label, &o.policy.MaxAgeSec, &o.policy.MaxMessages); err != nil {
+			_ = rows.Close()
+			return total, err
+		}
+		overrides = append(overrides, o)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return total, err
+	}
+
+	for _, o := range overrides {
+		if o.policy.MaxAgeSec > 0 {
+			query := `DELETE FROM message WHERE id IN (
+SELECT id FROM message WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4 AND create_time < $5 LIMIT $6)`
+			cutoff := &pgtype.Timestamptz{Time: now.Add(-time.Duration(o.policy.MaxAgeSec) * time.Second), Valid: true}
+			count, err := channelRetentionDeleteBatches(ctx, db, config.BatchSize, query, o.stream.Mode, o.stream.Subject, o.stream.Subcontext, o.stream.Label, cutoff)
+			total += count
+			if err != nil {
+				return total, err
+			}
+		}
+		if o.policy.MaxMessages > 0 {
+			count, err := channelRetentionPurgeCount(ctx, db, config.BatchSize, o.stream, o.policy.MaxMessages)
+			total += count
+			if err != nil {
+				return total, err
+			}
+		}
+	}
+
+	if total > 0 {
+		logger.Debug("Removed channel messages outside retention policy", zap.Int64("count", total))
+	}
+	return total, nil
+}
+
+// Remove the oldest messages in a channel beyond the most recent max messages.
+func channelRetentionPurgeCount(ctx context.Context, db *sql.DB, batchSize int, stream PresenceStream, maxMessages int) (int64, error) {
+	query := `DELETE FROM message WHERE id IN (
+SELECT id FROM message WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4
+ORDER BY create_time DESC, id DESC OFFSET $5 LIMIT $6)`
+	return channelRetentionDeleteBatches(ctx, db, batchSize, query, stream.Mode, stream.Subject, stream.Subcontext, stream.Label, maxMessages)
+}
+
+// Run a delete query repeatedly until it removes less than a full batch. The batch size is added as the last query parameter.
+func channelRetentionDeleteBatches(ctx context.Context, db *sql.DB, batchSize int, query string, params ...any) (int64, error) {
+	params = append(params, batchSize)
+	var total int64
+	for {
+		result, err := db.ExecContext(ctx, query, params...)
+		if err != nil {
+			return total, err
+		}
+		count, _ := result.RowsAffected()
+		total += count
+		if count < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+func channelRetentionScanStreams(ctx context.Context, db *sql.DB, mode uint8, query string, params ...any) ([]PresenceStream, error) {
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	streams := make([]PresenceStream, 0)
+	for rows.Next() {
+		stream := PresenceStream{Mode: mode}
+		var subject, subcontext uuid.UUID
+		if err := rows.Scan(&subject, &subcontext, &stream.Label); err != nil {
+			return nil, err
+		}
+		stream.Subject, stream.Subcontext = subject, subcontext
+		streams = append(streams, stream)
+	}
+
+	return streams, rows.Err()
+}
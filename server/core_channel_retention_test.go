@@ -0,0 +1,96 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelRetentionPurge(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+
+	userID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userID)
+
+	now := time.Now().UTC()
+	insert := func(stream PresenceStream, age time.Duration) string {
+		id := uuid.Must(uuid.NewV4()).String()
+		_, err := db.ExecContext(ctx, `INSERT INTO message (id, code, sender_id, username, stream_mode, stream_subject, stream_descriptor, stream_label, content, create_time, update_time)
+VALUES ($1, 0, $2, $3, $4, $5::UUID, $6::UUID, $7, '{}', $8, $8)`, id, userID, userID.String(), stream.Mode, stream.Subject, stream.Subcontext, stream.Label, now.Add(-age))
+		require.NoError(t, err)
+		return id
+	}
+	remaining := func(stream PresenceStream) []string {
+		rows, err := db.QueryContext(ctx, "SELECT id FROM message WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4 ORDER BY create_time DESC", stream.Mode, stream.Subject, stream.Subcontext, stream.Label)
+		require.NoError(t, err)
+		defer rows.Close()
+		ids := make([]string, 0)
+		for rows.Next() {
+			var id string
+			require.NoError(t, rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(t, rows.Err())
+		return ids
+	}
+	room := func(name string) PresenceStream {
+		return PresenceStream{Mode: StreamModeChannel, Label: name + "-" + userID.String()}
+	}
+
+	// Messages older than the maximum age go first, then the oldest beyond the maximum count.
+	defaults := room("defaults")
+	insert(defaults, 2*time.Hour)
+	insert(defaults, 4*time.Minute)
+	newest := []string{insert(defaults, 3*time.Minute), insert(defaults, 2*time.Minute), insert(defaults, time.Minute)}
+
+	// Overrides replace the configured policy entirely, zero values keep messages forever.
+	keepAll := room("keep-all")
+	require.NoError(t, ChannelRetentionSet(ctx, logger, db, keepAll, &ChannelRetentionPolicy{}))
+	keptAll := []string{insert(keepAll, time.Minute), insert(keepAll, time.Hour), insert(keepAll, 2*time.Hour), insert(keepAll, 3*time.Hour), insert(keepAll, 4*time.Hour)}
+
+	countOnly := room("count-only")
+	require.NoError(t, ChannelRetentionSet(ctx, logger, db, countOnly, &ChannelRetentionPolicy{MaxMessages: 2}))
+	keptOld := []string{insert(countOnly, 2*time.Hour), insert(countOnly, 3*time.Hour)}
+	insert(countOnly, 4*time.Hour)
+
+	policy, err := ChannelRetentionGet(ctx, logger, db, countOnly)
+	require.NoError(t, err)
+	assert.Equal(t, &ChannelRetentionPolicy{MaxMessages: 2}, policy)
+
+	config := &ChatRetentionConfig{RoomMaxAgeSec: 3600, RoomMaxMessages: 3, BatchSize: 1}
+	_, err = channelRetentionPurge(ctx, logger, db, config)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{newest[2], newest[1], newest[0]}, remaining(defaults))
+	assert.ElementsMatch(t, keptAll, remaining(keepAll))
+	assert.Equal(t, keptOld, remaining(countOnly), "an override without a maximum age keeps old messages")
+
+	// Removing the override applies the configured policy again.
+	require.NoError(t, ChannelRetentionDelete(ctx, logger, db, keepAll))
+	policy, err = ChannelRetentionGet(ctx, logger, db, keepAll)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+	_, err = channelRetentionPurge(ctx, logger, db, config)
+	require.NoError(t, err)
+	assert.Equal(t, keptAll[:1], remaining(keepAll))
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Maximum number of words used from a search query.
+const channelMessageSearchMaxTerms = 10
+
+var errChannelMessageSearchInvalid = errors.New("invalid channel message search")
+
+// ChannelMessageSearchFilter selects channel messages to return from a search. At least one field must be set.
+type ChannelMessageSearchFilter struct {
+	Query     string
+	SenderID  uuid.UUID
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type channelMessageSearchCursor struct {
+	StreamMode       uint8
+	StreamSubject    string
+	StreamSubcontext string
+	StreamLabel      string
+	Query            string
+	SenderID         string
+	StartTime        int64
+	EndTime          int64
+	CreateTime       int64
+	Id               string
+}
+
+// Convert free text into a prefix matching full-text query requiring all words, or an empty string if there are no words.
+func channelMessageSearchTsquery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > channelMessageSearchMaxTerms {
+		words = words[:channelMessageSearchMaxTerms]
+	}
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// Cursor representation of an optional time filter, since UnixNano is undefined for the zero time.
+func channelMessageSearchTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// ChannelMessagesSearch lists chat messages in a channel matching the filter, most recent first.
+func ChannelMessagesSearch(ctx context.Context, logger *zap.Logger, db *sql.DB, caller uuid.UUID, stream PresenceStream, channelID string, filter *ChannelMessageSearchFilter, limit int, cursor string) (*api.ChannelMessageList, error) {
+	tsquery := channelMessageSearchTsquery(filter.Query)
+	if strings.TrimSpace(filter.Query) != "" && tsquery == "" {
+		// Only punctuation or symbols, nothing that can be matched.
+		return nil, errChannelMessageSearchInvalid
+	}
+	if tsquery == "" && filter.SenderID == uuid.Nil && filter.StartTime.IsZero() && filter.EndTime.IsZero() {
+		return nil, errChannelMessageSearchInvalid
+	}
+
+	var incomingCursor *channelMessageSearchCursor
+	if cursor != "" {
+		cb, err := base64.StdEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, runtime.ErrChannelCursorInvalid
+		}
+		incomingCursor = &channelMessageSearchCursor{}
+		if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(incomingCursor); err != nil {
+			return nil, runtime.ErrChannelCursorInvalid
+		}
+		if stream.Mode != incomingCursor.StreamMode || stream.Subject.String() != incomingCursor.StreamSubject || stream.Subcontext.String() != incomingCursor.StreamSubcontext || stream.Label != incomingCursor.StreamLabel {
+			// Cursor is for a different channel.
+			return nil, runtime.ErrChannelCursorInvalid
+		}
+		if tsquery != incomingCursor.Query || filter.SenderID.String() != incomingCursor.SenderID || channelMessageSearchTime(filter.StartTime) != incomingCursor.StartTime || channelMessageSearchTime(filter.EndTime) != incomingCursor.EndTime {
+			// Cursor is for a different search.
+			return nil, runtime.ErrChannelCursorInvalid
+		}
+	}
+
+	// Check channel permissions for non-authoritative calls.
+	if err := channelCheckCallerPermission(ctx, logger, db, caller, stream); err != nil {
+		return nil, err
+	}
+
+	params := []any{stream.Mode, stream.Subject, stream.Subcontext, stream.Label, ChannelMessageTypeChat}
+	query := `SELECT id, code, sender_id, username, content, create_time, update_time FROM message
+WHERE stream_mode = $1 AND stream_subject = $2::UUID AND stream_descriptor = $3::UUID AND stream_label = $4 AND code = $5`
+	if tsquery != "" {
+		// The generated search column is kept up to date by the database as message content is written or updated.
+		params = append(params, tsquery)
+		query += fmt.Sprintf(" AND content_search @@ to_tsquery('simple', $%d)", len(params))
+	}
+	if filter.SenderID != uuid.Nil {
+		params = append(params, filter.SenderID)
+		query += fmt.Sprintf(" AND sender_id = $%d", len(params))
+	}
+	if !filter.StartTime.IsZero() {
+		params = append(params, &pgtype.Timestamptz{Time: filter.StartTime.UTC(), Valid: true})
+		query += fmt.Sprintf(" AND create_time >= $%d", len(params))
+	}
+	if !filter.EndTime.IsZero() {
+		params = append(params, &pgtype.Timestamptz{Time: filter.EndTime.UTC(), Valid: true})
+		query += fmt.Sprintf(" AND create_time < $%d", len(params))
+	}
+	if incomingCursor != nil {
+		params = append(params, &pgtype.Timestamptz{Time: time.Unix(0, incomingCursor.CreateTime).UTC(), Valid: true}, incomingCursor.Id)
+		query += fmt.Sprintf(" AND (create_time, id) < ($%d, $%d)", len(params)-1, len(params))
+	}
+	params = append(params, limit+1)
+	query += fmt.Sprintf(" ORDER BY create_time DESC, id DESC LIMIT $%d", len(params))
+
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Error searching channel messages", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*api.ChannelMessage, 0, limit)
+	var nextCursor *channelMessageSearchCursor
+
+	var dbID string
+	var dbCode int32
+	var dbSenderID string
+	var dbUsername string
+	var dbContent string
+	var dbCreateTime pgtype.Timestamptz
+	var dbUpdateTime pgtype.Timestamptz
+	for rows.Next() {
+		if len(messages) >= limit {
+			nextCursor = &channelMessageSearchCursor{
+				StreamMode:       stream.Mode,
+				StreamSubject:    stream.Subject.String(),
+				StreamSubcontext: stream.Subcontext.String(),
+				StreamLabel:      stream.Label,
+				Query:            tsquery,
+				SenderID:         filter.SenderID.String(),
+				StartTime:        channelMessageSearchTime(filter.StartTime),
+				EndTime:          channelMessageSearchTime(filter.EndTime),
+				CreateTime:       dbCreateTime.Time.UnixNano(),
+				Id:               dbID,
+			}
+			break
+		}
+
+		if err := rows.Scan(&dbID, &dbCode, &dbSenderID, &dbUsername, &dbContent, &dbCreateTime, &dbUpdateTime); err != nil {
+			logger.Error("Error parsing searched channel messages", zap.Error(err))
+			return nil, err
+		}
+
+		message := &api.ChannelMessage{
+			ChannelId:  channelID,
+			MessageId:  dbID,
+			Code:       &wrapperspb.Int32Value{Value: dbCode},
+			SenderId:   dbSenderID,
+			Username:   dbUsername,
+			Content:    dbContent,
+			CreateTime: &timestamppb.Timestamp{Seconds: dbCreateTime.Time.Unix()},
+			UpdateTime: &timestamppb.Timestamp{Seconds: dbUpdateTime.Time.Unix()},
+			Persistent: &wrapperspb.BoolValue{Value: true},
+		}
+		switch stream.Mode {
+		case StreamModeChannel:
+			message.RoomName = stream.Label
+		case StreamModeGroup:
+			message.GroupId = stream.Subject.String()
+		case StreamModeDM:
+			message.UserIdOne = stream.Subject.String()
+			message.UserIdTwo = stream.Subcontext.String()
+		}
+
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error searching channel messages", zap.Error(err))
+		return nil, err
+	}
+
+	var nextCursorStr string
+	if nextCursor != nil {
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(nextCursor); err != nil {
+			logger.Error("Error creating channel messages search cursor", zap.Error(err))
+			return nil, err
+		}
+		nextCursorStr = base64.StdEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	return &api.ChannelMessageList{
+		Messages:   messages,
+		NextCursor: nextCursorStr,
+	}, nil
+}
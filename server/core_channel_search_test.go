@@ -0,0 +1,116 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMessageSearchTsquery(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"", ""},
+		{"  !!! ", ""},
+		{"Hello", "hello:*"},
+		{"good game, well played!", "good:* & game:* & well:* & played:*"},
+		{"it's o'clock", "it:* & s:* & o:* & clock:*"},
+		{"a:* | b & !c", "a:* & b:* & c:*"},
+		{"привет мир", "привет:* & мир:*"},
+		{"1 2 3 4 5 6 7 8 9 10 11 12", "1:* & 2:* & 3:* & 4:* & 5:* & 6:* & 7:* & 8:* & 9:* & 10:*"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, channelMessageSearchTsquery(tt.query), tt.query)
+	}
+}
+
+func TestChannelMessagesSearch(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+
+	userA := uuid.Must(uuid.NewV4())
+	userB := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userA)
+	InsertUser(t, db, userB)
+
+	stream := PresenceStream{Mode: StreamModeChannel, Label: "search-" + userA.String()}
+	start := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	insert := func(sender uuid.UUID, code int32, content string, offset time.Duration) string {
+		id := uuid.Must(uuid.NewV4()).String()
+		_, err := db.ExecContext(ctx, `INSERT INTO message (id, code, sender_id, username, stream_mode, stream_subject, stream_descriptor, stream_label, content, create_time, update_time)
+VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`, id, code, sender, sender.String(), stream.Mode, stream.Subject, stream.Subcontext, stream.Label, content, start.Add(offset))
+		require.NoError(t, err)
+		return id
+	}
+	m1 := insert(userA, ChannelMessageTypeChat, `{"message":"Good game everyone"}`, 0)
+	m2 := insert(userB, ChannelMessageTypeChat, `{"message":"good luck"}`, time.Second)
+	m3 := insert(userA, ChannelMessageTypeChat, `{"message":"see you later"}`, 2*time.Second)
+	// Only chat messages are searched.
+	insert(userB, ChannelMessageTypeGroupJoin, `{"message":"good to be here"}`, 3*time.Second)
+
+	search := func(filter *ChannelMessageSearchFilter, limit int, cursor string) ([]string, string) {
+		list, err := ChannelMessagesSearch(ctx, logger, db, uuid.Nil, stream, "", filter, limit, cursor)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(list.Messages))
+		for _, message := range list.Messages {
+			ids = append(ids, message.MessageId)
+		}
+		return ids, list.NextCursor
+	}
+
+	ids, _ := search(&ChannelMessageSearchFilter{Query: "GOOD"}, 10, "")
+	assert.Equal(t, []string{m2, m1}, ids, "case insensitive, most recent first")
+	ids, _ = search(&ChannelMessageSearchFilter{Query: "gam"}, 10, "")
+	assert.Equal(t, []string{m1}, ids, "words match by prefix")
+	ids, _ = search(&ChannelMessageSearchFilter{Query: "good, luck!"}, 10, "")
+	assert.Equal(t, []string{m2}, ids, "every word must match")
+	ids, _ = search(&ChannelMessageSearchFilter{Query: "good", SenderID: userA}, 10, "")
+	assert.Equal(t, []string{m1}, ids)
+	ids, _ = search(&ChannelMessageSearchFilter{StartTime: start.Add(time.Second), EndTime: start.Add(2 * time.Second)}, 10, "")
+	assert.Equal(t, []string{m2}, ids, "start time is inclusive and end time exclusive")
+
+	// Page through every chat message sent by either user.
+	filter := &ChannelMessageSearchFilter{StartTime: start}
+	ids, cursor := search(filter, 2, "")
+	assert.Equal(t, []string{m3, m2}, ids)
+	require.NotEmpty(t, cursor)
+	ids, cursor = search(filter, 2, cursor)
+	assert.Equal(t, []string{m1}, ids)
+	assert.Empty(t, cursor)
+	_, err := ChannelMessagesSearch(ctx, logger, db, uuid.Nil, stream, "", &ChannelMessageSearchFilter{Query: "good"}, 2, "invalid")
+	assert.Error(t, err)
+
+	// Edited messages are found by their new content.
+	_, err = db.ExecContext(ctx, "UPDATE message SET content = $2 WHERE id = $1", m3, `{"message":"well played"}`)
+	require.NoError(t, err)
+	ids, _ = search(&ChannelMessageSearchFilter{Query: "played"}, 10, "")
+	assert.Equal(t, []string{m3}, ids)
+	ids, _ = search(&ChannelMessageSearchFilter{Query: "later"}, 10, "")
+	assert.Empty(t, ids)
+
+	_, err = ChannelMessagesSearch(ctx, logger, db, uuid.Nil, stream, "", &ChannelMessageSearchFilter{Query: " !!! "}, 10, "")
+	assert.ErrorIs(t, err, errChannelMessageSearchInvalid)
+	_, err = ChannelMessagesSearch(ctx, logger, db, uuid.Nil, stream, "", &ChannelMessageSearchFilter{}, 10, "")
+	assert.ErrorIs(t, err, errChannelMessageSearchInvalid)
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+var ErrChatMuteNotFound = errors.New("chat mute not found")
+
+// ChatMuteAdd mutes a user in chat until the expiry time, or indefinitely if the expiry time is zero. An existing
+// mute for the user is replaced.
+func ChatMuteAdd(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, reason string, expiryTime time.Time) error {
+	expiry := &pgtype.Timestamptz{}
+	if !expiryTime.IsZero() {
+		expiry = &pgtype.Timestamptz{Time: expiryTime.UTC(), Valid: true}
+	}
+
+	query := `
+INSERT INTO chat_mute (user_id, reason, expiry_time)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE SET reason = $2, expiry_time = $3, create_time = now()`
+	if _, err := db.ExecContext(ctx, query, userID, reason, expiry); err != nil {
+		logger.Error("Could not add chat mute.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
+
+	return nil
+}
+
+// ChatMuteDelete removes a user's chat mute.
+func ChatMuteDelete(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) error {
+	result, err := db.ExecContext(ctx, "DELETE FROM chat_mute WHERE user_id = $1", userID)
+	if err != nil {
+		logger.Error("Could not delete chat mute.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return ErrChatMuteNotFound
+	}
+
+	return nil
+}
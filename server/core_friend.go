@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
@@ -36,6 +37,12 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+var (
+	errFriendInviteMessageTooLong = errors.New("friend invite message is too long")
+	errFriendInviteOutgoingLimit  = errors.New("too many pending outgoing friend invites")
+	errFriendInviteIncomingLimit  = errors.New("user has too many pending incoming friend invites")
+)
+
 type edgeListCursor struct {
 	// ID fields.
 	State    int64
@@ -465,7 +472,13 @@ AND state = 0
 	return &api.FriendsOfFriendsList{FriendsOfFriends: fof, Cursor: outgoingCursor}, nil
 }
 
-func AddFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, messageRouter MessageRouter, userID uuid.UUID, username string, friendIDs []string) error {
+// AddFriends sends friend invites to the given users, or accepts their invites if they have already sent one. An
+// optional message is included in the notification sent for new invites.
+func AddFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, messageRouter MessageRouter, config *FriendConfig, userID uuid.UUID, username string, friendIDs []string, message string) error {
+	if config.MaxMessageLength > 0 && utf8.RuneCountInString(message) > config.MaxMessageLength {
+		return errFriendInviteMessageTooLong
+	}
+
 	uniqueFriendIDs := make(map[string]struct{})
 	for _, fid := range friendIDs {
 		uniqueFriendIDs[fid] = struct{}{}
@@ -477,6 +490,13 @@ func AddFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 		// If the transaction is retried ensure we wipe any notifications that may have been prepared by previous attempts.
 		notificationToSend = make(map[string]bool)
 
+		// Expired invites must not be accepted, and no longer count towards pending invite limits.
+		if config.InviteExpirySec > 0 {
+			if _, err := friendInvitesDeleteExpired(ctx, logger, tx, config.InviteExpirySec, userID, 0); err != nil {
+				return err
+			}
+		}
+
 		for id := range uniqueFriendIDs {
 			// Check to see if user has already blocked friend, if so, don't add friend or send notification.
 			var blockState int
@@ -492,6 +512,12 @@ func AddFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 				continue
 			}
 
+			if config.MaxPendingOutgoing > 0 || config.MaxPendingIncoming > 0 {
+				if err := friendInviteCheckLimits(ctx, logger, tx, config, userID, id); err != nil {
+					return err
+				}
+			}
+
 			isFriendAccept, addFriendErr := addFriend(ctx, logger, tx, userID, id)
 			if addFriendErr == nil {
 				notificationToSend[id] = isFriendAccept
@@ -501,24 +527,32 @@ func AddFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 		}
 		return nil
 	}); err != nil {
-		logger.Error("Error adding friends.", zap.Error(err))
+		if err != errFriendInviteOutgoingLimit && err != errFriendInviteIncomingLimit {
+			logger.Error("Error adding friends.", zap.Error(err))
+		}
 		return err
 	}
 
 	notifications := make(map[uuid.UUID][]*api.Notification)
 	content, _ := json.Marshal(map[string]interface{}{"username": username})
+	requestContent := content
+	if message != "" {
+		requestContent, _ = json.Marshal(map[string]interface{}{"username": username, "message": message})
+	}
 	for id, isFriendAccept := range notificationToSend {
 		uid := uuid.FromStringOrNil(id)
 		code := NotificationCodeFriendRequest
 		subject := fmt.Sprintf("%v wants to add you as a friend", username)
+		notificationContent := requestContent
 		if isFriendAccept {
 			code = NotificationCodeFriendAccept
 			subject = fmt.Sprintf("%v accepted your friend request", username)
+			notificationContent = content
 		}
 		notifications[uid] = []*api.Notification{{
 			Id:         uuid.Must(uuid.NewV4()).String(),
 			Subject:    subject,
-			Content:    string(content),
+			Content:    string(notificationContent),
 			SenderId:   userID.String(),
 			Code:       code,
 			Persistent: true,
@@ -532,6 +566,92 @@ func AddFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 	return nil
 }
 
+// Check that a new invite from the user to the friend stays within the configured pending invite limits. Invites
+// that would accept an existing invite, or where any other relationship already exists, are never limited.
+func friendInviteCheckLimits(ctx context.Context, logger *zap.Logger, tx *sql.Tx, config *FriendConfig, userID uuid.UUID, friendID string) error {
+	var edgeExists bool
+	query := "SELECT EXISTS(SELECT 1 FROM user_edge WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1))"
+	if err := tx.QueryRowContext(ctx, query, userID, friendID).Scan(&edgeExists); err != nil {
+		logger.Debug("Failed to check edge existence.", zap.Error(err), zap.String("user", userID.String()), zap.String("friend", friendID))
+		return err
+	}
+	if edgeExists {
+		return nil
+	}
+
+	if config.MaxPendingOutgoing > 0 {
+		// The user's own expired invites have already been removed.
+		var pending int
+		if err := tx.QueryRowContext(ctx, "SELECT count(*) FROM user_edge WHERE source_id = $1 AND state = 1", userID).Scan(&pending); err != nil {
+			logger.Debug("Failed to count pending outgoing friend invites.", zap.Error(err), zap.String("user", userID.String()))
+			return err
+		}
+		if pending >= config.MaxPendingOutgoing {
+			logger.Info("Could not add friend as user has too many pending outgoing invites.", zap.String("user", userID.String()), zap.String("friend", friendID), zap.Int("pending", pending))
+			return errFriendInviteOutgoingLimit
+		}
+	}
+
+	if config.MaxPendingIncoming > 0 {
+		var pending int
+		query = "SELECT count(*) FROM user_edge WHERE source_id = $1 AND state = 2"
+		params := []any{friendID}
+		if config.InviteExpirySec > 0 {
+			query += " AND update_time > $2"
+			params = append(params, time.Now().UTC().Add(-time.Duration(config.InviteExpirySec)*time.Second))
+		}
+		if err := tx.QueryRowContext(ctx, query, params...).Scan(&pending); err != nil {
+			logger.Debug("Failed to count pending incoming friend invites.", zap.Error(err), zap.String("friend", friendID))
+			return err
+		}
+		if pending >= config.MaxPendingIncoming {
+			logger.Info("Could not add friend as friend has too many pending incoming invites.", zap.String("user", userID.String()), zap.String("friend", friendID), zap.Int("pending", pending))
+			return errFriendInviteIncomingLimit
+		}
+	}
+
+	return nil
+}
+
+// Remove pending friend invites, both the sent and received edges, that are older than the given expiry and update
+// the edge counts of the users involved. When a user ID is given only invites sent or received by that user are
+// removed, otherwise at most limit invites are removed across all users. Returns the number of invites removed.
+func friendInvitesDeleteExpired(ctx context.Context, logger *zap.Logger, tx *sql.Tx, expirySec int, userID uuid.UUID, limit int) (int64, error) {
+	cutoff := time.Now().UTC().Add(-time.Duration(expirySec) * time.Second)
+	params := []any{cutoff}
+	filter := ""
+	if userID != uuid.Nil {
+		params = append(params, userID)
+		filter = " AND (source_id = $2 OR destination_id = $2)"
+	} else {
+		params = append(params, limit)
+		filter = " LIMIT $2"
+	}
+
+	query := `
+WITH expired AS (
+	SELECT source_id, destination_id FROM user_edge WHERE state = 1 AND update_time <= $1` + filter + `
+), deleted AS (
+	DELETE FROM user_edge
+	WHERE (state = 1 AND (source_id, destination_id) IN (SELECT source_id, destination_id FROM expired))
+	OR (state = 2 AND (source_id, destination_id) IN (SELECT destination_id, source_id FROM expired))
+	RETURNING source_id
+), updated AS (
+	UPDATE users SET edge_count = edge_count - d.count, update_time = now()
+	FROM (SELECT source_id, count(*) AS count FROM deleted GROUP BY source_id) AS d
+	WHERE users.id = d.source_id
+)
+SELECT count(*) FROM expired`
+
+	var count int64
+	if err := tx.QueryRowContext(ctx, query, params...).Scan(&count); err != nil {
+		logger.Debug("Failed to remove expired friend invites.", zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // Returns "true" if accepting an invite, otherwise false
 func addFriend(ctx context.Context, logger *zap.Logger, tx *sql.Tx, userID uuid.UUID, friendID string) (bool, error) {
 	// Mark an invite as accepted, if one was in place.
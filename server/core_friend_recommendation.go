@@ -0,0 +1,387 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/zap"
+)
+
+const (
+	// Source of signals recorded by the server for users who joined the same match.
+	FriendRecommendationSourceMatch = "match"
+
+	// Number of recommendations computed and cached for each user, the most that can be listed at once.
+	friendRecommendationMaxCandidates = 100
+	// Number of candidates read from each signal query before scores are combined.
+	friendRecommendationQueryLimit = 1000
+)
+
+var errFriendRecommendationSourceReserved = errors.New("friend recommendation signal source is reserved")
+
+type friendRecommendationCandidate struct {
+	UserID        string  `json:"user_id"`
+	Score         float64 `json:"score"`
+	MutualFriends int32   `json:"mutual_friends,omitempty"`
+	SharedGroups  int32   `json:"shared_groups,omitempty"`
+	RecentMatches int32   `json:"recent_matches,omitempty"`
+	Signal        float64 `json:"signal,omitempty"`
+}
+
+// FriendRecommendationsList lists users recommended as friends for the given user, ranked by mutual friends, shared
+// groups, recent matches played together and any signals set by the runtime. Existing friends, pending invites and
+// blocked users in either direction are never recommended. Computed recommendations are cached for a short while.
+func FriendRecommendationsList(ctx context.Context, logger *zap.Logger, db *sql.DB, statusRegistry StatusRegistry, config *FriendConfig, userID uuid.UUID, limit int) (*apigrpc.FriendRecommendationList, error) {
+	candidates, err := friendRecommendationCandidatesGet(ctx, logger, db, config, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cached candidates may have become friends, or been blocked, since they were computed.
+	excluded, err := friendRecommendationExcluded(ctx, logger, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]*friendRecommendationCandidate, 0, limit)
+	ids := make([]string, 0, limit)
+	for _, candidate := range candidates {
+		if _, found := excluded[candidate.UserID]; found {
+			continue
+		}
+		selected = append(selected, candidate)
+		ids = append(ids, candidate.UserID)
+		if len(selected) >= limit {
+			break
+		}
+	}
+
+	recommendations := make([]*apigrpc.FriendRecommendation, 0, len(selected))
+	if len(ids) == 0 {
+		return &apigrpc.FriendRecommendationList{Recommendations: recommendations}, nil
+	}
+
+	users, err := GetUsers(ctx, logger, db, statusRegistry, ids, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[string]*api.User, len(users.Users))
+	for _, user := range users.Users {
+		usersByID[user.Id] = user
+	}
+
+	for _, candidate := range selected {
+		user, found := usersByID[candidate.UserID]
+		if !found {
+			// User has been deleted since recommendations were computed.
+			continue
+		}
+		recommendations = append(recommendations, &apigrpc.FriendRecommendation{
+			User:              user,
+			Score:             candidate.Score,
+			MutualFriendCount: candidate.MutualFriends,
+			SharedGroupCount:  candidate.SharedGroups,
+			RecentMatchCount:  candidate.RecentMatches,
+			SignalScore:       candidate.Signal,
+		})
+	}
+
+	return &apigrpc.FriendRecommendationList{Recommendations: recommendations}, nil
+}
+
+// FriendRecommendationSignalSet sets the weight of a runtime-provided signal recommending the candidate to the user
+// as a friend. Signals from different sources are added together, and stop counting once they expire.
+func FriendRecommendationSignalSet(ctx context.Context, logger *zap.Logger, db *sql.DB, userID, candidateID uuid.UUID, source string, weight float64, expirySec int) error {
+	if source == FriendRecommendationSourceMatch {
+		return errFriendRecommendationSourceReserved
+	}
+
+	var expiryTime *time.Time
+	if expirySec > 0 {
+		t := time.Now().UTC().Add(time.Duration(expirySec) * time.Second)
+		expiryTime = &t
+	}
+
+	query := `
+INSERT INTO friend_recommendation_signal (user_id, candidate_id, source, weight, expiry_time)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, candidate_id, source)
+DO UPDATE SET weight = $4, expiry_time = $5, update_time = now()`
+	if _, err := db.ExecContext(ctx, query, userID, candidateID, source, weight, expiryTime); err != nil {
+		logger.Error("Could not set friend recommendation signal.", zap.Error(err), zap.String("user_id", userID.String()), zap.String("candidate_id", candidateID.String()), zap.String("source", source))
+		return err
+	}
+
+	return nil
+}
+
+// FriendRecommendationSignalDelete removes a runtime-provided friend recommendation signal.
+func FriendRecommendationSignalDelete(ctx context.Context, logger *zap.Logger, db *sql.DB, userID, candidateID uuid.UUID, source string) error {
+	query := "DELETE FROM friend_recommendation_signal WHERE user_id = $1 AND candidate_id = $2 AND source = $3"
+	if _, err := db.ExecContext(ctx, query, userID, candidateID, source); err != nil {
+		logger.Error("Could not delete friend recommendation signal.", zap.Error(err), zap.String("user_id", userID.String()), zap.String("candidate_id", candidateID.String()), zap.String("source", source))
+		return err
+	}
+
+	return nil
+}
+
+// Record that a user has joined a match alongside other users, so they are recommended to each other as friends.
+func friendRecommendationMatchRecord(ctx context.Context, logger *zap.Logger, db *sql.DB, config *FriendConfig, userID uuid.UUID, otherUserIDs []uuid.UUID) {
+	if config.RecommendationMatchWindowSec <= 0 {
+		return
+	}
+
+	expiryTime := time.Now().UTC().Add(time.Duration(config.RecommendationMatchWindowSec) * time.Second)
+	params := []any{userID, FriendRecommendationSourceMatch, expiryTime}
+	values := make([]string, 0, len(otherUserIDs)*2)
+	seen := make(map[uuid.UUID]struct{}, len(otherUserIDs))
+	for _, otherUserID := range otherUserIDs {
+		if _, found := seen[otherUserID]; found || otherUserID == userID {
+			continue
+		}
+		seen[otherUserID] = struct{}{}
+		params = append(params, otherUserID)
+		p := "$" + strconv.Itoa(len(params))
+		values = append(values, "($1, "+p+", $2, 1, $3)", "("+p+", $1, $2, 1, $3)")
+	}
+	if len(values) == 0 {
+		return
+	}
+
+	query := `
+INSERT INTO friend_recommendation_signal (user_id, candidate_id, source, weight, expiry_time)
+VALUES ` + strings.Join(values, ", ") + `
+ON CONFLICT (user_id, candidate_id, source)
+DO UPDATE SET weight = friend_recommendation_signal.weight + 1, expiry_time = $3, update_time = now()`
+	if _, err := db.ExecContext(ctx, query, params...); err != nil {
+		logger.Warn("Could not record match participants for friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+}
+
+// Read the user's ranked recommendation candidates from the cache, computing and caching them if needed.
+func friendRecommendationCandidatesGet(ctx context.Context, logger *zap.Logger, db *sql.DB, config *FriendConfig, userID uuid.UUID) ([]*friendRecommendationCandidate, error) {
+	var candidatesJSON []byte
+	err := db.QueryRowContext(ctx, "SELECT candidates FROM friend_recommendation_cache WHERE user_id = $1 AND expiry_time > now()", userID).Scan(&candidatesJSON)
+	switch {
+	case err == nil:
+		candidates := make([]*friendRecommendationCandidate, 0)
+		if err := json.Unmarshal(candidatesJSON, &candidates); err == nil {
+			return candidates, nil
+		}
+		// Unreadable cache entries are recomputed below.
+	case !errors.Is(err, sql.ErrNoRows):
+		logger.Error("Could not read cached friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+
+	candidates, err := friendRecommendationCandidatesCompute(ctx, logger, db, config, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.RecommendationCacheSec > 0 {
+		if candidatesJSON, err = json.Marshal(candidates); err != nil {
+			logger.Error("Could not encode friend recommendations.", zap.Error(err))
+			return nil, err
+		}
+		expiryTime := time.Now().UTC().Add(time.Duration(config.RecommendationCacheSec) * time.Second)
+		query := `
+INSERT INTO friend_recommendation_cache (user_id, candidates, expiry_time)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id)
+DO UPDATE SET candidates = $2, expiry_time = $3`
+		if _, err := db.ExecContext(ctx, query, userID, candidatesJSON, expiryTime); err != nil {
+			// Recommendations are still returned, they will be computed again on the next request.
+			logger.Warn("Could not cache friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+	}
+
+	return candidates, nil
+}
+
+func friendRecommendationCandidatesCompute(ctx context.Context, logger *zap.Logger, db *sql.DB, config *FriendConfig, userID uuid.UUID) ([]*friendRecommendationCandidate, error) {
+	candidates := make(map[string]*friendRecommendationCandidate)
+	candidate := func(id string) *friendRecommendationCandidate {
+		c, found := candidates[id]
+		if !found {
+			c = &friendRecommendationCandidate{UserID: id}
+			candidates[id] = c
+		}
+		return c
+	}
+
+	// Friends of the user's friends, by number of mutual friends.
+	query := `
+SELECT f2.destination_id, count(*)
+FROM user_edge f1
+JOIN user_edge f2 ON f2.source_id = f1.destination_id
+WHERE f1.source_id = $1 AND f1.state = 0 AND f2.state = 0 AND f2.destination_id <> $1
+GROUP BY f2.destination_id
+ORDER BY count(*) DESC
+LIMIT $2`
+	if err := friendRecommendationScanCounts(ctx, db, query, []any{userID, friendRecommendationQueryLimit}, func(id string, count int32) {
+		candidate(id).MutualFriends = count
+	}); err != nil {
+		logger.Error("Could not count mutual friends for friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+
+	// Members of the same groups, leaving out large groups where members are unlikely to know each other.
+	if config.RecommendationMaxGroupSize > 0 {
+		query = `
+SELECT m.destination_id, count(*)
+FROM group_edge g
+JOIN groups gr ON gr.id = g.destination_id
+JOIN group_edge m ON m.source_id = g.destination_id
+WHERE g.source_id = $1 AND g.state <= 2
+AND gr.edge_count <= $3 AND gr.disable_time = '1970-01-01 00:00:00 UTC'
+AND m.state <= 2 AND m.destination_id <> $1
+GROUP BY m.destination_id
+ORDER BY count(*) DESC
+LIMIT $2`
+		if err := friendRecommendationScanCounts(ctx, db, query, []any{userID, friendRecommendationQueryLimit, config.RecommendationMaxGroupSize}, func(id string, count int32) {
+			candidate(id).SharedGroups = count
+		}); err != nil {
+			logger.Error("Could not count shared groups for friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, err
+		}
+	}
+
+	// Recent match participants and runtime-provided signals, dropping any that have expired.
+	if _, err := db.ExecContext(ctx, "DELETE FROM friend_recommendation_signal WHERE user_id = $1 AND expiry_time <= now()", userID); err != nil {
+		logger.Warn("Could not remove expired friend recommendation signals.", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+	query = `
+SELECT candidate_id, source, weight
+FROM friend_recommendation_signal
+WHERE user_id = $1 AND (expiry_time IS NULL OR expiry_time > now())
+ORDER BY weight DESC
+LIMIT $2`
+	rows, err := db.QueryContext(ctx, query, userID, friendRecommendationQueryLimit)
+	if err != nil {
+		logger.Error("Could not read friend recommendation signals.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+	for rows.Next() {
+		var candidateID uuid.UUID
+		var source string
+		var weight float64
+		if err := rows.Scan(&candidateID, &source, &weight); err != nil {
+			_ = rows.Close()
+			logger.Error("Could not parse friend recommendation signals.", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, err
+		}
+		if source == FriendRecommendationSourceMatch {
+			candidate(candidateID.String()).RecentMatches = int32(weight)
+		} else {
+			candidate(candidateID.String()).Signal += weight
+		}
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not read friend recommendation signals.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+
+	excluded, err := friendRecommendationExcluded(ctx, logger, db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]*friendRecommendationCandidate, 0, len(candidates))
+	for id, c := range candidates {
+		if _, found := excluded[id]; found || id == userID.String() {
+			continue
+		}
+		c.Score = float64(c.MutualFriends)*config.RecommendationMutualWeight +
+			float64(c.SharedGroups)*config.RecommendationGroupWeight +
+			float64(c.RecentMatches)*config.RecommendationMatchWeight +
+			c.Signal
+		if c.Score <= 0 {
+			continue
+		}
+		ranked = append(ranked, c)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score == ranked[j].Score {
+			return ranked[i].UserID < ranked[j].UserID
+		}
+		return ranked[i].Score > ranked[j].Score
+	})
+	if len(ranked) > friendRecommendationMaxCandidates {
+		ranked = ranked[:friendRecommendationMaxCandidates]
+	}
+
+	return ranked, nil
+}
+
+// Users who must not be recommended: anyone the user already has a relationship with, and anyone who blocked them.
+func friendRecommendationExcluded(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (map[string]struct{}, error) {
+	query := `
+SELECT destination_id FROM user_edge WHERE source_id = $1
+UNION
+SELECT source_id FROM user_edge WHERE destination_id = $1 AND state = 3`
+	rows, err := db.QueryContext(ctx, query, userID)
+	if err != nil {
+		logger.Error("Could not read user edges for friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	excluded := make(map[string]struct{})
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			logger.Error("Could not parse user edges for friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, err
+		}
+		excluded[id.String()] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not read user edges for friend recommendations.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+
+	return excluded, nil
+}
+
+func friendRecommendationScanCounts(ctx context.Context, db *sql.DB, query string, params []any, fn func(id string, count int32)) error {
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uuid.UUID
+		var count int32
+		if err := rows.Scan(&id, &count); err != nil {
+			return err
+		}
+		fn(id.String(), count)
+	}
+	return rows.Err()
+}
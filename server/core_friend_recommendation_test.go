@@ -0,0 +1,120 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFriendRecommendationsList(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	groupIndex, err := NewLocalGroupIndex(logger, db)
+	require.NoError(t, err)
+	sessionRegistry := NewLocalSessionRegistry(metrics)
+	statusRegistry := NewLocalStatusRegistry(logger, cfg, sessionRegistry, protojsonMarshaler)
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+	config := &FriendConfig{
+		RecommendationCacheSec:       300,
+		RecommendationMatchWindowSec: 3600,
+		RecommendationMaxGroupSize:   10,
+		RecommendationMutualWeight:   1.0,
+		RecommendationGroupWeight:    0.5,
+		RecommendationMatchWeight:    0.5,
+	}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	invite := func(userID uuid.UUID, friendIDs ...uuid.UUID) {
+		ids := make([]string, 0, len(friendIDs))
+		for _, id := range friendIDs {
+			ids = append(ids, id.String())
+		}
+		require.NoError(t, AddFriends(ctx, logger, db, tracker, router, &FriendConfig{}, userID, "", ids, ""))
+	}
+	befriend := func(userID uuid.UUID, friendIDs ...uuid.UUID) {
+		invite(userID, friendIDs...)
+		for _, id := range friendIDs {
+			invite(id, userID)
+		}
+	}
+	user, friendA, friendB := newUser(), newUser(), newUser()
+	mutualOne, mutualTwo, grouped, matched, signaled := newUser(), newUser(), newUser(), newUser(), newUser()
+	blocked, blocker, invited := newUser(), newUser(), newUser()
+
+	befriend(user, friendA, friendB)
+	befriend(friendA, mutualOne, mutualTwo, blocked, blocker, invited)
+	befriend(friendB, mutualTwo)
+
+	group, err := CreateGroup(ctx, logger, db, groupIndex, user, user, uuid.Must(uuid.NewV4()).String(), "en", "", "", "", true, 10)
+	require.NoError(t, err)
+	require.NoError(t, AddGroupUsers(ctx, logger, db, groupIndex, tracker, router, user, uuid.Must(uuid.FromString(group.Id)), []uuid.UUID{grouped}))
+
+	for i := 0; i < 3; i++ {
+		friendRecommendationMatchRecord(ctx, logger, db, config, user, []uuid.UUID{matched, matched})
+	}
+	require.NoError(t, FriendRecommendationSignalSet(ctx, logger, db, user, signaled, "custom", 5, 0))
+	assert.Equal(t, errFriendRecommendationSourceReserved, FriendRecommendationSignalSet(ctx, logger, db, user, signaled, FriendRecommendationSourceMatch, 5, 0))
+
+	// Blocked users in either direction and pending invites are left out.
+	require.NoError(t, BlockFriends(ctx, logger, db, tracker, user, []string{blocked.String()}))
+	require.NoError(t, BlockFriends(ctx, logger, db, tracker, blocker, []string{user.String()}))
+	invite(user, invited)
+
+	list := func(config *FriendConfig) []string {
+		recommendations, err := FriendRecommendationsList(ctx, logger, db, statusRegistry, config, user, 10)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(recommendations.Recommendations))
+		for _, r := range recommendations.Recommendations {
+			ids = append(ids, r.User.Id)
+		}
+		return ids
+	}
+
+	// Scores are 5 for the signal, 1 per mutual friend, 0.5 per recent match and 0.5 per shared group.
+	expected := []string{signaled.String(), mutualTwo.String(), matched.String(), mutualOne.String(), grouped.String()}
+	assert.Equal(t, expected, list(config))
+
+	recommendations, err := FriendRecommendationsList(ctx, logger, db, statusRegistry, config, user, 2)
+	require.NoError(t, err)
+	require.Len(t, recommendations.Recommendations, 2)
+	assert.Equal(t, 5.0, recommendations.Recommendations[0].SignalScore)
+	assert.EqualValues(t, 2, recommendations.Recommendations[1].MutualFriendCount)
+
+	// Cached recommendations are reused, but never include users who became friends since.
+	late := newUser()
+	require.NoError(t, FriendRecommendationSignalSet(ctx, logger, db, user, late, "custom", 10, 0))
+	befriend(user, mutualTwo)
+	assert.Equal(t, []string{signaled.String(), matched.String(), mutualOne.String(), grouped.String()}, list(config))
+
+	// Expired signals no longer count once recommendations are computed again.
+	_, err = db.ExecContext(ctx, "UPDATE friend_recommendation_signal SET expiry_time = now() - INTERVAL '1 minute' WHERE user_id = $1 AND candidate_id = $2", user, signaled)
+	require.NoError(t, err)
+	uncached := *config
+	uncached.RecommendationCacheSec = 0
+	_, err = db.ExecContext(ctx, "DELETE FROM friend_recommendation_cache WHERE user_id = $1", user)
+	require.NoError(t, err)
+	assert.Equal(t, []string{late.String(), matched.String(), mutualOne.String(), grouped.String()}, list(&uncached))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -145,3 +146,116 @@ func TestServer_ListFriendsOfFriends(t *testing.T) {
 		assert.Empty(t, fof.Cursor)
 	})
 }
+
+func TestFriendInvitesDeleteExpired(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+	config := &FriendConfig{}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	user, expired, pending, friend := newUser(), newUser(), newUser(), newUser()
+
+	require.NoError(t, AddFriends(ctx, logger, db, tracker, router, config, user, "", []string{expired.String(), pending.String(), friend.String()}, ""))
+	require.NoError(t, AddFriends(ctx, logger, db, tracker, router, config, friend, "", []string{user.String()}, ""))
+	age := func(a, b uuid.UUID, age string) {
+		_, err := db.ExecContext(ctx, "UPDATE user_edge SET update_time = now() - $3::INTERVAL WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", a, b, age)
+		require.NoError(t, err)
+	}
+	age(user, expired, "2 hours")
+	// Friendships never expire, however old.
+	age(user, friend, "2 hours")
+
+	edgeCount := func(userID uuid.UUID) int {
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT edge_count FROM users WHERE id = $1", userID).Scan(&count))
+		return count
+	}
+	edges := func(a, b uuid.UUID) int {
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM user_edge WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", a, b).Scan(&count))
+		return count
+	}
+	deleteExpired := func(userID uuid.UUID, limit int) int64 {
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		count, err := friendInvitesDeleteExpired(ctx, logger, tx, 3600, userID, limit)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		return count
+	}
+
+	assert.Zero(t, deleteExpired(pending, 0), "only invites of the given user")
+	assert.Equal(t, 2, edges(user, expired))
+	assert.EqualValues(t, 1, deleteExpired(expired, 0))
+	assert.Zero(t, edges(user, expired))
+	assert.Equal(t, 2, edges(user, pending))
+	assert.Equal(t, 2, edges(user, friend))
+	assert.Equal(t, 2, edgeCount(user))
+	assert.Equal(t, 0, edgeCount(expired))
+	assert.Equal(t, 1, edgeCount(pending))
+	assert.Equal(t, 1, edgeCount(friend))
+
+	// Without a user, invites across all users are removed in batches.
+	age(user, pending, "2 hours")
+	assert.EqualValues(t, 1, deleteExpired(uuid.Nil, 1))
+	// Expired invites left by earlier tests may be removed before this one.
+	for deleteExpired(uuid.Nil, 100) > 0 {
+	}
+	assert.Zero(t, edges(user, pending))
+	assert.Equal(t, 1, edgeCount(user))
+	assert.Equal(t, 0, edgeCount(pending))
+}
+
+func TestFriendInviteLimits(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+	config := &FriendConfig{InviteExpirySec: 3600, MaxPendingOutgoing: 2, MaxPendingIncoming: 2}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	add := func(userID uuid.UUID, friendIDs ...uuid.UUID) error {
+		ids := make([]string, 0, len(friendIDs))
+		for _, id := range friendIDs {
+			ids = append(ids, id.String())
+		}
+		return AddFriends(ctx, logger, db, tracker, router, config, userID, "", ids, "")
+	}
+	expire := func(a, b uuid.UUID) {
+		_, err := db.ExecContext(ctx, "UPDATE user_edge SET update_time = now() - INTERVAL '2 hours' WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", a, b)
+		require.NoError(t, err)
+	}
+
+	// Outgoing invites.
+	user, a, b, c := newUser(), newUser(), newUser(), newUser()
+	require.NoError(t, add(user, a, b))
+	assert.Equal(t, errFriendInviteOutgoingLimit, add(user, c))
+	require.NoError(t, add(user, a), "repeated invites are never limited")
+	require.NoError(t, add(a, user), "accepting invites is never limited")
+	require.NoError(t, add(user, c))
+	assert.Equal(t, errFriendInviteOutgoingLimit, add(user, newUser()))
+	expire(user, b)
+	require.NoError(t, add(user, newUser()), "expired invites don't count")
+
+	// Incoming invites.
+	target, x, y, z := newUser(), newUser(), newUser(), newUser()
+	require.NoError(t, add(x, target))
+	require.NoError(t, add(y, target))
+	assert.Equal(t, errFriendInviteIncomingLimit, add(z, target))
+	require.NoError(t, add(target, z), "users with too many incoming invites can still send their own")
+	require.NoError(t, add(z, target), "accepting invites is never limited")
+	expire(x, target)
+	require.NoError(t, add(newUser(), target), "expired invites don't count, even before they're removed")
+}
@@ -46,7 +46,10 @@ var ErrEmptyMemberDemote = errors.New("could not demote member")
 var ErrEmptyMemberPromote = errors.New("could not promote member")
 var ErrEmptyMemberKick = errors.New("could not kick member")
 
-const BANNED_CODE = 4
+const (
+	BANNED_CODE  = 4
+	INVITED_CODE = 5
+)
 
 type groupListCursor struct {
 	Lang       string
@@ -67,7 +70,7 @@ func (c *groupListCursor) GetUpdateTime() time.Time {
 	return time.Unix(0, c.UpdateTime)
 }
 
-func CreateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, creatorID uuid.UUID, name, lang, desc, avatarURL, metadata string, open bool, maxCount int) (*api.Group, error) {
+func CreateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, userID uuid.UUID, creatorID uuid.UUID, name, lang, desc, avatarURL, metadata string, open bool, maxCount int) (*api.Group, error) {
 	if userID == uuid.Nil {
 		return nil, runtime.ErrGroupCreatorInvalid
 	}
@@ -142,14 +145,16 @@ RETURNING id, creator_id, name, description, avatar_url, state, edge_count, lang
 		return nil, err
 	}
 
+	groupIndex.Write(ctx, []*api.Group{group})
+
 	logger.Info("Group created.", zap.String("group_id", group.Id), zap.String("user_id", userID.String()))
 
 	return group, nil
 }
 
-func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID, userID uuid.UUID, creatorID uuid.UUID, name, lang, desc, avatar, metadata *wrapperspb.StringValue, open *wrapperspb.BoolValue, maxCount int) error {
+func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, groupID uuid.UUID, userID uuid.UUID, creatorID uuid.UUID, name, lang, desc, avatar, metadata *wrapperspb.StringValue, open *wrapperspb.BoolValue, maxCount int) error {
 	if userID != uuid.Nil {
-		allowedUser, err := groupCheckUserPermission(ctx, logger, db, groupID, userID, 1)
+		allowedUser, err := GroupUserPermissionCheck(ctx, logger, db, groupID, userID, GroupPermissionEditMetadata)
 		if err != nil {
 			return err
 		}
@@ -163,20 +168,25 @@ func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uu
 	statements := make([]string, 0)
 	params := []interface{}{groupID}
 	index := 2
+	// Names of the changed fields, recorded in the group's activity feed.
+	fields := make([]string, 0)
 
 	if name != nil {
+		fields = append(fields, "name")
 		statements = append(statements, "name = $"+strconv.Itoa(index))
 		params = append(params, name.GetValue())
 		index++
 	}
 
 	if lang != nil {
+		fields = append(fields, "lang_tag")
 		statements = append(statements, "lang_tag = $"+strconv.Itoa(index))
 		params = append(params, lang.GetValue())
 		index++
 	}
 
 	if desc != nil {
+		fields = append(fields, "description")
 		if u := desc.GetValue(); u == "" {
 			statements = append(statements, "description = NULL")
 		} else {
@@ -187,6 +197,7 @@ func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uu
 	}
 
 	if avatar != nil {
+		fields = append(fields, "avatar_url")
 		if u := avatar.GetValue(); u == "" {
 			statements = append(statements, "avatar_url = NULL")
 		} else {
@@ -197,6 +208,7 @@ func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uu
 	}
 
 	if open != nil {
+		fields = append(fields, "open")
 		state := 0
 		if !open.GetValue() {
 			state = 1
@@ -207,12 +219,14 @@ func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uu
 	}
 
 	if metadata != nil {
+		fields = append(fields, "metadata")
 		statements = append(statements, "metadata = $"+strconv.Itoa(index))
 		params = append(params, metadata.GetValue())
 		index++
 	}
 
 	if maxCount > 0 {
+		fields = append(fields, "max_count")
 		statements = append(statements, "max_count = $"+strconv.Itoa(index))
 		params = append(params, maxCount)
 		index++
@@ -221,6 +235,7 @@ func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uu
 	if creatorID != uuid.Nil {
 		statements = append(statements, "creator_id = $"+strconv.Itoa(index))
 		params = append(params, creatorID)
+		fields = append(fields, "creator_id")
 	}
 
 	if len(statements) == 0 {
@@ -229,32 +244,40 @@ func UpdateGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uu
 	}
 
 	query := "UPDATE groups SET update_time = now(), " + strings.Join(statements, ", ") + " WHERE (id = $1) AND (disable_time = '1970-01-01 00:00:00 UTC')"
-	res, err := db.ExecContext(ctx, query, params...)
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation {
-			logger.Info("Could not update group as it already exists.", zap.String("group_id", groupID.String()))
-			return runtime.ErrGroupNameInUse
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, query, params...)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation {
+				logger.Info("Could not update group as it already exists.", zap.String("group_id", groupID.String()))
+				return runtime.ErrGroupNameInUse
+			}
+			logger.Error("Could not update group.", zap.Error(err))
+			return err
 		}
-		logger.Error("Could not update group.", zap.Error(err))
-		return err
-	}
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		logger.Error("Could not get rows affected after group update query.", zap.Error(err))
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			logger.Error("Could not get rows affected after group update query.", zap.Error(err))
+			return err
+		}
+		if rowsAffected == 0 {
+			return runtime.ErrGroupNotUpdated
+		}
+
+		return groupEventInsert(ctx, logger, tx, groupID, GroupEventUpdate, userID, uuid.Nil, map[string]any{"fields": fields})
+	}); err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return runtime.ErrGroupNotUpdated
-	}
+
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
 
 	logger.Info("Group updated.", zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
 
 	return nil
 }
 
-func DeleteGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, groupID uuid.UUID, userID uuid.UUID) error {
+func DeleteGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, tracker Tracker, groupID uuid.UUID, userID uuid.UUID) error {
 	if userID != uuid.Nil {
 		// only super-admins can delete group.
 		allowedUser, err := groupCheckUserPermission(ctx, logger, db, groupID, userID, 0)
@@ -275,6 +298,8 @@ func DeleteGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tr
 		return err
 	}
 
+	groupIndex.Delete(ctx, []uuid.UUID{groupID})
+
 	// Close group chat channel after a successful group delete.
 	tracker.UntrackByStream(PresenceStream{
 		Mode:    StreamModeGroup,
@@ -286,7 +311,14 @@ func DeleteGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tr
 	return nil
 }
 
-func JoinGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, router MessageRouter, groupID uuid.UUID, userID uuid.UUID, username string) error {
+func JoinGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, tracker Tracker, router MessageRouter, groupID uuid.UUID, userID uuid.UUID, username string) error {
+	// Users with a pending invite join by accepting it, even if the group is closed.
+	if pending, err := groupInvitePending(ctx, logger, db, groupID, userID); err != nil {
+		return err
+	} else if pending {
+		return GroupInviteAccept(ctx, logger, db, groupIndex, router, groupID, userID, username)
+	}
+
 	query := `
 SELECT id, creator_id, name, description, avatar_url, state, edge_count, lang_tag, max_count, metadata, create_time, update_time
 FROM groups
@@ -318,7 +350,12 @@ WHERE (id = $1) AND (disable_time = '1970-01-01 00:00:00 UTC')`
 	state := 2
 	if !group.Open.Value {
 		state = 3
-		_, err = groupAddUser(ctx, db, nil, uuid.Must(uuid.FromString(group.Id)), userID, state)
+		err = ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+			if _, err := groupAddUser(ctx, db, tx, uuid.Must(uuid.FromString(group.Id)), userID, state); err != nil {
+				return err
+			}
+			return groupEventInsert(ctx, logger, tx, groupID, GroupEventJoinRequest, userID, userID, nil)
+		})
 		if err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation {
@@ -437,6 +474,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 			logger.Debug("Could insert group join channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
 			return err
 		}
+		if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventJoin, userID, userID, nil); err != nil {
+			return err
+		}
 
 		return nil
 	}); err != nil {
@@ -450,13 +490,15 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 		return err
 	}
 
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
+
 	router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
 
 	logger.Info("Successfully joined group.", zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
 	return nil
 }
 
-func LeaveGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, router MessageRouter, streamManager StreamManager, groupID uuid.UUID, userID uuid.UUID, username string) error {
+func LeaveGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, tracker Tracker, router MessageRouter, streamManager StreamManager, groupID uuid.UUID, userID uuid.UUID, username string) error {
 	var myState sql.NullInt64
 	query := "SELECT state FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID"
 	if err := db.QueryRowContext(ctx, query, groupID, userID).Scan(&myState); err != nil {
@@ -510,7 +552,7 @@ func LeaveGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 		if otherSuperadminCount == 0 {
 			if otherMemberCount == 0 {
 				// The caller is a superadmin and the last member of the group, convert this operation to a deletion.
-				return DeleteGroup(ctx, logger, db, tracker, groupID, userID)
+				return DeleteGroup(ctx, logger, db, groupIndex, tracker, groupID, userID)
 			}
 
 			logger.Info("Cannot leave group as user is last superadmin.", zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
@@ -549,6 +591,9 @@ func LeaveGroup(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 			logger.Debug("Could not delete group_edge relationships.", zap.Error(err))
 			return err
 		}
+		if err = groupUserRoleRemove(ctx, logger, tx, groupID, userID); err != nil {
+			return err
+		}
 
 		// check to ensure we are not decrementing the count when the relationship was an invite.
 		if myState.Int64 < 3 {
@@ -577,6 +622,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 			logger.Debug("Could insert group leave channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
 			return err
 		}
+		if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventLeave, userID, userID, nil); err != nil {
+			return err
+		}
 
 		return nil
 	}); err != nil {
@@ -584,6 +632,8 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 		return err
 	}
 
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
+
 	router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
 
 	// Remove presences.
@@ -600,21 +650,15 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 	return nil
 }
 
-func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, router MessageRouter, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, tracker Tracker, router MessageRouter, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
 	if caller != uuid.Nil {
-		var dbState sql.NullInt64
-		query := "SELECT state FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID"
-		if err := db.QueryRowContext(ctx, query, groupID, caller).Scan(&dbState); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				logger.Info("Could not retrieve state as no group relationship exists.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
-				return runtime.ErrGroupPermissionDenied
-			}
-			logger.Error("Could not retrieve state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+		myState, granted, err := groupCallerState(ctx, logger, db, groupID, caller, GroupPermissionInvite)
+		if err != nil {
 			return err
 		}
 
-		if dbState.Int64 > 1 {
-			logger.Info("Cannot add users as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int64("state", dbState.Int64))
+		if myState > 1 && !granted {
+			logger.Info("Cannot add users as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int("state", myState))
 			return runtime.ErrGroupPermissionDenied
 		}
 	}
@@ -694,6 +738,13 @@ func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker
 					logger.Debug("Could not update user state in group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 					return err
 				}
+				if res == 0 {
+					// Adding a user with a pending invite accepts the invite on their behalf.
+					if res, err = groupUpdateUserState(ctx, db, tx, groupID, uid, INVITED_CODE, 2); err != nil {
+						logger.Debug("Could not update user state in group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
+						return err
+					}
+				}
 				if res != 2 {
 					incrementEdgeCount = false
 				}
@@ -739,6 +790,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 				logger.Debug("Could insert group add channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 				return err
 			}
+			if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventAdd, caller, uid, nil); err != nil {
+				return err
+			}
 
 			messages = append(messages, message)
 
@@ -759,6 +813,8 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 		return err
 	}
 
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
+
 	for _, message := range messages {
 		router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
 	}
@@ -771,22 +827,17 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 	return nil
 }
 
-func BanGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, router MessageRouter, streamManager StreamManager, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+func BanGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, tracker Tracker, router MessageRouter, streamManager StreamManager, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
 	myState := 0
 	if caller != uuid.Nil {
-		var dbState sql.NullInt64
-		query := "SELECT state FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID"
-		if err := db.QueryRowContext(ctx, query, groupID, caller).Scan(&dbState); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				logger.Info("Could not retrieve state as no group relationship exists.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
-				return runtime.ErrGroupPermissionDenied
-			}
-			logger.Error("Could not retrieve state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+		var granted bool
+		var err error
+		if myState, granted, err = groupCallerState(ctx, logger, db, groupID, caller, GroupPermissionBan); err != nil {
 			return err
 		}
 
-		myState = int(dbState.Int64)
-		if myState > 1 {
+		// Members granted the permission by a role ban like admins, only members, join requests and banned users.
+		if myState > 1 && !granted {
 			logger.Info("Cannot ban users as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int("state", myState))
 			return runtime.ErrGroupPermissionDenied
 		}
@@ -865,6 +916,9 @@ RETURNING state`
 				logger.Debug("Could not delete relationship from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 				return err
 			}
+			if err := groupUserRoleRemove(ctx, logger, tx, groupID, uid); err != nil {
+				return err
+			}
 
 			query = `
 INSERT INTO group_edge (position, state, source_id, destination_id) VALUES ($1, $2, $3, $4)
@@ -875,6 +929,10 @@ UPDATE SET state = $2, update_time = now()`
 				logger.Debug("Could not add banned relationship in group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 				return err
 			}
+			// Record bans of join requests and invites too, with the state the user was removed from.
+			if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventBan, caller, uid, map[string]any{"state": deletedState.Int64}); err != nil {
+				return err
+			}
 
 			// Only update group edge count and send messages when we kicked valid members, not invites.
 			if deletedState.Int64 < 3 {
@@ -926,6 +984,8 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 		return err
 	}
 
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
+
 	for _, message := range messages {
 		router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
 	}
@@ -943,22 +1003,17 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 	return nil
 }
 
-func KickGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, router MessageRouter, streamManager StreamManager, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID, strictError bool) error {
+func KickGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, tracker Tracker, router MessageRouter, streamManager StreamManager, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID, strictError bool) error {
 	myState := 0
 	if caller != uuid.Nil {
-		var dbState sql.NullInt64
-		query := "SELECT state FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID"
-		if err := db.QueryRowContext(ctx, query, groupID, caller).Scan(&dbState); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				logger.Info("Could not retrieve state as no group relationship exists.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
-				return runtime.ErrGroupPermissionDenied
-			}
-			logger.Error("Could not retrieve state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+		var granted bool
+		var err error
+		if myState, granted, err = groupCallerState(ctx, logger, db, groupID, caller, GroupPermissionKick); err != nil {
 			return err
 		}
 
-		myState = int(dbState.Int64)
-		if myState > 1 {
+		// Members granted the permission by a role kick like admins, only members, join requests and banned users.
+		if myState > 1 && !granted {
 			logger.Info("Cannot kick users as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int("state", myState))
 			return runtime.ErrGroupPermissionDenied
 		}
@@ -1052,6 +1107,13 @@ RETURNING state`
 					return err
 				}
 			}
+			if err := groupUserRoleRemove(ctx, logger, tx, groupID, uid); err != nil {
+				return err
+			}
+			// Record kicks of join requests and invites too, with the state the user was removed from.
+			if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventKick, caller, uid, map[string]any{"state": deletedState.Int64}); err != nil {
+				return err
+			}
 
 			// Only update group edge count and send messages when we kicked valid members, not invites.
 			if deletedState.Int64 < 3 {
@@ -1102,6 +1164,8 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 		return err
 	}
 
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
+
 	for _, message := range messages {
 		router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
 	}
@@ -1119,22 +1183,21 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 	return nil
 }
 
-func PromoteGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+func PromoteGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, router MessageRouter, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
 	myState := 0
+	// Highest state users may be promoted from, callers may not promote anyone past their own state.
+	maxState := api.GroupUserList_GroupUser_MEMBER
 	if caller != uuid.Nil {
-		var dbState sql.NullInt64
-		query := "SELECT state FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID"
-		if err := db.QueryRowContext(ctx, query, groupID, caller).Scan(&dbState); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				logger.Info("Could not retrieve state as no group relationship exists.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
-				return runtime.ErrGroupPermissionDenied
-			}
-			logger.Error("Could not retrieve state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+		var granted bool
+		var err error
+		if myState, granted, err = groupCallerState(ctx, logger, db, groupID, caller, GroupPermissionPromote); err != nil {
 			return err
 		}
 
-		myState = int(dbState.Int64)
-		if myState > 1 {
+		if granted {
+			// Members granted the permission by a role may only accept join requests.
+			maxState = api.GroupUserList_GroupUser_JOIN_REQUEST
+		} else if myState > 1 {
 			logger.Info("Cannot promote users as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int("state", myState))
 			return runtime.ErrGroupPermissionDenied
 		}
@@ -1184,7 +1247,7 @@ OR
 RETURNING state`
 
 			var newState sql.NullInt64
-			if err := tx.QueryRowContext(ctx, query, groupID, uid, myState, api.GroupUserList_GroupUser_MEMBER).Scan(&newState); err != nil {
+			if err := tx.QueryRowContext(ctx, query, groupID, uid, myState, maxState).Scan(&newState); err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
 					continue
 				}
@@ -1229,6 +1292,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 				logger.Debug("Could not insert group demote channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 				return err
 			}
+			if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventPromote, caller, uid, map[string]any{"state": newState.Int64}); err != nil {
+				return err
+			}
 			messages = append(messages, message)
 		}
 		return nil
@@ -1237,6 +1303,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 		return err
 	}
 
+	// Accepted join requests change the member count.
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
+
 	for _, message := range messages {
 		router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
 	}
@@ -1247,18 +1316,12 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 func DemoteGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
 	myState := 0
 	if caller != uuid.Nil {
-		var dbState sql.NullInt64
-		query := "SELECT state FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID"
-		if err := db.QueryRowContext(ctx, query, groupID, caller).Scan(&dbState); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				logger.Info("Could not retrieve state as no group relationship exists.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
-				return runtime.ErrGroupPermissionDenied
-			}
-			logger.Error("Could not retrieve state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+		var err error
+		// Roles never grant demotion, only admins and superadmins may demote.
+		if myState, _, err = groupCallerState(ctx, logger, db, groupID, caller, 0); err != nil {
 			return err
 		}
 
-		myState = int(dbState.Int64)
 		if myState > 1 {
 			logger.Info("Cannot demote users as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int("state", myState))
 			return runtime.ErrGroupPermissionDenied
@@ -1369,6 +1432,9 @@ VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
 				logger.Debug("Could not insert group demote channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 				return err
 			}
+			if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventDemote, caller, uid, map[string]any{"state": newState.Int64}); err != nil {
+				return err
+			}
 			messages = append(messages, message)
 		}
 		return nil
@@ -2000,7 +2066,7 @@ VALUES
 func groupUpdateUserState(ctx context.Context, db *sql.DB, tx *sql.Tx, groupID uuid.UUID, userID uuid.UUID, fromState int, toState int) (int64, error) {
 	query := `
 UPDATE group_edge SET
-	update_time = now(), state = $4
+	update_time = now(), state = $4, inviter_id = NULL, invite_expire_time = NULL
 WHERE
 	(source_id = $1::UUID AND destination_id = $2::UUID AND state = $3)
 OR
@@ -2082,6 +2148,9 @@ RETURNING state`
 			return err
 		}
 	}
+	if err := groupUserRoleRemove(ctx, logger, tx, groupID, userID); err != nil {
+		return err
+	}
 
 	if deletedState.Int64 < 3 {
 		query = "UPDATE groups SET edge_count = edge_count - 1, update_time = now() WHERE id = $1::UUID"
@@ -2095,7 +2164,9 @@ RETURNING state`
 	return nil
 }
 
-func GroupDeleteAll(ctx context.Context, logger *zap.Logger, tx *sql.Tx, userID uuid.UUID) error {
+// GroupDeleteAll removes a user from all their groups, deleting groups left without a superadmin. Returns the groups
+// changed or deleted.
+func GroupDeleteAll(ctx context.Context, logger *zap.Logger, tx *sql.Tx, userID uuid.UUID) ([]uuid.UUID, error) {
 	query := `
 SELECT id, edge_count, group_edge.state FROM groups
 JOIN group_edge ON (group_edge.source_id = id)
@@ -2104,7 +2175,7 @@ WHERE group_edge.destination_id = $1`
 	rows, err := tx.QueryContext(ctx, query, userID)
 	if err != nil {
 		logger.Debug("Could not list groups for a user.", zap.Error(err), zap.String("user_id", userID.String()))
-		return err
+		return nil, err
 	}
 
 	deleteGroupsAndRelationships := make([]uuid.UUID, 0, 5)
@@ -2119,7 +2190,7 @@ WHERE group_edge.destination_id = $1`
 		if err := rows.Scan(&id, &edgeCount, &userState); err != nil {
 			_ = rows.Close()
 			logger.Error("Could not parse rows when listing groups for a user.", zap.Error(err), zap.String("user_id", userID.String()))
-			return err
+			return nil, err
 		}
 
 		groupID := uuid.Must(uuid.FromString(id))
@@ -2141,7 +2212,7 @@ WHERE group_edge.destination_id = $1`
 		err := tx.QueryRowContext(ctx, countOtherSuperadminsQuery, g, userID).Scan(&otherSuperadminCount)
 		if err != nil {
 			logger.Error("Could not parse rows when listing other superadmins.", zap.Error(err), zap.String("group_id", g.String()), zap.String("user_id", userID.String()))
-			return err
+			return nil, err
 		}
 
 		if otherSuperadminCount.Int64 == 0 {
@@ -2153,18 +2224,18 @@ WHERE group_edge.destination_id = $1`
 
 	for _, g := range deleteGroupsAndRelationships {
 		if err := deleteGroup(ctx, logger, tx, g); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	for _, g := range deleteRelationships {
 		err := deleteRelationship(ctx, logger, tx, userID, g)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return append(deleteGroupsAndRelationships, deleteRelationships...), nil
 }
 
 func GetRandomGroups(ctx context.Context, logger *zap.Logger, db *sql.DB, count int) ([]*api.Group, error) {
@@ -0,0 +1,162 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Types of events recorded in a group's activity feed.
+const (
+	GroupEventJoin        = "join"
+	GroupEventJoinRequest = "join_request"
+	GroupEventLeave       = "leave"
+	GroupEventAdd         = "add"
+	GroupEventKick        = "kick"
+	GroupEventBan         = "ban"
+	GroupEventPromote     = "promote"
+	GroupEventDemote      = "demote"
+	GroupEventUpdate      = "update"
+)
+
+type groupEventListCursor struct {
+	GroupID    string
+	CreateTime int64
+	ID         string
+}
+
+// Record an action taken in a group as part of the transaction performing it. The actor is uuid.Nil for actions
+// taken by the server, and the target is uuid.Nil for events about the group itself.
+func groupEventInsert(ctx context.Context, logger *zap.Logger, tx *sql.Tx, groupID uuid.UUID, eventType string, actorID, targetID uuid.UUID, data map[string]any) error {
+	dataJSON := []byte("{}")
+	if len(data) > 0 {
+		var err error
+		if dataJSON, err = json.Marshal(data); err != nil {
+			return err
+		}
+	}
+
+	query := "INSERT INTO group_event (id, group_id, type, actor_id, target_id, data) VALUES ($1, $2, $3, $4, $5, $6)"
+	if _, err := tx.ExecContext(ctx, query, uuid.Must(uuid.NewV4()), groupID, eventType, actorID, targetID, dataJSON); err != nil {
+		logger.Debug("Could not insert group event.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("type", eventType))
+		return err
+	}
+	return nil
+}
+
+// GroupEventsList lists the activity feed of a group, newest first.
+func GroupEventsList(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID, limit int, cursor string) (*apigrpc.GroupEventList, error) {
+	var incomingCursor *groupEventListCursor
+	if cursor != "" {
+		cb, err := base64.URLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, runtime.ErrGroupUserInvalidCursor
+		}
+		incomingCursor = &groupEventListCursor{}
+		if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(incomingCursor); err != nil {
+			return nil, runtime.ErrGroupUserInvalidCursor
+		}
+		if incomingCursor.GroupID != groupID.String() {
+			// Cursor is for a different group.
+			return nil, runtime.ErrGroupUserInvalidCursor
+		}
+	}
+
+	query := "SELECT id, type, actor_id, target_id, data, create_time FROM group_event WHERE group_id = $1"
+	params := []interface{}{groupID, limit + 1}
+	if incomingCursor != nil {
+		query += " AND (create_time, id) < ($3, $4)"
+		params = append(params, time.Unix(0, incomingCursor.CreateTime).UTC(), incomingCursor.ID)
+	}
+	query += " ORDER BY create_time DESC, id DESC LIMIT $2"
+
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Could not list group events.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*apigrpc.GroupEvent, 0, limit)
+	var nextCursor *groupEventListCursor
+	var dbID uuid.UUID
+	var dbCreateTime pgtype.Timestamptz
+	for rows.Next() {
+		if len(events) >= limit {
+			nextCursor = &groupEventListCursor{
+				GroupID:    groupID.String(),
+				CreateTime: dbCreateTime.Time.UnixNano(),
+				ID:         dbID.String(),
+			}
+			break
+		}
+
+		var dbType string
+		var dbActorID uuid.UUID
+		var dbTargetID uuid.UUID
+		var dbData string
+		if err := rows.Scan(&dbID, &dbType, &dbActorID, &dbTargetID, &dbData, &dbCreateTime); err != nil {
+			logger.Error("Could not parse listed group events.", zap.Error(err), zap.String("group_id", groupID.String()))
+			return nil, err
+		}
+
+		event := &apigrpc.GroupEvent{
+			Id:         dbID.String(),
+			GroupId:    groupID.String(),
+			Type:       dbType,
+			Data:       dbData,
+			CreateTime: &timestamppb.Timestamp{Seconds: dbCreateTime.Time.Unix()},
+		}
+		if dbActorID != uuid.Nil {
+			event.ActorId = dbActorID.String()
+		}
+		if dbTargetID != uuid.Nil {
+			event.TargetId = dbTargetID.String()
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not list group events.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+
+	var nextCursorStr string
+	if nextCursor != nil {
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(nextCursor); err != nil {
+			logger.Error("Could not create group event list cursor.", zap.Error(err))
+			return nil, err
+		}
+		nextCursorStr = base64.URLEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	return &apigrpc.GroupEventList{
+		Events: events,
+		Cursor: nextCursorStr,
+	}, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupEventsList(t *testing.T) {
+	ctx := context.Background()
+	logger := loggerForTest(t)
+	db := NewDB(t)
+	defer db.Close()
+	groupIndex, err := NewLocalGroupIndex(logger, db)
+	require.NoError(t, err)
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	owner, member, requester, invitee := newUser(), newUser(), newUser(), newUser()
+
+	group, err := CreateGroup(ctx, logger, db, groupIndex, owner, owner, uuid.Must(uuid.NewV4()).String(), "en", "", "", "", false, 100)
+	require.NoError(t, err)
+	groupID := uuid.Must(uuid.FromString(group.Id))
+
+	require.NoError(t, AddGroupUsers(ctx, logger, db, groupIndex, tracker, router, owner, groupID, []uuid.UUID{member}))
+	require.NoError(t, JoinGroup(ctx, logger, db, groupIndex, tracker, router, groupID, requester, requester.String()))
+	require.NoError(t, GroupInvitesSend(ctx, logger, db, tracker, router, &GroupConfig{InviteExpirySec: 3600}, owner, groupID, []uuid.UUID{invitee}))
+
+	// Removing join requests and invites is recorded as well as removing members.
+	require.NoError(t, KickGroupUsers(ctx, logger, db, groupIndex, tracker, router, nil, owner, groupID, []uuid.UUID{requester}, false))
+	require.NoError(t, BanGroupUsers(ctx, logger, db, groupIndex, tracker, router, nil, owner, groupID, []uuid.UUID{invitee}))
+	require.NoError(t, KickGroupUsers(ctx, logger, db, groupIndex, tracker, router, nil, owner, groupID, []uuid.UUID{member}, false))
+
+	events := make([]*apigrpc.GroupEvent, 0)
+	var cursor string
+	for {
+		list, err := GroupEventsList(ctx, logger, db, groupID, 2, cursor)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(list.Events), 2)
+		events = append(events, list.Events...)
+		if cursor = list.Cursor; cursor == "" {
+			break
+		}
+	}
+
+	type event struct {
+		Type   string
+		Actor  string
+		Target string
+		Data   string
+	}
+	expected := []event{
+		{GroupEventKick, owner.String(), member.String(), `{"state": 2}`},
+		{GroupEventBan, owner.String(), invitee.String(), `{"state": 5}`},
+		{GroupEventKick, owner.String(), requester.String(), `{"state": 3}`},
+		{GroupEventJoinRequest, requester.String(), requester.String(), "{}"},
+		{GroupEventAdd, owner.String(), member.String(), "{}"},
+	}
+	require.Len(t, events, len(expected))
+	for i, e := range events {
+		assert.Equal(t, expected[i].Type, e.Type)
+		assert.Equal(t, expected[i].Actor, e.ActorId)
+		assert.Equal(t, expected[i].Target, e.TargetId)
+		assert.JSONEq(t, expected[i].Data, e.Data)
+		assert.Equal(t, group.Id, e.GroupId)
+	}
+
+	list, err := GroupEventsList(ctx, logger, db, groupID, 2, "")
+	require.NoError(t, err)
+	_, err = GroupEventsList(ctx, logger, db, uuid.Must(uuid.NewV4()), 2, list.Cursor)
+	assert.Equal(t, runtime.ErrGroupUserInvalidCursor, err, "cursors belong to one group")
+}
@@ -0,0 +1,462 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	errGroupInviteNotFound = errors.New("group invite not found")
+	errGroupInviteLimit    = errors.New("group has too many pending invites")
+)
+
+type groupInviteListCursor struct {
+	SourceID string
+	Position int64
+}
+
+// GroupInvitesSend invites users to join a group. Users who already have a relationship with the group, as members,
+// join requests, banned users or with a pending invite, are skipped.
+func GroupInvitesSend(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, router MessageRouter, config *GroupConfig, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+	if caller != uuid.Nil {
+		myState, granted, err := groupCallerState(ctx, logger, db, groupID, caller, GroupPermissionInvite)
+		if err != nil {
+			return err
+		}
+
+		if myState > 1 && !granted {
+			logger.Info("Cannot invite users as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int("state", myState))
+			return runtime.ErrGroupPermissionDenied
+		}
+	}
+
+	var groupName sql.NullString
+	query := "SELECT name FROM groups WHERE id = $1 AND disable_time = '1970-01-01 00:00:00 UTC'"
+	if err := db.QueryRowContext(ctx, query, groupID).Scan(&groupName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("Cannot invite users to disabled group.", zap.String("group_id", groupID.String()))
+			return runtime.ErrGroupNotFound
+		}
+		logger.Error("Could not look up group when inviting users.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return err
+	}
+
+	var inviterID interface{}
+	if caller != uuid.Nil {
+		inviterID = caller
+	}
+	expireTime := time.Now().UTC().Add(time.Duration(config.InviteExpirySec) * time.Second)
+	var invited []uuid.UUID
+
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		// If the transaction is retried ensure we wipe any invites that may have been recorded by previous attempts.
+		invited = make([]uuid.UUID, 0, len(userIDs))
+
+		// Expired invites no longer count towards the limit, and must not block new invites to the same users.
+		if err := groupInvitesDeleteExpired(ctx, logger, tx, groupID); err != nil {
+			return err
+		}
+
+		var pending int
+		if config.MaxPendingInvites > 0 {
+			query := "SELECT count(*) FROM group_edge WHERE source_id = $1::UUID AND state = $2"
+			if err := tx.QueryRowContext(ctx, query, groupID, INVITED_CODE).Scan(&pending); err != nil {
+				logger.Debug("Could not count pending group invites.", zap.Error(err), zap.String("group_id", groupID.String()))
+				return err
+			}
+		}
+
+		// Position to use for new invite edges, unique per invite within the group.
+		position := time.Now().UTC().UnixNano()
+
+		for _, uid := range userIDs {
+			if uid == caller {
+				continue
+			}
+
+			var userExists sql.NullBool
+			query := "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1::UUID)"
+			if err := tx.QueryRowContext(ctx, query, uid).Scan(&userExists); err != nil {
+				logger.Debug("Could not look up user to invite to group.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
+				return err
+			}
+			if !userExists.Bool {
+				return runtime.ErrGroupUserNotFound
+			}
+
+			var edgeExists sql.NullBool
+			query = "SELECT EXISTS(SELECT 1 FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID)"
+			if err := tx.QueryRowContext(ctx, query, groupID, uid).Scan(&edgeExists); err != nil {
+				logger.Debug("Could not retrieve user state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
+				return err
+			}
+			if edgeExists.Bool {
+				continue
+			}
+
+			if config.MaxPendingInvites > 0 && pending >= config.MaxPendingInvites {
+				logger.Info("Could not invite users as group has too many pending invites.", zap.String("group_id", groupID.String()), zap.Int("pending", pending))
+				return errGroupInviteLimit
+			}
+
+			query = `
+INSERT INTO group_edge
+	(position, state, source_id, destination_id, inviter_id, invite_expire_time)
+VALUES
+	($1, $2, $3, $4, $5, $6),
+	($1, $2, $4, $3, $5, $6)`
+			if _, err := tx.ExecContext(ctx, query, position, INVITED_CODE, groupID, uid, inviterID, expireTime); err != nil {
+				logger.Debug("Could not add group invite.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
+				return err
+			}
+
+			position++
+			pending++
+			invited = append(invited, uid)
+		}
+		return nil
+	}); err != nil {
+		if err != runtime.ErrGroupUserNotFound && err != errGroupInviteLimit {
+			logger.Error("Error inviting users to group.", zap.Error(err), zap.String("group_id", groupID.String()))
+		}
+		return err
+	}
+
+	if len(invited) == 0 {
+		return nil
+	}
+
+	notificationContentBytes, err := json.Marshal(map[string]any{"group_id": groupID.String(), "name": groupName.String, "expire_time": expireTime.Unix()})
+	if err != nil {
+		// The invites are already persisted, only the notifications are lost.
+		logger.Error("Could not encode notification content.", zap.Error(err))
+		return nil
+	}
+	notificationContent := string(notificationContentBytes)
+	notificationSubject := fmt.Sprintf("You've been invited to group %v", groupName.String)
+	notifications := make(map[uuid.UUID][]*api.Notification, len(invited))
+	for _, uid := range invited {
+		notifications[uid] = []*api.Notification{
+			{
+				Id:         uuid.Must(uuid.NewV4()).String(),
+				Subject:    notificationSubject,
+				Content:    notificationContent,
+				SenderId:   caller.String(),
+				Code:       NotificationCodeGroupInvite,
+				Persistent: true,
+				CreateTime: &timestamppb.Timestamp{Seconds: time.Now().UTC().Unix()},
+			},
+		}
+	}
+	// Any error is already logged before it's returned here.
+	_ = NotificationSend(ctx, logger, db, tracker, router, notifications)
+
+	return nil
+}
+
+// GroupInviteAccept makes the invited user a member of the group, as long as the invite has not expired.
+func GroupInviteAccept(ctx context.Context, logger *zap.Logger, db *sql.DB, groupIndex GroupIndex, router MessageRouter, groupID uuid.UUID, userID uuid.UUID, username string) error {
+	var groupExists sql.NullBool
+	query := "SELECT EXISTS (SELECT id FROM groups WHERE id = $1 AND disable_time = '1970-01-01 00:00:00 UTC')"
+	if err := db.QueryRowContext(ctx, query, groupID).Scan(&groupExists); err != nil {
+		logger.Error("Could not look up group when accepting invite.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return err
+	}
+	if !groupExists.Bool {
+		logger.Info("Cannot accept invite to a disabled group.", zap.String("group_id", groupID.String()))
+		return runtime.ErrGroupNotFound
+	}
+
+	// Prepare the message we'll need to send to the group channel.
+	stream := PresenceStream{
+		Mode:    StreamModeGroup,
+		Subject: groupID,
+	}
+	channelID, err := StreamToChannelId(stream)
+	if err != nil {
+		logger.Error("Could not create channel ID.", zap.Error(err))
+		return err
+	}
+	ts := time.Now().Unix()
+	message := &api.ChannelMessage{
+		ChannelId:  channelID,
+		MessageId:  uuid.Must(uuid.NewV4()).String(),
+		Code:       &wrapperspb.Int32Value{Value: ChannelMessageTypeGroupJoin},
+		SenderId:   userID.String(),
+		Username:   username,
+		Content:    "{}",
+		CreateTime: &timestamppb.Timestamp{Seconds: ts},
+		UpdateTime: &timestamppb.Timestamp{Seconds: ts},
+		Persistent: &wrapperspb.BoolValue{Value: true},
+		GroupId:    groupID.String(),
+	}
+
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		query := `
+UPDATE group_edge SET
+	update_time = now(), state = $3, inviter_id = NULL, invite_expire_time = NULL
+WHERE
+	((source_id = $1::UUID AND destination_id = $2::UUID) OR (source_id = $2::UUID AND destination_id = $1::UUID))
+AND
+	state = $4 AND invite_expire_time > now()`
+		res, err := tx.ExecContext(ctx, query, groupID, userID, api.GroupUserList_GroupUser_MEMBER, INVITED_CODE)
+		if err != nil {
+			logger.Debug("Could not update user state in group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+			return err
+		}
+		if rowsAffected, err := res.RowsAffected(); err != nil {
+			logger.Debug("Could not count accepted group invites.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+			return err
+		} else if rowsAffected != 2 {
+			return errGroupInviteNotFound
+		}
+
+		if err := incrementGroupEdge(ctx, logger, tx, userID, groupID); err != nil {
+			return err
+		}
+
+		query = `INSERT INTO message (id, code, sender_id, username, stream_mode, stream_subject, stream_descriptor, stream_label, content, create_time, update_time)
+VALUES ($1, $2, $3, $4, $5, $6::UUID, $7::UUID, $8, $9, $10, $10)`
+		if _, err := tx.ExecContext(ctx, query, message.MessageId, message.Code.Value, message.SenderId, message.Username, stream.Mode, stream.Subject, stream.Subcontext, stream.Label, message.Content, time.Unix(message.CreateTime.Seconds, 0).UTC()); err != nil {
+			logger.Debug("Could not insert group join channel message.", zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+			return err
+		}
+		if err := groupEventInsert(ctx, logger, tx, groupID, GroupEventJoin, userID, userID, map[string]any{"invite": true}); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		if err != errGroupInviteNotFound && err != runtime.ErrGroupFull {
+			logger.Error("Error accepting group invite.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+		}
+		return err
+	}
+
+	groupIndex.Refresh(ctx, []uuid.UUID{groupID})
+
+	router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: message}}, true)
+
+	logger.Info("Accepted group invite.", zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+	return nil
+}
+
+// GroupInviteDecline removes the invite for a user to join a group.
+func GroupInviteDecline(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID, userID uuid.UUID) error {
+	query := "DELETE FROM group_edge WHERE ((source_id = $1::UUID AND destination_id = $2::UUID) OR (source_id = $2::UUID AND destination_id = $1::UUID)) AND state = $3"
+	res, err := db.ExecContext(ctx, query, groupID, userID, INVITED_CODE)
+	if err != nil {
+		logger.Error("Could not decline group invite.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+		return err
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected == 0 {
+		return errGroupInviteNotFound
+	}
+
+	return nil
+}
+
+// GroupInvitesRevoke removes pending invites sent by a group. Users without a pending invite are ignored.
+func GroupInvitesRevoke(ctx context.Context, logger *zap.Logger, db *sql.DB, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+	if caller != uuid.Nil {
+		myState, granted, err := groupCallerState(ctx, logger, db, groupID, caller, GroupPermissionInvite)
+		if err != nil {
+			return err
+		}
+
+		if myState > 1 && !granted {
+			logger.Info("Cannot revoke invites as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int("state", myState))
+			return runtime.ErrGroupPermissionDenied
+		}
+	}
+
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		query := "DELETE FROM group_edge WHERE ((source_id = $1::UUID AND destination_id = $2::UUID) OR (source_id = $2::UUID AND destination_id = $1::UUID)) AND state = $3"
+		for _, uid := range userIDs {
+			if _, err := tx.ExecContext(ctx, query, groupID, uid, INVITED_CODE); err != nil {
+				logger.Debug("Could not revoke group invite.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		logger.Error("Error revoking group invites.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return err
+	}
+
+	return nil
+}
+
+// GroupInvitesList lists pending invites received by a user.
+func GroupInvitesList(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, limit int, cursor string) (*apigrpc.GroupInviteList, error) {
+	return groupInvitesList(ctx, logger, db, userID, false, limit, cursor)
+}
+
+// GroupUserInvitesList lists pending invites sent by a group.
+func GroupUserInvitesList(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID, limit int, cursor string) (*apigrpc.GroupInviteList, error) {
+	return groupInvitesList(ctx, logger, db, groupID, true, limit, cursor)
+}
+
+func groupInvitesList(ctx context.Context, logger *zap.Logger, db *sql.DB, sourceID uuid.UUID, sourceIsGroup bool, limit int, cursor string) (*apigrpc.GroupInviteList, error) {
+	var incomingCursor *groupInviteListCursor
+	if cursor != "" {
+		cb, err := base64.URLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, runtime.ErrGroupUserInvalidCursor
+		}
+		incomingCursor = &groupInviteListCursor{}
+		if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(incomingCursor); err != nil {
+			return nil, runtime.ErrGroupUserInvalidCursor
+		}
+		if incomingCursor.SourceID != sourceID.String() {
+			// Cursor is for a different user or group.
+			return nil, runtime.ErrGroupUserInvalidCursor
+		}
+	}
+
+	groupJoin := "g.id = ge.destination_id"
+	if sourceIsGroup {
+		groupJoin = "g.id = ge.source_id"
+	}
+	query := `
+SELECT g.id, g.creator_id, g.name, g.description, g.avatar_url, g.state, g.edge_count, g.lang_tag, g.max_count, g.metadata, g.create_time, g.update_time,
+	ge.destination_id, ge.inviter_id, ge.update_time, ge.invite_expire_time, ge.position
+FROM group_edge ge JOIN groups g ON ` + groupJoin + `
+WHERE ge.source_id = $1::UUID AND ge.state = $2 AND ge.invite_expire_time > now() AND g.disable_time = '1970-01-01 00:00:00 UTC'`
+	params := []interface{}{sourceID, INVITED_CODE, limit + 1}
+	if incomingCursor != nil {
+		query += " AND ge.position > $4"
+		params = append(params, incomingCursor.Position)
+	}
+	query += " ORDER BY ge.position ASC LIMIT $3"
+
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Could not list group invites.", zap.Error(err), zap.String("source_id", sourceID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	invites := make([]*apigrpc.GroupInvite, 0, limit)
+	var nextCursor *groupInviteListCursor
+	var lastPosition int64
+	for rows.Next() {
+		if len(invites) >= limit {
+			nextCursor = &groupInviteListCursor{
+				SourceID: sourceID.String(),
+				Position: lastPosition,
+			}
+			break
+		}
+
+		s := groupSqlStruct{}
+		var dbDestinationID uuid.UUID
+		var dbInviterID uuid.NullUUID
+		var dbCreateTime pgtype.Timestamptz
+		var dbExpireTime pgtype.Timestamptz
+		if err := rows.Scan(&s.id, &s.creatorID, &s.name, &s.description, &s.avatarURL, &s.state, &s.edgeCount, &s.lang, &s.maxCount, &s.metadata, &s.createTime, &s.updateTime,
+			&dbDestinationID, &dbInviterID, &dbCreateTime, &dbExpireTime, &lastPosition); err != nil {
+			logger.Error("Could not parse listed group invites.", zap.Error(err), zap.String("source_id", sourceID.String()))
+			return nil, err
+		}
+
+		group, _ := sqlMapper(&s)
+		invite := &apigrpc.GroupInvite{
+			Group:      group,
+			UserId:     sourceID.String(),
+			CreateTime: &timestamppb.Timestamp{Seconds: dbCreateTime.Time.Unix()},
+			ExpireTime: &timestamppb.Timestamp{Seconds: dbExpireTime.Time.Unix()},
+		}
+		if sourceIsGroup {
+			invite.UserId = dbDestinationID.String()
+		}
+		if dbInviterID.Valid {
+			invite.InviterId = dbInviterID.UUID.String()
+		}
+		invites = append(invites, invite)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not list group invites.", zap.Error(err), zap.String("source_id", sourceID.String()))
+		return nil, err
+	}
+
+	var nextCursorStr string
+	if nextCursor != nil {
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(nextCursor); err != nil {
+			logger.Error("Could not create group invite list cursor.", zap.Error(err))
+			return nil, err
+		}
+		nextCursorStr = base64.URLEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	return &apigrpc.GroupInviteList{
+		Invites: invites,
+		Cursor:  nextCursorStr,
+	}, nil
+}
+
+// Report whether a user has a pending invite to a group, removing it first if it has expired.
+func groupInvitePending(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID, userID uuid.UUID) (bool, error) {
+	query := "DELETE FROM group_edge WHERE ((source_id = $1::UUID AND destination_id = $2::UUID) OR (source_id = $2::UUID AND destination_id = $1::UUID)) AND state = $3 AND invite_expire_time <= now()"
+	if _, err := db.ExecContext(ctx, query, groupID, userID, INVITED_CODE); err != nil {
+		logger.Error("Could not remove expired group invite.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+		return false, err
+	}
+
+	var pending sql.NullBool
+	query = "SELECT EXISTS(SELECT 1 FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID AND state = $3)"
+	if err := db.QueryRowContext(ctx, query, groupID, userID, INVITED_CODE).Scan(&pending); err != nil {
+		logger.Error("Could not look up pending group invite.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+		return false, err
+	}
+
+	return pending.Bool, nil
+}
+
+// Remove expired invites, in both directions, for all edges of the given group or user.
+func groupInvitesDeleteExpired(ctx context.Context, logger *zap.Logger, tx *sql.Tx, sourceID uuid.UUID) error {
+	query := `
+DELETE FROM group_edge
+WHERE state = $2 AND (source_id, destination_id) IN (
+	SELECT source_id, destination_id FROM group_edge WHERE source_id = $1::UUID AND state = $2 AND invite_expire_time <= now()
+	UNION ALL
+	SELECT destination_id, source_id FROM group_edge WHERE source_id = $1::UUID AND state = $2 AND invite_expire_time <= now()
+)`
+	if _, err := tx.ExecContext(ctx, query, sourceID, INVITED_CODE); err != nil {
+		logger.Debug("Could not remove expired group invites.", zap.Error(err), zap.String("source_id", sourceID.String()))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupInvites(t *testing.T) {
+	ctx := context.Background()
+	logger := loggerForTest(t)
+	db := NewDB(t)
+	defer db.Close()
+	groupIndex, err := NewLocalGroupIndex(logger, db)
+	require.NoError(t, err)
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+	config := &GroupConfig{InviteExpirySec: 3600, MaxPendingInvites: 2}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	owner, accepted, declined, revoked, expired, joined, added := newUser(), newUser(), newUser(), newUser(), newUser(), newUser(), newUser()
+
+	group, err := CreateGroup(ctx, logger, db, groupIndex, owner, owner, uuid.Must(uuid.NewV4()).String(), "en", "", "", "", false, 100)
+	require.NoError(t, err)
+	groupID := uuid.Must(uuid.FromString(group.Id))
+
+	state := func(userID uuid.UUID) int {
+		var state int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT state FROM group_edge WHERE source_id = $1 AND destination_id = $2", groupID, userID).Scan(&state))
+		return state
+	}
+	edges := func(userID uuid.UUID) int {
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM group_edge WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", groupID, userID).Scan(&count))
+		return count
+	}
+	expire := func(userID uuid.UUID) {
+		_, err := db.ExecContext(ctx, "UPDATE group_edge SET invite_expire_time = now() - INTERVAL '1 minute' WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", groupID, userID)
+		require.NoError(t, err)
+	}
+
+	// Send, with the pending limit applying to new invites only.
+	require.NoError(t, GroupInvitesSend(ctx, logger, db, tracker, router, config, owner, groupID, []uuid.UUID{accepted, declined}))
+	assert.Equal(t, INVITED_CODE, state(accepted))
+	require.NoError(t, GroupInvitesSend(ctx, logger, db, tracker, router, config, owner, groupID, []uuid.UUID{accepted}), "existing invites are skipped")
+	err = GroupInvitesSend(ctx, logger, db, tracker, router, config, owner, groupID, []uuid.UUID{revoked})
+	assert.Equal(t, errGroupInviteLimit, err)
+	assert.Zero(t, edges(revoked))
+
+	invites, err := GroupUserInvitesList(ctx, logger, db, groupID, 10, "")
+	require.NoError(t, err)
+	assert.Len(t, invites.Invites, 2)
+	invites, err = GroupInvitesList(ctx, logger, db, accepted, 10, "")
+	require.NoError(t, err)
+	require.Len(t, invites.Invites, 1)
+	assert.Equal(t, group.Id, invites.Invites[0].Group.Id)
+	assert.Equal(t, owner.String(), invites.Invites[0].InviterId)
+
+	// Accept and decline, each only once.
+	require.NoError(t, GroupInviteAccept(ctx, logger, db, groupIndex, router, groupID, accepted, accepted.String()))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), state(accepted))
+	assert.Equal(t, errGroupInviteNotFound, GroupInviteAccept(ctx, logger, db, groupIndex, router, groupID, accepted, accepted.String()))
+	require.NoError(t, GroupInviteDecline(ctx, logger, db, groupID, declined))
+	assert.Zero(t, edges(declined))
+	assert.Equal(t, errGroupInviteNotFound, GroupInviteDecline(ctx, logger, db, groupID, declined))
+
+	// Members need the invite permission.
+	err = GroupInvitesSend(ctx, logger, db, tracker, router, config, accepted, groupID, []uuid.UUID{revoked})
+	assert.Equal(t, runtime.ErrGroupPermissionDenied, err)
+
+	// Revoke.
+	require.NoError(t, GroupInvitesSend(ctx, logger, db, tracker, router, config, owner, groupID, []uuid.UUID{revoked, expired}))
+	require.NoError(t, GroupInvitesRevoke(ctx, logger, db, owner, groupID, []uuid.UUID{revoked}))
+	assert.Zero(t, edges(revoked))
+
+	// Expired invites can't be accepted, aren't listed, don't count towards the limit and don't block a new invite.
+	expire(expired)
+	assert.Equal(t, errGroupInviteNotFound, GroupInviteAccept(ctx, logger, db, groupIndex, router, groupID, expired, expired.String()))
+	invites, err = GroupInvitesList(ctx, logger, db, expired, 10, "")
+	require.NoError(t, err)
+	assert.Empty(t, invites.Invites)
+	require.NoError(t, GroupInvitesSend(ctx, logger, db, tracker, router, config, uuid.Nil, groupID, []uuid.UUID{expired, joined}))
+	assert.Equal(t, INVITED_CODE, state(expired))
+
+	// Joining a closed group with an expired invite only requests to join.
+	expire(expired)
+	require.NoError(t, JoinGroup(ctx, logger, db, groupIndex, tracker, router, groupID, expired, expired.String()))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_JOIN_REQUEST), state(expired))
+	require.NoError(t, GroupInvitesSend(ctx, logger, db, tracker, router, config, owner, groupID, []uuid.UUID{added}))
+
+	// Joining or being added with a pending invite accepts it.
+	require.NoError(t, JoinGroup(ctx, logger, db, groupIndex, tracker, router, groupID, joined, joined.String()))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), state(joined))
+	require.NoError(t, AddGroupUsers(ctx, logger, db, groupIndex, tracker, router, owner, groupID, []uuid.UUID{added}))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), state(added))
+
+	invites, err = GroupUserInvitesList(ctx, logger, db, groupID, 10, "")
+	require.NoError(t, err)
+	assert.Empty(t, invites.Invites)
+
+	var edgeCount int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT edge_count FROM groups WHERE id = $1", groupID).Scan(&edgeCount))
+	assert.Equal(t, 4, edgeCount)
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// GroupPermission is a set of actions a custom group role allows its members to perform. Superadmins and admins
+// are always allowed every action, roles only extend what regular members may do.
+type GroupPermission uint32
+
+const (
+	GroupPermissionKick GroupPermission = 1 << iota
+	GroupPermissionBan
+	GroupPermissionInvite
+	GroupPermissionPromote
+	GroupPermissionEditMetadata
+	GroupPermissionManageChat
+	// Not enforced by the server, which holds no group wallets. Runtime code managing a group wallet checks it with
+	// GroupUserPermissionCheck before spending.
+	GroupPermissionSpendWallet
+)
+
+var groupPermissionNames = []struct {
+	permission GroupPermission
+	name       string
+}{
+	{GroupPermissionKick, "kick"},
+	{GroupPermissionBan, "ban"},
+	{GroupPermissionInvite, "invite"},
+	{GroupPermissionPromote, "promote"},
+	{GroupPermissionEditMetadata, "edit_metadata"},
+	{GroupPermissionManageChat, "manage_chat"},
+	{GroupPermissionSpendWallet, "spend_wallet"},
+}
+
+var (
+	errGroupRoleNotFound    = errors.New("group role not found")
+	errGroupRoleNameInvalid = errors.New("group role name must be 1-64 characters")
+)
+
+// GroupPermissionParse converts a single permission name to its GroupPermission value.
+func GroupPermissionParse(name string) (GroupPermission, error) {
+	for _, p := range groupPermissionNames {
+		if p.name == name {
+			return p.permission, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown group permission: %q", name)
+}
+
+// GroupPermissionsParse converts a list of permission names to a combined GroupPermission set.
+func GroupPermissionsParse(names []string) (GroupPermission, error) {
+	var permissions GroupPermission
+	for _, name := range names {
+		p, err := GroupPermissionParse(name)
+		if err != nil {
+			return 0, err
+		}
+		permissions |= p
+	}
+	return permissions, nil
+}
+
+// Names returns the names of all permissions in the set, in a stable order.
+func (p GroupPermission) Names() []string {
+	names := make([]string, 0, len(groupPermissionNames))
+	for _, n := range groupPermissionNames {
+		if p&n.permission != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return names
+}
+
+type GroupRole struct {
+	Name        string
+	Permissions GroupPermission
+	CreateTime  time.Time
+	UpdateTime  time.Time
+}
+
+type GroupUserRole struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+// GroupRoleSet creates a custom role in a group, or replaces the permissions of an existing role with the same name.
+func GroupRoleSet(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID, name string, permissions GroupPermission) error {
+	if l := utf8.RuneCountInString(name); l < 1 || l > 64 {
+		return errGroupRoleNameInvalid
+	}
+
+	query := `
+INSERT INTO group_role (group_id, name, permissions)
+VALUES ($1, $2, $3)
+ON CONFLICT (group_id, name)
+DO UPDATE SET permissions = $3, update_time = now()`
+	if _, err := db.ExecContext(ctx, query, groupID, name, int64(permissions)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == dbErrorForeignKeyViolation {
+			return runtime.ErrGroupNotFound
+		}
+		logger.Error("Could not set group role.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("role", name))
+		return err
+	}
+
+	return nil
+}
+
+// GroupRoleDelete removes a custom role from a group, unassigning it from any users that held it.
+func GroupRoleDelete(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID, name string) error {
+	query := "DELETE FROM group_role WHERE group_id = $1 AND name = $2"
+	if _, err := db.ExecContext(ctx, query, groupID, name); err != nil {
+		logger.Error("Could not delete group role.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("role", name))
+		return err
+	}
+
+	return nil
+}
+
+// GroupRolesList lists all custom roles defined in a group, ordered by name.
+func GroupRolesList(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID) ([]*GroupRole, error) {
+	query := "SELECT name, permissions, create_time, update_time FROM group_role WHERE group_id = $1 ORDER BY name"
+	rows, err := db.QueryContext(ctx, query, groupID)
+	if err != nil {
+		logger.Error("Could not list group roles.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]*GroupRole, 0, 10)
+	for rows.Next() {
+		var dbPermissions int64
+		var dbCreateTime pgtype.Timestamptz
+		var dbUpdateTime pgtype.Timestamptz
+		role := &GroupRole{}
+		if err := rows.Scan(&role.Name, &dbPermissions, &dbCreateTime, &dbUpdateTime); err != nil {
+			logger.Error("Could not parse listed group roles.", zap.Error(err), zap.String("group_id", groupID.String()))
+			return nil, err
+		}
+		role.Permissions = GroupPermission(dbPermissions)
+		role.CreateTime = dbCreateTime.Time
+		role.UpdateTime = dbUpdateTime.Time
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not list group roles.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// GroupUserRoleAssign gives a group member a custom role, replacing any role they already held. Only members,
+// admins and superadmins can hold roles, not join requests or banned users.
+func GroupUserRoleAssign(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID, userID uuid.UUID, role string) error {
+	query := `
+INSERT INTO group_user_role (group_id, user_id, role)
+SELECT $1::UUID, $2::UUID, $3
+FROM group_edge
+WHERE source_id = $1::UUID AND destination_id = $2::UUID AND state <= $4
+ON CONFLICT (group_id, user_id)
+DO UPDATE SET role = $3, create_time = now()`
+	res, err := db.ExecContext(ctx, query, groupID, userID, role, api.GroupUserList_GroupUser_MEMBER)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == dbErrorForeignKeyViolation {
+			return errGroupRoleNotFound
+		}
+		logger.Error("Could not assign group role.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()), zap.String("role", role))
+		return err
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected == 0 {
+		return runtime.ErrGroupUserNotFound
+	}
+
+	return nil
+}
+
+// GroupUserRoleUnassign removes any custom role held by a user in a group.
+func GroupUserRoleUnassign(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID, userID uuid.UUID) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM group_user_role WHERE group_id = $1 AND user_id = $2", groupID, userID); err != nil {
+		logger.Error("Could not unassign group role.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+		return err
+	}
+
+	return nil
+}
+
+// GroupUserRolesList lists the custom roles assigned to users in a group.
+func GroupUserRolesList(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID uuid.UUID) ([]*GroupUserRole, error) {
+	rows, err := db.QueryContext(ctx, "SELECT user_id, role FROM group_user_role WHERE group_id = $1 ORDER BY role, user_id", groupID)
+	if err != nil {
+		logger.Error("Could not list group user roles.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	userRoles := make([]*GroupUserRole, 0, 10)
+	for rows.Next() {
+		userRole := &GroupUserRole{}
+		if err := rows.Scan(&userRole.UserID, &userRole.Role); err != nil {
+			logger.Error("Could not parse listed group user roles.", zap.Error(err), zap.String("group_id", groupID.String()))
+			return nil, err
+		}
+		userRoles = append(userRoles, userRole)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not list group user roles.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+
+	return userRoles, nil
+}
+
+// GroupUserPermissionCheck reports whether a user may perform an action in a group, either because they are an
+// admin or superadmin, or because they are a member holding a role that grants the permission.
+func GroupUserPermissionCheck(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID, userID uuid.UUID, permission GroupPermission) (bool, error) {
+	state, granted, err := groupCallerState(ctx, logger, db, groupID, userID, permission)
+	if err != nil {
+		if err == runtime.ErrGroupPermissionDenied {
+			return false, nil
+		}
+		return false, err
+	}
+	return state <= 1 || granted, nil
+}
+
+// Look up the caller's state in a group, and whether they are a member holding a role that grants the given
+// permission. The state is always the caller's real state, callers decide how far a role grant extends.
+func groupCallerState(ctx context.Context, logger *zap.Logger, db *sql.DB, groupID, caller uuid.UUID, permission GroupPermission) (int, bool, error) {
+	query := `
+SELECT ge.state, COALESCE(gr.permissions, 0)
+FROM group_edge ge
+LEFT JOIN group_user_role gur ON gur.group_id = ge.source_id AND gur.user_id = ge.destination_id
+LEFT JOIN group_role gr ON gr.group_id = gur.group_id AND gr.name = gur.role
+WHERE ge.source_id = $1::UUID AND ge.destination_id = $2::UUID`
+	var dbState int
+	var dbPermissions int64
+	if err := db.QueryRowContext(ctx, query, groupID, caller).Scan(&dbState, &dbPermissions); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("Could not retrieve state as no group relationship exists.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+			return 0, false, runtime.ErrGroupPermissionDenied
+		}
+		logger.Error("Could not retrieve state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+		return 0, false, err
+	}
+
+	granted := permission != 0 && dbState == int(api.GroupUserList_GroupUser_MEMBER) && GroupPermission(dbPermissions)&permission == permission
+	return dbState, granted, nil
+}
+
+// Remove any custom role held by a user who is leaving the group, so it is not restored if they join again.
+func groupUserRoleRemove(ctx context.Context, logger *zap.Logger, tx *sql.Tx, groupID, userID uuid.UUID) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM group_user_role WHERE group_id = $1 AND user_id = $2", groupID, userID); err != nil {
+		logger.Debug("Could not remove group user role.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", userID.String()))
+		return err
+	}
+	return nil
+}
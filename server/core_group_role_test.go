@@ -0,0 +1,132 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupPermissionsParse(t *testing.T) {
+	permissions, err := GroupPermissionsParse([]string{"manage_chat", "spend_wallet", "kick", "kick"})
+	require.NoError(t, err)
+	assert.Equal(t, GroupPermissionKick|GroupPermissionManageChat|GroupPermissionSpendWallet, permissions)
+	assert.Equal(t, []string{"kick", "manage_chat", "spend_wallet"}, permissions.Names())
+
+	permissions, err = GroupPermissionsParse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, GroupPermission(0), permissions)
+	assert.Empty(t, permissions.Names())
+
+	_, err = GroupPermissionsParse([]string{"kick", "Kick"})
+	assert.Error(t, err, "permission names are case sensitive")
+
+	all := make([]string, 0, len(groupPermissionNames))
+	for _, p := range groupPermissionNames {
+		all = append(all, p.name)
+	}
+	permissions, err = GroupPermissionsParse(all)
+	require.NoError(t, err)
+	assert.Equal(t, all, permissions.Names())
+}
+
+func TestGroupRolePermissions(t *testing.T) {
+	ctx := context.Background()
+	logger := loggerForTest(t)
+	db := NewDB(t)
+	defer db.Close()
+	groupIndex, err := NewLocalGroupIndex(logger, db)
+	require.NoError(t, err)
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+
+	newUser := func() uuid.UUID {
+		userID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userID)
+		return userID
+	}
+	owner, recruiter, admin, member, requester := newUser(), newUser(), newUser(), newUser(), newUser()
+
+	group, err := CreateGroup(ctx, logger, db, groupIndex, owner, owner, uuid.Must(uuid.NewV4()).String(), "en", "", "", "", false, 100)
+	require.NoError(t, err)
+	groupID := uuid.Must(uuid.FromString(group.Id))
+	require.NoError(t, AddGroupUsers(ctx, logger, db, groupIndex, tracker, router, owner, groupID, []uuid.UUID{recruiter, admin, member}))
+	require.NoError(t, PromoteGroupUsers(ctx, logger, db, groupIndex, router, owner, groupID, []uuid.UUID{admin}))
+	require.NoError(t, JoinGroup(ctx, logger, db, groupIndex, tracker, router, groupID, requester, requester.String()))
+
+	require.NoError(t, GroupRoleSet(ctx, logger, db, groupID, "recruiter", GroupPermissionPromote|GroupPermissionKick|GroupPermissionSpendWallet))
+	require.NoError(t, GroupUserRoleAssign(ctx, logger, db, groupID, recruiter, "recruiter"))
+
+	state := func(userID uuid.UUID) int {
+		var state int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT state FROM group_edge WHERE source_id = $1 AND destination_id = $2", groupID, userID).Scan(&state))
+		return state
+	}
+	require.Equal(t, int(api.GroupUserList_GroupUser_ADMIN), state(admin))
+	require.Equal(t, int(api.GroupUserList_GroupUser_JOIN_REQUEST), state(requester))
+
+	allowed, err := GroupUserPermissionCheck(ctx, logger, db, groupID, recruiter, GroupPermissionPromote)
+	require.NoError(t, err)
+	assert.True(t, allowed, "role grants promote")
+	allowed, err = GroupUserPermissionCheck(ctx, logger, db, groupID, recruiter, GroupPermissionBan)
+	require.NoError(t, err)
+	assert.False(t, allowed, "role does not grant ban")
+	allowed, err = GroupUserPermissionCheck(ctx, logger, db, groupID, member, GroupPermissionPromote)
+	require.NoError(t, err)
+	assert.False(t, allowed, "members without a role")
+	allowed, err = GroupUserPermissionCheck(ctx, logger, db, groupID, admin, GroupPermissionBan)
+	require.NoError(t, err)
+	assert.True(t, allowed, "admins hold every permission")
+	// Nothing in the server spends group wallets, runtime code enforces this permission with the same check.
+	allowed, err = GroupUserPermissionCheck(ctx, logger, db, groupID, recruiter, GroupPermissionSpendWallet)
+	require.NoError(t, err)
+	assert.True(t, allowed, "role grants spend wallet")
+	allowed, err = GroupUserPermissionCheck(ctx, logger, db, groupID, member, GroupPermissionSpendWallet)
+	require.NoError(t, err)
+	assert.False(t, allowed, "members without a role can't spend the wallet")
+
+	// A role granting promote only accepts join requests, it never makes anyone an admin.
+	require.NoError(t, PromoteGroupUsers(ctx, logger, db, groupIndex, router, recruiter, groupID, []uuid.UUID{member, requester}))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), state(member))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), state(requester))
+	require.NoError(t, PromoteGroupUsers(ctx, logger, db, groupIndex, router, recruiter, groupID, []uuid.UUID{requester}))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), state(requester))
+
+	var edgeCount int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT edge_count FROM groups WHERE id = $1", groupID).Scan(&edgeCount))
+	assert.Equal(t, 5, edgeCount)
+
+	// Roles never grant demotion.
+	err = DemoteGroupUsers(ctx, logger, db, router, recruiter, groupID, []uuid.UUID{admin})
+	assert.Equal(t, runtime.ErrGroupPermissionDenied, err)
+	assert.Equal(t, int(api.GroupUserList_GroupUser_ADMIN), state(admin))
+
+	// A role granting kick removes members but not admins.
+	require.NoError(t, KickGroupUsers(ctx, logger, db, groupIndex, tracker, router, nil, recruiter, groupID, []uuid.UUID{admin, member}, false))
+	assert.Equal(t, int(api.GroupUserList_GroupUser_ADMIN), state(admin))
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM group_edge WHERE source_id = $1 AND destination_id = $2", groupID, member).Scan(&count))
+	assert.Zero(t, count)
+
+	err = BanGroupUsers(ctx, logger, db, groupIndex, tracker, router, nil, recruiter, groupID, []uuid.UUID{requester})
+	assert.Equal(t, runtime.ErrGroupPermissionDenied, err)
+	assert.Equal(t, int(api.GroupUserList_GroupUser_MEMBER), state(requester))
+}
@@ -66,7 +66,7 @@ AND (NOT EXISTS
 
 	// Import email address, if it exists.
 	if profile.Email != "" {
-		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1 WHERE id = $2", profile.Email, userID)
+		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1, verify_time = CASE WHEN email IS DISTINCT FROM $1 THEN '1970-01-01 00:00:00 UTC' ELSE verify_time END WHERE id = $2", profile.Email, userID)
 		if err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "users_email_key") {
@@ -175,7 +175,7 @@ func LinkEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.
 
 	res, err := db.ExecContext(ctx, `
 UPDATE users
-SET email = $2, password = $3, update_time = now()
+SET email = $2, password = $3, verify_time = CASE WHEN email IS DISTINCT FROM $2 THEN '1970-01-01 00:00:00 UTC' ELSE verify_time END, update_time = now()
 WHERE (id = $1)
 AND (NOT EXISTS
     (SELECT id
@@ -233,7 +233,7 @@ AND (NOT EXISTS
 
 	// Import email address, if it exists.
 	if facebookProfile.Email != "" {
-		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1 WHERE id = $2", facebookProfile.Email, userID)
+		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1, verify_time = CASE WHEN email IS DISTINCT FROM $1 THEN '1970-01-01 00:00:00 UTC' ELSE verify_time END WHERE id = $2", facebookProfile.Email, userID)
 		if err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "users_email_key") {
@@ -377,7 +377,7 @@ AND (NOT EXISTS
 
 	// Import email address, if it exists.
 	if googleProfile.GetEmail() != "" {
-		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1 WHERE id = $2", googleProfile.GetEmail(), userID)
+		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1, verify_time = CASE WHEN email IS DISTINCT FROM $1 THEN '1970-01-01 00:00:00 UTC' ELSE verify_time END WHERE id = $2", googleProfile.GetEmail(), userID)
 		if err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "users_email_key") {
@@ -392,6 +392,78 @@ AND (NOT EXISTS
 	return nil
 }
 
+func LinkOIDC(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, userID uuid.UUID, providerName, token string) error {
+	provider, err := oidcProvider(config, providerName)
+	if err != nil {
+		return err
+	}
+
+	if token == "" {
+		return status.Error(codes.InvalidArgument, "OpenID Connect ID token is required.")
+	}
+
+	oidcProfile, err := socialClient.CheckOIDCToken(ctx, provider, token)
+	if err != nil {
+		logger.Info("Could not authenticate OpenID Connect profile.", zap.Error(err), zap.String("provider", providerName))
+		return status.Error(codes.Unauthenticated, "Could not authenticate OpenID Connect profile.")
+	}
+	if len(oidcProfile.Subject) > 255 {
+		logger.Info("OpenID Connect subject exceeds max length of 255 chars.", zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject))
+		return status.Error(codes.Unauthenticated, "Could not authenticate OpenID Connect profile.")
+	}
+
+	displayName := oidcProfile.DisplayName
+	if len(displayName) > 255 {
+		// Ignore the name in case it is longer than db can store
+		logger.Warn("Skipping updating display_name: value received from OpenID Connect provider longer than max length of 255 chars.", zap.String("display_name", displayName))
+		displayName = ""
+	}
+
+	avatarURL := oidcProfile.AvatarURL
+	if len(avatarURL) > 512 {
+		// Ignore the url in case it is longer than db can store
+		logger.Warn("Skipping updating avatar_url: value received from OpenID Connect provider longer than max length of 512 chars.", zap.String("avatar_url", avatarURL))
+		avatarURL = ""
+	}
+
+	err = ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		// A user may have one identity per provider, linking another replaces it.
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO user_identity (provider, subject, user_id)
+VALUES ($2, $3, $1)
+ON CONFLICT (user_id, provider) DO UPDATE SET subject = $3, update_time = now()`,
+			userID, providerName, oidcProfile.Subject); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "UPDATE users AS u SET display_name = COALESCE(NULLIF(u.display_name, ''), $2), avatar_url = COALESCE(NULLIF(u.avatar_url, ''), $3), update_time = now() WHERE id = $1", userID, displayName, avatarURL)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "user_identity_pkey") {
+			return status.Error(codes.AlreadyExists, "OpenID Connect identity is already in use.")
+		}
+		logger.Error("Could not link OpenID Connect identity.", zap.Error(err), zap.String("provider", providerName), zap.Any("input", token))
+		return status.Error(codes.Internal, "Error while trying to link OpenID Connect identity.")
+	}
+
+	// Import the email address, if the provider has verified it.
+	if oidcProfile.Email != "" {
+		_, err = db.ExecContext(ctx, "UPDATE users SET email = $1 WHERE id = $2 AND email IS NULL", oidcProfile.Email, userID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "users_email_key") {
+				logger.Warn("Skipping OpenID Connect email import as it is already set in another user.", zap.Error(err), zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("user_id", userID.String()))
+			} else {
+				logger.Error("Failed to import OpenID Connect email.", zap.Error(err), zap.String("provider", providerName), zap.String("subject", oidcProfile.Subject), zap.String("user_id", userID.String()))
+				return status.Error(codes.Internal, "Error importing OpenID Connect email.")
+			}
+		}
+	}
+
+	return nil
+}
+
 func LinkSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, tracker Tracker, router MessageRouter, userID uuid.UUID, username, token string, sync bool) error {
 	if config.GetSocial().Steam.PublisherKey == "" || config.GetSocial().Steam.AppID == 0 {
 		return status.Error(codes.FailedPrecondition, "Steam authentication is not configured.")
@@ -0,0 +1,376 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgryski/dgoogauth"
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kinds of short-lived tokens issued while setting up or using MFA on a player account.
+const (
+	MFATokenTypeEnrollment = "mfa_enrollment"
+	MFATokenTypeChallenge  = "mfa_challenge"
+)
+
+// MFATokenClaims are carried by MFA enrollment and challenge tokens. Enrollment tokens hold the secret being set up
+// until the user confirms it, and challenge tokens hold what is needed to issue a session once the second factor is
+// given, including the authentication method that issued the challenge.
+type MFATokenClaims struct {
+	Type      string            `json:"typ,omitempty"`
+	TokenId   string            `json:"tid,omitempty"`
+	UserId    string            `json:"uid,omitempty"`
+	Username  string            `json:"usn,omitempty"`
+	Method    string            `json:"mth,omitempty"`
+	Vars      map[string]string `json:"vrs,omitempty"`
+	Secret    string            `json:"sec,omitempty"`
+	ExpiresAt int64             `json:"exp,omitempty"`
+	IssuedAt  int64             `json:"iat,omitempty"`
+}
+
+func (c *MFATokenClaims) Valid() error {
+	// Verify expiry.
+	if c.ExpiresAt <= time.Now().UTC().Unix() {
+		return jwt.NewValidationError("token is expired", jwt.ValidationErrorExpired)
+	}
+	return nil
+}
+
+// EnrollMFA starts MFA enrollment for a user, returning a new secret for their authenticator app and a token that
+// confirms the enrollment when passed to ConfirmMFA along with a code generated from the secret.
+func EnrollMFA(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, userID uuid.UUID) (*apigrpc.MFAEnrollment, error) {
+	var username string
+	var mfaSecret []byte
+	if err := db.QueryRowContext(ctx, "SELECT username, mfa_secret FROM users WHERE id = $1", userID).Scan(&username, &mfaSecret); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "User account not found.")
+		}
+		logger.Error("Could not read user MFA state.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "Error enrolling MFA.")
+	}
+	if mfaSecret != nil {
+		return nil, status.Error(codes.FailedPrecondition, "MFA is already enabled.")
+	}
+
+	secret, err := generateMFASecret()
+	if err != nil {
+		logger.Error("Failed to generate the MFA secret.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error enrolling MFA.")
+	}
+
+	token := generateMFAToken(config, &MFATokenClaims{
+		Type:   MFATokenTypeEnrollment,
+		UserId: userID.String(),
+		Secret: secret,
+	}, config.GetMFA().EnrollmentExpirySec)
+
+	otpConfig := &dgoogauth.OTPConfig{
+		Secret:     secret,
+		WindowSize: MFAWindowSize,
+		UTC:        true,
+	}
+
+	return &apigrpc.MFAEnrollment{
+		Secret: secret,
+		Url:    otpConfig.ProvisionURIWithIssuer(username, config.GetMFA().Issuer),
+		Token:  token,
+	}, nil
+}
+
+// ConfirmMFA completes MFA enrollment once the user shows their authenticator app produces valid codes, and returns
+// the recovery codes that can be used in place of an MFA code if they lose access to the app.
+func ConfirmMFA(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, userID uuid.UUID, token, code string) ([]string, error) {
+	var claims MFATokenClaims
+	if err := parseMFAToken(config, token, &claims); err != nil || claims.Type != MFATokenTypeEnrollment || claims.UserId != userID.String() {
+		return nil, status.Error(codes.InvalidArgument, "MFA enrollment token is invalid or expired.")
+	}
+
+	otpConfig := &dgoogauth.OTPConfig{
+		Secret:        claims.Secret,
+		WindowSize:    MFAWindowSize,
+		UTC:           true,
+		DisallowReuse: []int{},
+	}
+	ok, err := otpConfig.Authenticate(code)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "The MFA code used is incorrectly formatted.")
+	}
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "The MFA code is invalid.")
+	}
+
+	key := []byte(config.GetMFA().StorageEncryptionKey)
+	dbMFASecret, err := encrypt([]byte(claims.Secret), key)
+	if err != nil {
+		logger.Error("Failed to cipher the MFA secret.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error confirming MFA.")
+	}
+	recoveryCodes, err := generateRecoveryCodes()
+	if err != nil {
+		logger.Error("Failed to generate the MFA recovery codes.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error confirming MFA.")
+	}
+	dbRecoveryCodes, err := encrypt([]byte(strings.Join(recoveryCodes, ",")), key)
+	if err != nil {
+		logger.Error("Failed to cipher the MFA recovery codes.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error confirming MFA.")
+	}
+
+	res, err := db.ExecContext(ctx, "UPDATE users SET mfa_secret = $2, mfa_recovery_codes = $3, mfa_used_counters = $4, update_time = now() WHERE id = $1 AND mfa_secret IS NULL", userID, dbMFASecret, dbRecoveryCodes, formatMFAUsedCounters(otpConfig.DisallowReuse))
+	if err != nil {
+		logger.Error("Could not store user MFA secret.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "Error confirming MFA.")
+	}
+	if count, _ := res.RowsAffected(); count == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "MFA is already enabled.")
+	}
+
+	return recoveryCodes, nil
+}
+
+// CheckMFAChallenge returns an error holding an MFA challenge token if the user has enabled MFA, which the client
+// must exchange for a session with AuthenticateMFA. The method is the kind of authentication that issued the challenge.
+func CheckMFAChallenge(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, userID, username, method string, vars map[string]string) error {
+	var enabled bool
+	if err := db.QueryRowContext(ctx, "SELECT mfa_secret IS NOT NULL FROM users WHERE id = $1", userID).Scan(&enabled); err != nil {
+		logger.Error("Could not read user MFA state.", zap.Error(err), zap.String("user_id", userID))
+		return status.Error(codes.Internal, "Error finding user account.")
+	}
+	if !enabled {
+		return nil
+	}
+
+	token := generateMFAToken(config, &MFATokenClaims{
+		Type:     MFATokenTypeChallenge,
+		UserId:   userID,
+		Username: username,
+		Method:   method,
+		Vars:     vars,
+	}, config.GetMFA().ChallengeExpirySec)
+
+	st, err := status.New(codes.PermissionDenied, "A MFA code is required.").WithDetails(&apigrpc.MFAChallenge{Token: token})
+	if err != nil {
+		logger.Error("Could not build MFA challenge.", zap.Error(err))
+		return status.Error(codes.Internal, "Error finding user account.")
+	}
+	return st.Err()
+}
+
+// AuthenticateMFA completes an MFA challenge with a code from the user's authenticator app or one of their recovery
+// codes. Challenges, recovery codes and authenticator app codes can each only be used once. It returns the claims of
+// the challenge, holding the user, their current username, the session variables and the authentication method the
+// challenge was issued for.
+func AuthenticateMFA(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, attemptCache LoginAttemptCache, token, code string) (*MFATokenClaims, error) {
+	var claims MFATokenClaims
+	if err := parseMFAToken(config, token, &claims); err != nil || claims.Type != MFATokenTypeChallenge || claims.TokenId == "" {
+		return nil, status.Error(codes.Unauthenticated, "MFA challenge token is invalid or expired.")
+	}
+	if strings.TrimSpace(code) == "" {
+		return nil, status.Error(codes.InvalidArgument, "A MFA code is required.")
+	}
+
+	// Codes are short, so guesses are limited per account.
+	if !attemptCache.Allow(claims.UserId, "") {
+		return nil, status.Error(codes.ResourceExhausted, "Try again later.")
+	}
+
+	key := []byte(config.GetMFA().StorageEncryptionKey)
+	var username string
+	err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		var dbMFASecret, dbRecoveryCodes []byte
+		var dbUsedCounters string
+		var disableTime time.Time
+		err := tx.QueryRowContext(ctx, "SELECT username, mfa_secret, mfa_recovery_codes, mfa_used_counters, disable_time FROM users WHERE id = $1 FOR UPDATE", claims.UserId).Scan(&username, &dbMFASecret, &dbRecoveryCodes, &dbUsedCounters, &disableTime)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return StatusError(codes.NotFound, "User account not found.", err)
+			}
+			return err
+		}
+		if disableTime.Unix() != 0 {
+			return StatusError(codes.PermissionDenied, "User account banned.", nil)
+		}
+
+		// Consume the challenge, if the code turns out to be wrong the transaction rolls back and it can be tried again.
+		if _, err = tx.ExecContext(ctx, "DELETE FROM user_mfa_challenge WHERE user_id = $1 AND expire_time <= now()", claims.UserId); err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, "INSERT INTO user_mfa_challenge (user_id, token_id, expire_time) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING", claims.UserId, claims.TokenId, time.Unix(claims.ExpiresAt, 0).UTC())
+		if err != nil {
+			return err
+		}
+		if count, _ := res.RowsAffected(); count == 0 {
+			return StatusError(codes.Unauthenticated, "MFA challenge token is invalid or expired.", nil)
+		}
+
+		if dbMFASecret == nil {
+			// MFA was reset since the challenge was issued, the password check that issued it still stands.
+			return nil
+		}
+
+		secret, err := decrypt(dbMFASecret, key)
+		if err != nil {
+			return err
+		}
+		recoveryCodes, err := parseMFARecoveryCodes(dbRecoveryCodes, key)
+		if err != nil {
+			return err
+		}
+
+		usedCounters, err := parseMFAUsedCounters(dbUsedCounters)
+		if err != nil {
+			return err
+		}
+
+		otpConfig := &dgoogauth.OTPConfig{
+			Secret:        string(secret),
+			WindowSize:    MFAWindowSize,
+			UTC:           true,
+			ScratchCodes:  recoveryCodes,
+			DisallowReuse: usedCounters,
+		}
+		ok, err := otpConfig.Authenticate(code)
+		if err != nil {
+			return StatusError(codes.InvalidArgument, "The MFA code used is incorrectly formatted.", err)
+		}
+		if !ok {
+			return StatusError(codes.Unauthenticated, "The MFA code is invalid.", nil)
+		}
+
+		if len(otpConfig.ScratchCodes) != len(recoveryCodes) {
+			// A recovery code was used so the remaining ones must be persisted.
+			remaining := make([]string, len(otpConfig.ScratchCodes))
+			for i, c := range otpConfig.ScratchCodes {
+				remaining[i] = strconv.Itoa(c)
+			}
+			dbRecoveryCodes, err = encrypt([]byte(strings.Join(remaining, ",")), key)
+			if err != nil {
+				return err
+			}
+		}
+		// The time step of an authenticator app code is recorded so the same code is refused while it is still valid.
+		_, err = tx.ExecContext(ctx, "UPDATE users SET mfa_recovery_codes = $2, mfa_used_counters = $3, update_time = now() WHERE id = $1", claims.UserId, dbRecoveryCodes, formatMFAUsedCounters(otpConfig.DisallowReuse))
+		return err
+	})
+	if err != nil {
+		var statusErr *statusError
+		if errors.As(err, &statusErr) {
+			if statusErr.Code() == codes.Unauthenticated || statusErr.Code() == codes.InvalidArgument {
+				attemptCache.Add(claims.UserId, "")
+			}
+			return nil, statusErr.Status()
+		}
+		logger.Error("Could not complete MFA challenge.", zap.Error(err), zap.String("user_id", claims.UserId))
+		return nil, status.Error(codes.Internal, "Error finding user account.")
+	}
+
+	attemptCache.Reset(claims.UserId)
+	claims.Username = username
+	return &claims, nil
+}
+
+// ResetMFA removes a user's MFA secret and recovery codes, so they can sign in with only their password and enroll again.
+func ResetMFA(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) error {
+	res, err := db.ExecContext(ctx, "UPDATE users SET mfa_secret = NULL, mfa_recovery_codes = NULL, mfa_used_counters = '', update_time = now() WHERE id = $1", userID)
+	if err != nil {
+		logger.Error("Could not reset user MFA.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error resetting MFA.")
+	}
+	if count, _ := res.RowsAffected(); count == 0 {
+		return status.Error(codes.NotFound, "User account not found.")
+	}
+	return nil
+}
+
+func parseMFARecoveryCodes(dbRecoveryCodes, key []byte) ([]int, error) {
+	if dbRecoveryCodes == nil {
+		return nil, nil
+	}
+	recoveryCodesStr, err := decrypt(dbRecoveryCodes, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(recoveryCodesStr) == 0 {
+		// All recovery codes have been used.
+		return []int{}, nil
+	}
+	recoveryCodesStrArr := strings.Split(string(recoveryCodesStr), ",")
+	recoveryCodes := make([]int, len(recoveryCodesStrArr))
+	for i, codeStr := range recoveryCodesStrArr {
+		if recoveryCodes[i], err = strconv.Atoi(codeStr); err != nil {
+			return nil, err
+		}
+	}
+	return recoveryCodes, nil
+}
+
+func parseMFAUsedCounters(dbUsedCounters string) ([]int, error) {
+	// A non-nil slice tells the OTP check to refuse and record used time steps.
+	usedCounters := []int{}
+	if dbUsedCounters == "" {
+		return usedCounters, nil
+	}
+	for _, counterStr := range strings.Split(dbUsedCounters, ",") {
+		counter, err := strconv.Atoi(counterStr)
+		if err != nil {
+			return nil, err
+		}
+		usedCounters = append(usedCounters, counter)
+	}
+	return usedCounters, nil
+}
+
+func formatMFAUsedCounters(usedCounters []int) string {
+	counterStrs := make([]string, len(usedCounters))
+	for i, counter := range usedCounters {
+		counterStrs[i] = strconv.Itoa(counter)
+	}
+	return strings.Join(counterStrs, ",")
+}
+
+func generateMFAToken(config Config, claims *MFATokenClaims, expirySec int) string {
+	now := time.Now().UTC()
+	claims.TokenId = uuid.Must(uuid.NewV4()).String()
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(time.Duration(expirySec) * time.Second).Unix()
+	signedToken, _ := generateJWTToken(string(mfaTokenKey(config)), claims)
+	return signedToken
+}
+
+func parseMFAToken(config Config, token string, claims *MFATokenClaims) error {
+	return parseJWTToken(string(mfaTokenKey(config)), token, claims)
+}
+
+// MFA tokens are signed with a key derived from the session encryption key, so they can never be mistaken for
+// session tokens.
+func mfaTokenKey(config Config) []byte {
+	mac := hmac.New(sha256.New, []byte(config.GetSession().EncryptionKey))
+	mac.Write([]byte("mfa"))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgryski/dgoogauth"
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMFAToken(t *testing.T) {
+	config := NewConfig(loggerForTest(t))
+	userID := uuid.Must(uuid.NewV4()).String()
+
+	token := generateMFAToken(config, &MFATokenClaims{
+		Type:     MFATokenTypeChallenge,
+		UserId:   userID,
+		Username: "player",
+		Vars:     map[string]string{"k": "v"},
+	}, 60)
+
+	var claims MFATokenClaims
+	require.NoError(t, parseMFAToken(config, token, &claims))
+	assert.Equal(t, MFATokenTypeChallenge, claims.Type)
+	assert.Equal(t, userID, claims.UserId)
+	assert.Equal(t, "player", claims.Username)
+	assert.Equal(t, map[string]string{"k": "v"}, claims.Vars)
+
+	// MFA tokens are not accepted as session tokens.
+	_, _, _, _, _, _, ok := parseToken(sessionTokenKeyFunc(config.GetSession()), token)
+	assert.False(t, ok)
+
+	expired := generateMFAToken(config, &MFATokenClaims{Type: MFATokenTypeChallenge, UserId: userID}, -1)
+	assert.Error(t, parseMFAToken(config, expired, &MFATokenClaims{}))
+}
+
+func TestMFARecoveryCodes(t *testing.T) {
+	key := []byte(NewMFAConfig().StorageEncryptionKey)
+
+	codes, err := generateRecoveryCodes()
+	require.NoError(t, err)
+	encrypted, err := encrypt([]byte(strings.Join(codes, ",")), key)
+	require.NoError(t, err)
+	parsed, err := parseMFARecoveryCodes(encrypted, key)
+	require.NoError(t, err)
+	require.Len(t, parsed, len(codes))
+	for i, code := range codes {
+		assert.Equal(t, code, strconv.Itoa(parsed[i]))
+	}
+
+	// Every recovery code has been used.
+	encrypted, err = encrypt([]byte(""), key)
+	require.NoError(t, err)
+	parsed, err = parseMFARecoveryCodes(encrypted, key)
+	require.NoError(t, err)
+	assert.Empty(t, parsed)
+}
+
+func TestMFAUsedCounters(t *testing.T) {
+	usedCounters, err := parseMFAUsedCounters("")
+	require.NoError(t, err)
+	assert.NotNil(t, usedCounters, "an empty list must still refuse reused codes")
+	assert.Empty(t, usedCounters)
+
+	usedCounters, err = parseMFAUsedCounters(formatMFAUsedCounters([]int{58000001, 58000002}))
+	require.NoError(t, err)
+	assert.Equal(t, []int{58000001, 58000002}, usedCounters)
+
+	_, err = parseMFAUsedCounters("58000001,x")
+	assert.Error(t, err)
+}
+
+func TestAuthenticateMFA(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+
+	userID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userID)
+
+	key := []byte(cfg.GetMFA().StorageEncryptionKey)
+	secret, err := generateMFASecret()
+	require.NoError(t, err)
+	dbMFASecret, err := encrypt([]byte(secret), key)
+	require.NoError(t, err)
+	dbRecoveryCodes, err := encrypt([]byte("12345678,87654321"), key)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "UPDATE users SET mfa_secret = $2, mfa_recovery_codes = $3 WHERE id = $1", userID, dbMFASecret, dbRecoveryCodes)
+	require.NoError(t, err)
+
+	challenge := func() string {
+		err := CheckMFAChallenge(ctx, logger, db, cfg, userID.String(), "player", "email", map[string]string{"k": "v"})
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+		require.Len(t, st.Details(), 1)
+		return st.Details()[0].(*apigrpc.MFAChallenge).Token
+	}
+	attemptCache := NewLocalLoginAttemptCache(logger, nil, cfg, "", nil)
+	defer attemptCache.Stop()
+	code := fmt.Sprintf("%06d", dgoogauth.ComputeCode(secret, time.Now().UTC().Unix()/30))
+
+	token := challenge()
+	claims, err := AuthenticateMFA(ctx, logger, db, cfg, attemptCache, token, code)
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), claims.UserId)
+	assert.Equal(t, "email", claims.Method)
+	assert.Equal(t, map[string]string{"k": "v"}, claims.Vars)
+
+	// The same challenge can't be exchanged for another session, even with a valid recovery code.
+	_, err = AuthenticateMFA(ctx, logger, db, cfg, attemptCache, token, "12345678")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// An authenticator code can't be replayed on a new challenge while it is still valid.
+	_, err = AuthenticateMFA(ctx, logger, db, cfg, attemptCache, challenge(), code)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// A wrong code doesn't use up the challenge.
+	token = challenge()
+	_, err = AuthenticateMFA(ctx, logger, db, cfg, attemptCache, token, "00000000")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = AuthenticateMFA(ctx, logger, db, cfg, attemptCache, token, "12345678")
+	require.NoError(t, err)
+
+	// Recovery codes can only be used once.
+	_, err = AuthenticateMFA(ctx, logger, db, cfg, attemptCache, challenge(), "12345678")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -23,17 +23,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/heroiclabs/nakama-common/runtime"
-	"github.com/jackc/pgx/v5"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -46,6 +48,9 @@ const (
 	NotificationCodeFriendJoinGame   int32 = -6
 	NotificationCodeSingleSocket     int32 = -7
 	NotificationCodeUserBanned       int32 = -8
+	NotificationCodeRead             int32 = -9
+	NotificationCodeDelete           int32 = -10
+	NotificationCodeGroupInvite      int32 = -11
 )
 
 type notificationCacheableCursor struct {
@@ -53,7 +58,19 @@ type notificationCacheableCursor struct {
 	CreateTime     int64
 }
 
+// NotificationListFilter narrows the notifications returned by a listing. A nil filter lists all notifications.
+type NotificationListFilter struct {
+	UnreadOnly bool
+	CodeRanges []*apigrpc.NotificationCodeRange
+}
+
 func NotificationSend(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, messageRouter MessageRouter, notifications map[uuid.UUID][]*api.Notification) error {
+	return NotificationSendWithExpiry(ctx, logger, db, tracker, messageRouter, notifications, time.Time{})
+}
+
+// NotificationSendWithExpiry sends notifications, persistent notifications are no longer listed after the given expiry time.
+// A zero expiry time means the notifications never expire.
+func NotificationSendWithExpiry(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, messageRouter MessageRouter, notifications map[uuid.UUID][]*api.Notification, expiryTime time.Time) error {
 	persistentNotifications := make(map[uuid.UUID][]*api.Notification, len(notifications))
 	for userID, ns := range notifications {
 		for _, userNotification := range ns {
@@ -70,20 +87,34 @@ func NotificationSend(ctx context.Context, logger *zap.Logger, db *sql.DB, track
 
 	// Store any persistent notifications.
 	if len(persistentNotifications) > 0 {
-		if err := NotificationSave(ctx, logger, db, persistentNotifications); err != nil {
+		if err := NotificationSave(ctx, logger, db, persistentNotifications, expiryTime); err != nil {
 			return err
 		}
 	}
 
+	notificationDeliver(logger, tracker, messageRouter, notifications, persistentNotifications)
+	return nil
+}
+
+// Deliver notifications to connected users, and persistent notifications to offline users through push. Persistent
+// notifications must already be stored.
+func notificationDeliver(logger *zap.Logger, tracker Tracker, messageRouter MessageRouter, notifications, persistentNotifications map[uuid.UUID][]*api.Notification) {
 	recipients := make(map[PresenceStream][]*PresenceID, len(notifications))
 	for userID := range notifications {
 		recipients[PresenceStream{Mode: StreamModeNotifications, Subject: userID}] = make([]*PresenceID, 0, 1)
 	}
 	tracker.ListPresenceIDByStreams(recipients)
 
-	// Deliver live notifications to connected users.
+	// Deliver live notifications to connected users, and hand off persistent notifications for offline users to push.
+	var pushNotifications map[uuid.UUID][]*api.Notification
 	for stream, presenceIDs := range recipients {
 		if len(presenceIDs) == 0 {
+			if pn, found := persistentNotifications[stream.Subject]; found {
+				if pushNotifications == nil {
+					pushNotifications = make(map[uuid.UUID][]*api.Notification, len(persistentNotifications))
+				}
+				pushNotifications[stream.Subject] = pn
+			}
 			continue
 		}
 		ns, found := notifications[stream.Subject]
@@ -100,7 +131,9 @@ func NotificationSend(ctx context.Context, logger *zap.Logger, db *sql.DB, track
 		}, true)
 	}
 
-	return nil
+	if len(pushNotifications) > 0 {
+		messageRouter.SendToPush(logger, pushNotifications)
+	}
 }
 
 func NotificationSendAll(ctx context.Context, logger *zap.Logger, db *sql.DB, gotracker Tracker, messageRouter MessageRouter, notification *api.Notification) error {
@@ -174,7 +207,7 @@ func NotificationSendAll(ctx context.Context, logger *zap.Logger, db *sql.DB, go
 				return
 			}
 
-			if err := NotificationSave(ctx, notificationLogger, db, sends); err != nil {
+			if err := NotificationSave(ctx, notificationLogger, db, sends, time.Time{}); err != nil {
 				notificationLogger.Error("Failed to save persistent notifications", zap.Error(err))
 				return
 			}
@@ -202,7 +235,25 @@ func NotificationSendAll(ctx context.Context, logger *zap.Logger, db *sql.DB, go
 	return nil
 }
 
-func NotificationList(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, limit int, cursor string, cacheable bool) (*api.NotificationList, error) {
+func NotificationList(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, limit int, cursor string, cacheable bool, filter *NotificationListFilter) (*api.NotificationList, error) {
+	inbox, err := NotificationInboxList(ctx, logger, db, userID, limit, cursor, cacheable, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationList := &api.NotificationList{CacheableCursor: inbox.CacheableCursor}
+	if len(inbox.Notifications) > 0 {
+		notificationList.Notifications = make([]*api.Notification, 0, len(inbox.Notifications))
+		for _, n := range inbox.Notifications {
+			notificationList.Notifications = append(notificationList.Notifications, n.Notification)
+		}
+	}
+
+	return notificationList, nil
+}
+
+// NotificationInboxList lists notifications that have not expired along with their read state.
+func NotificationInboxList(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, limit int, cursor string, cacheable bool, filter *NotificationListFilter) (*apigrpc.NotificationInbox, error) {
 	var nc *notificationCacheableCursor
 	if cursor != "" {
 		nc = &notificationCacheableCursor{}
@@ -219,22 +270,37 @@ func NotificationList(ctx context.Context, logger *zap.Logger, db *sql.DB, userI
 
 	params := []interface{}{userID}
 
-	limitQuery := ""
-	if limit > 0 {
-		params = append(params, limit+1)
-		limitQuery = " LIMIT $2"
+	filterQuery := " AND (expiry_time IS NULL OR expiry_time > now())"
+	if filter != nil {
+		if filter.UnreadOnly {
+			filterQuery += " AND read_time IS NULL"
+		}
+		if len(filter.CodeRanges) > 0 {
+			codeQueries := make([]string, 0, len(filter.CodeRanges))
+			for _, codeRange := range filter.CodeRanges {
+				params = append(params, codeRange.MinCode, codeRange.MaxCode)
+				codeQueries = append(codeQueries, fmt.Sprintf("code BETWEEN $%d AND $%d", len(params)-1, len(params)))
+			}
+			filterQuery += " AND (" + strings.Join(codeQueries, " OR ") + ")"
+		}
 	}
 
 	cursorQuery := ""
 	if nc != nil && nc.NotificationID != nil {
-		cursorQuery = " AND (user_id, create_time, id) > ($1::UUID, $3::TIMESTAMPTZ, $4::UUID)"
 		params = append(params, &pgtype.Timestamptz{Time: time.Unix(0, nc.CreateTime).UTC(), Valid: true}, uuid.FromBytesOrNil(nc.NotificationID))
+		cursorQuery = fmt.Sprintf(" AND (user_id, create_time, id) > ($1::UUID, $%d::TIMESTAMPTZ, $%d::UUID)", len(params)-1, len(params))
+	}
+
+	limitQuery := ""
+	if limit > 0 {
+		params = append(params, limit+1)
+		limitQuery = fmt.Sprintf(" LIMIT $%d", len(params))
 	}
 
 	query := `
-SELECT id, subject, content, code, sender_id, create_time
+SELECT id, subject, content, code, sender_id, create_time, read_time, expiry_time
 FROM notification
-WHERE user_id = $1` + cursorQuery + `
+WHERE user_id = $1` + filterQuery + cursorQuery + `
 ORDER BY create_time ASC, id ASC` + limitQuery
 
 	rows, err := db.QueryContext(ctx, query, params...)
@@ -243,7 +309,7 @@ ORDER BY create_time ASC, id ASC` + limitQuery
 		return nil, err
 	}
 
-	notifications := make([]*api.Notification, 0, limit)
+	notifications := make([]*apigrpc.InboxNotification, 0, limit)
 	var lastCreateTime int64
 	var resultCount int
 	var hasNextPage bool
@@ -255,7 +321,9 @@ ORDER BY create_time ASC, id ASC` + limitQuery
 		}
 		no := &api.Notification{Persistent: true, CreateTime: &timestamppb.Timestamp{}}
 		var createTime pgtype.Timestamptz
-		if err := rows.Scan(&no.Id, &no.Subject, &no.Content, &no.Code, &no.SenderId, &createTime); err != nil {
+		var readTime pgtype.Timestamptz
+		var expiryTime pgtype.Timestamptz
+		if err := rows.Scan(&no.Id, &no.Subject, &no.Content, &no.Code, &no.SenderId, &createTime, &readTime, &expiryTime); err != nil {
 			_ = rows.Close()
 			logger.Error("Could not scan notification from database.", zap.Error(err))
 			return nil, err
@@ -266,29 +334,36 @@ ORDER BY create_time ASC, id ASC` + limitQuery
 		if no.SenderId == uuid.Nil.String() {
 			no.SenderId = ""
 		}
-		notifications = append(notifications, no)
+		inboxNotification := &apigrpc.InboxNotification{Notification: no}
+		if readTime.Valid {
+			inboxNotification.ReadTime = &timestamppb.Timestamp{Seconds: readTime.Time.Unix()}
+		}
+		if expiryTime.Valid {
+			inboxNotification.ExpiryTime = &timestamppb.Timestamp{Seconds: expiryTime.Time.Unix()}
+		}
+		notifications = append(notifications, inboxNotification)
 	}
 	_ = rows.Close()
 
-	notificationList := &api.NotificationList{}
+	notificationInbox := &apigrpc.NotificationInbox{}
 	cursorBuf := new(bytes.Buffer)
 	if len(notifications) == 0 {
 		if cacheable {
 			if len(cursor) > 0 {
-				notificationList.CacheableCursor = cursor
+				notificationInbox.CacheableCursor = cursor
 			} else {
 				newCursor := &notificationCacheableCursor{NotificationID: nil, CreateTime: 0}
 				if err := gob.NewEncoder(cursorBuf).Encode(newCursor); err != nil {
 					logger.Error("Could not create new cursor.", zap.Error(err))
 					return nil, err
 				}
-				notificationList.CacheableCursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
+				notificationInbox.CacheableCursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
 			}
 		}
 	} else {
-		notificationList.Notifications = notifications
+		notificationInbox.Notifications = notifications
 		if cacheable || hasNextPage {
-			lastNotification := notifications[len(notifications)-1]
+			lastNotification := notifications[len(notifications)-1].Notification
 			newCursor := &notificationCacheableCursor{
 				NotificationID: uuid.FromStringOrNil(lastNotification.Id).Bytes(),
 				CreateTime:     lastCreateTime,
@@ -298,28 +373,133 @@ ORDER BY create_time ASC, id ASC` + limitQuery
 				return nil, err
 			}
 
-			notificationList.CacheableCursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
+			notificationInbox.CacheableCursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
 		}
 	}
 
-	return notificationList, nil
+	return notificationInbox, nil
 }
 
-func NotificationDelete(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, notificationIDs []string) error {
+func NotificationDelete(ctx context.Context, logger *zap.Logger, db *sql.DB, messageRouter MessageRouter, userID uuid.UUID, notificationIDs []string) error {
 	params := []any{userID, notificationIDs}
 
-	query := "DELETE FROM notification WHERE user_id = $1 AND id = ANY($2)"
+	query := "DELETE FROM notification WHERE user_id = $1 AND id = ANY($2) RETURNING id"
 	logger.Debug("Delete notification query", zap.String("query", query), zap.Any("params", params))
-	_, err := db.ExecContext(ctx, query, params...)
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Could not delete notifications.", zap.Error(err))
+		return err
+	}
+	deletedIDs, err := notificationScanIDs(rows)
 	if err != nil {
 		logger.Error("Could not delete notifications.", zap.Error(err))
 		return err
 	}
 
+	notificationSendStateUpdate(logger, messageRouter, NotificationCodeDelete, map[uuid.UUID][]string{userID: deletedIDs})
+
+	return nil
+}
+
+// NotificationsRead marks notifications as read, notifications that were already read keep their original read time.
+func NotificationsRead(ctx context.Context, logger *zap.Logger, db *sql.DB, messageRouter MessageRouter, userID uuid.UUID, notificationIDs []string) error {
+	query := "UPDATE notification SET read_time = now() WHERE user_id = $1 AND id = ANY($2) AND read_time IS NULL RETURNING id"
+	rows, err := db.QueryContext(ctx, query, userID, notificationIDs)
+	if err != nil {
+		logger.Error("Could not mark notifications as read.", zap.Error(err))
+		return err
+	}
+	readIDs, err := notificationScanIDs(rows)
+	if err != nil {
+		logger.Error("Could not mark notifications as read.", zap.Error(err))
+		return err
+	}
+
+	notificationSendStateUpdate(logger, messageRouter, NotificationCodeRead, map[uuid.UUID][]string{userID: readIDs})
+
 	return nil
 }
 
-func NotificationSave(ctx context.Context, logger *zap.Logger, db *sql.DB, notifications map[uuid.UUID][]*api.Notification) error {
+// NotificationsDeleteExpired removes notifications whose expiry time has passed, at most batchSize at a time so no
+// single statement holds locks on a large number of rows.
+func NotificationsDeleteExpired(ctx context.Context, logger *zap.Logger, db *sql.DB, batchSize int) (int64, error) {
+	query := "DELETE FROM notification WHERE id IN (SELECT id FROM notification WHERE expiry_time <= now() LIMIT $1)"
+	var total int64
+	for {
+		result, err := db.ExecContext(ctx, query, batchSize)
+		if err != nil {
+			logger.Error("Could not delete expired notifications.", zap.Error(err))
+			return total, err
+		}
+		count, _ := result.RowsAffected()
+		total += count
+		if count < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+func notificationScanIDs(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	ids := make([]string, 0, 1)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Inform all sessions of the given users that some of their notifications have changed state.
+func notificationSendStateUpdate(logger *zap.Logger, messageRouter MessageRouter, code int32, notificationIDs map[uuid.UUID][]string) {
+	for userID, ids := range notificationIDs {
+		if len(ids) == 0 {
+			continue
+		}
+
+		content, err := json.Marshal(map[string][]string{"ids": ids})
+		if err != nil {
+			logger.Warn("Failed to encode notification state update", zap.Error(err))
+			continue
+		}
+
+		messageRouter.SendToStream(logger, PresenceStream{Mode: StreamModeNotifications, Subject: userID}, &rtapi.Envelope{
+			Message: &rtapi.Envelope_Notifications{
+				Notifications: &rtapi.Notifications{
+					Notifications: []*api.Notification{{
+						Id:         uuid.Must(uuid.NewV4()).String(),
+						Subject:    notificationStateUpdateSubject(code),
+						Content:    string(content),
+						Code:       code,
+						CreateTime: &timestamppb.Timestamp{Seconds: time.Now().Unix()},
+					}},
+				},
+			},
+		}, true)
+	}
+}
+
+func notificationStateUpdateSubject(code int32) string {
+	if code == NotificationCodeRead {
+		return "Notifications read"
+	}
+	return "Notifications deleted"
+}
+
+func NotificationSave(ctx context.Context, logger *zap.Logger, db *sql.DB, notifications map[uuid.UUID][]*api.Notification, expiryTime time.Time) error {
+	return notificationSave(ctx, logger, db, notifications, expiryTime)
+}
+
+// Implemented by both *sql.DB and *sql.Tx.
+type notificationExecer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func notificationSave(ctx context.Context, logger *zap.Logger, db notificationExecer, notifications map[uuid.UUID][]*api.Notification, expiryTime time.Time) error {
 	ids := make([]string, 0, len(notifications))
 	userIds := make([]uuid.UUID, 0, len(notifications))
 	subjects := make([]string, 0, len(notifications))
@@ -328,14 +508,15 @@ func NotificationSave(ctx context.Context, logger *zap.Logger, db *sql.DB, notif
 	senderIds := make([]string, 0, len(notifications))
 	query := `
 INSERT INTO
-	notification (id, user_id, subject, content, code, sender_id)
+	notification (id, user_id, subject, content, code, sender_id, expiry_time)
 SELECT
 	unnest($1::uuid[]),
 	unnest($2::uuid[]),
 	unnest($3::text[]),
 	unnest($4::jsonb[]),
 	unnest($5::smallint[]),
-	unnest($6::uuid[]);
+	unnest($6::uuid[]),
+	$7::TIMESTAMPTZ;
 `
 	for userID, no := range notifications {
 		for _, un := range no {
@@ -348,7 +529,12 @@ SELECT
 		}
 	}
 
-	if _, err := db.ExecContext(ctx, query, ids, userIds, subjects, contents, codes, senderIds); err != nil {
+	var expiry *pgtype.Timestamptz
+	if !expiryTime.IsZero() {
+		expiry = &pgtype.Timestamptz{Time: expiryTime.UTC(), Valid: true}
+	}
+
+	if _, err := db.ExecContext(ctx, query, ids, userIds, subjects, contents, codes, senderIds, expiry); err != nil {
 		logger.Error("Could not save notifications.", zap.Error(err))
 		return err
 	}
@@ -410,7 +596,7 @@ func NotificationsGetId(ctx context.Context, logger *zap.Logger, db *sql.DB, use
 	return notifications, nil
 }
 
-func NotificationsDeleteId(ctx context.Context, logger *zap.Logger, db *sql.DB, userID string, ids ...string) error {
+func NotificationsDeleteId(ctx context.Context, logger *zap.Logger, db *sql.DB, messageRouter MessageRouter, userID string, ids ...string) error {
 	if len(ids) == 0 {
 		// NOOP
 		return nil
@@ -428,13 +614,32 @@ func NotificationsDeleteId(ctx context.Context, logger *zap.Logger, db *sql.DB,
 			return errors.New("expects id to be a valid uuid")
 		}
 
-		return NotificationDelete(ctx, logger, db, uid, ids)
+		return NotificationDelete(ctx, logger, db, messageRouter, uid, ids)
 	}
 
-	if _, err := db.QueryContext(ctx, "DELETE FROM notification WHERE id = any($1)", ids); err != nil {
+	rows, err := db.QueryContext(ctx, "DELETE FROM notification WHERE id = any($1) RETURNING user_id, id", ids)
+	if err != nil {
 		logger.Error("failed to delete notifications by id", zap.Error(err))
 		return fmt.Errorf("failed to delete notifications: %s", err.Error())
 	}
+	defer rows.Close()
+
+	deletedIDs := make(map[uuid.UUID][]string, 1)
+	for rows.Next() {
+		var uid uuid.UUID
+		var id string
+		if err := rows.Scan(&uid, &id); err != nil {
+			logger.Error("failed to scan deleted notification", zap.Error(err))
+			return fmt.Errorf("failed to delete notifications: %s", err.Error())
+		}
+		deletedIDs[uid] = append(deletedIDs[uid], id)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to delete notifications by id", zap.Error(err))
+		return fmt.Errorf("failed to delete notifications: %s", err.Error())
+	}
+
+	notificationSendStateUpdate(logger, messageRouter, NotificationCodeDelete, deletedIDs)
 
 	return nil
 }
@@ -0,0 +1,114 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrNotificationScheduleNotFound = errors.New("scheduled notification not found")
+
+// A notification waiting to be sent at a future time.
+type notificationScheduled struct {
+	UserID       uuid.UUID
+	Notification *api.Notification
+	ExpirySec    int
+}
+
+// NotificationSchedule stores a notification to be sent to a user at the given send time, and returns its ID.
+func NotificationSchedule(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, notification *api.Notification, expirySec int, sendTime time.Time) (string, error) {
+	id := uuid.Must(uuid.NewV4())
+
+	senderID := notification.SenderId
+	if senderID == "" {
+		senderID = uuid.Nil.String()
+	}
+
+	query := `
+INSERT INTO notification_schedule (id, user_id, subject, content, code, sender_id, persistent, expiry_sec, send_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	if _, err := db.ExecContext(ctx, query, id, userID, notification.Subject, notification.Content, notification.Code, senderID, notification.Persistent, expirySec, &pgtype.Timestamptz{Time: sendTime.UTC(), Valid: true}); err != nil {
+		logger.Error("Could not schedule notification.", zap.Error(err), zap.String("user_id", userID.String()))
+		return "", err
+	}
+
+	return id.String(), nil
+}
+
+// NotificationScheduleCancel removes a scheduled notification that has not been sent yet.
+func NotificationScheduleCancel(ctx context.Context, logger *zap.Logger, db *sql.DB, id uuid.UUID) error {
+	result, err := db.ExecContext(ctx, "DELETE FROM notification_schedule WHERE id = $1", id)
+	if err != nil {
+		logger.Error("Could not cancel scheduled notification.", zap.Error(err), zap.String("id", id.String()))
+		return err
+	}
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return ErrNotificationScheduleNotFound
+	}
+
+	return nil
+}
+
+// Lock and return scheduled notifications that are due to be sent. Rows locked by another transaction are skipped, so
+// each scheduled notification is returned to one caller at a time even with several servers processing the schedule
+// concurrently. They stay scheduled until removed with notificationScheduleDelete in the same transaction.
+func notificationScheduleClaimDue(ctx context.Context, tx *sql.Tx, limit int) ([]*notificationScheduled, error) {
+	query := `
+SELECT id, user_id, subject, content, code, sender_id, persistent, expiry_sec
+FROM notification_schedule
+WHERE send_time <= now()
+ORDER BY send_time ASC, id ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED`
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now := timestamppb.Now()
+	scheduled := make([]*notificationScheduled, 0, limit)
+	for rows.Next() {
+		s := &notificationScheduled{Notification: &api.Notification{CreateTime: now}}
+		if err := rows.Scan(&s.Notification.Id, &s.UserID, &s.Notification.Subject, &s.Notification.Content, &s.Notification.Code, &s.Notification.SenderId, &s.Notification.Persistent, &s.ExpirySec); err != nil {
+			return nil, err
+		}
+		scheduled = append(scheduled, s)
+	}
+
+	return scheduled, rows.Err()
+}
+
+// Remove scheduled notifications once they have been sent.
+func notificationScheduleDelete(ctx context.Context, tx *sql.Tx, ids []string) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM notification_schedule WHERE id = ANY($1::UUID[])", ids)
+	return err
+}
+
+// Push back the send time of scheduled notifications that could not be sent, so they are tried again later without
+// holding up other notifications that are due.
+func notificationScheduleRetry(ctx context.Context, db *sql.DB, ids []string, delay time.Duration) error {
+	_, err := db.ExecContext(ctx, "UPDATE notification_schedule SET send_time = $2 WHERE id = ANY($1::UUID[])", ids, &pgtype.Timestamptz{Time: time.Now().UTC().Add(delay), Valid: true})
+	return err
+}
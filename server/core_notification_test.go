@@ -0,0 +1,94 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationInbox(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+	tracker := &LocalTracker{}
+	router := &DummyMessageRouter{}
+
+	userID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userID)
+
+	send := func(code int32, expiryTime time.Time) string {
+		id := uuid.Must(uuid.NewV4()).String()
+		require.NoError(t, NotificationSendWithExpiry(ctx, logger, db, tracker, router, map[uuid.UUID][]*api.Notification{
+			userID: {{Id: id, Subject: "test", Content: "{}", Code: code, SenderId: uuid.Nil.String(), Persistent: true}},
+		}, expiryTime))
+		return id
+	}
+	list := func(filter *NotificationListFilter) map[int32]*apigrpc.InboxNotification {
+		inbox, err := NotificationInboxList(ctx, logger, db, userID, 100, "", false, filter)
+		require.NoError(t, err)
+		byCode := make(map[int32]*apigrpc.InboxNotification, len(inbox.Notifications))
+		for _, n := range inbox.Notifications {
+			byCode[n.Notification.Code] = n
+		}
+		return byCode
+	}
+
+	low := send(1, time.Time{})
+	send(50, time.Time{})
+	send(150, time.Now().UTC().Add(time.Hour))
+	expired := send(2, time.Now().UTC().Add(time.Hour))
+	_, err := db.ExecContext(ctx, "UPDATE notification SET expiry_time = now() - INTERVAL '1 minute' WHERE id = $1", expired)
+	require.NoError(t, err)
+
+	// Expired notifications are never listed, even before they're removed.
+	all := list(nil)
+	assert.Len(t, all, 3)
+	assert.NotContains(t, all, int32(2))
+	assert.Nil(t, all[1].ExpiryTime)
+	assert.NotNil(t, all[150].ExpiryTime)
+
+	inRanges := list(&NotificationListFilter{CodeRanges: []*apigrpc.NotificationCodeRange{{MinCode: 1, MaxCode: 10}, {MinCode: 100, MaxCode: 200}}})
+	assert.Len(t, inRanges, 2)
+	assert.Contains(t, inRanges, int32(1))
+	assert.Contains(t, inRanges, int32(150))
+
+	// Marking notifications as read keeps the time they were first read.
+	require.NoError(t, NotificationsRead(ctx, logger, db, router, userID, []string{low}))
+	_, err = db.ExecContext(ctx, "UPDATE notification SET read_time = now() - INTERVAL '1 hour' WHERE id = $1", low)
+	require.NoError(t, err)
+	require.NoError(t, NotificationsRead(ctx, logger, db, router, userID, []string{low}))
+	all = list(nil)
+	require.NotNil(t, all[1].ReadTime)
+	assert.Less(t, all[1].ReadTime.AsTime(), time.Now().Add(-30*time.Minute))
+	assert.Nil(t, all[50].ReadTime)
+	unread := list(&NotificationListFilter{UnreadOnly: true})
+	assert.Len(t, unread, 2)
+	assert.NotContains(t, unread, int32(1))
+
+	// Expired notifications are removed in batches.
+	_, err = NotificationsDeleteExpired(ctx, logger, db, 1)
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM notification WHERE user_id = $1", userID).Scan(&count))
+	assert.Equal(t, 3, count)
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type pushDevice struct {
+	Token    string
+	Platform apigrpc.PushDevice_Platform
+}
+
+func PushDeviceAdd(ctx context.Context, logger *zap.Logger, db *sql.DB, maxDevices int, userID uuid.UUID, platform apigrpc.PushDevice_Platform, token string) error {
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		// A device token belongs to whichever user registered it most recently.
+		query := `
+INSERT INTO user_push_device (token, user_id, platform)
+VALUES ($1, $2, $3)
+ON CONFLICT (token) DO UPDATE SET user_id = $2, platform = $3, update_time = now()`
+		if _, err := tx.ExecContext(ctx, query, token, userID, platform); err != nil {
+			return err
+		}
+
+		// Drop the least recently registered tokens above the per-user limit.
+		query = `
+DELETE FROM user_push_device
+WHERE user_id = $1 AND token NOT IN (
+	SELECT token FROM user_push_device WHERE user_id = $1 ORDER BY update_time DESC LIMIT $2
+)`
+		_, err := tx.ExecContext(ctx, query, userID, maxDevices)
+		return err
+	}); err != nil {
+		logger.Error("Could not add push device.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
+
+	return nil
+}
+
+func PushDeviceDelete(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, token string) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM user_push_device WHERE user_id = $1 AND token = $2", userID, token); err != nil {
+		logger.Error("Could not delete push device.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
+
+	return nil
+}
+
+func PushDeviceList(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (*apigrpc.PushDeviceList, error) {
+	rows, err := db.QueryContext(ctx, "SELECT token, platform, create_time, update_time FROM user_push_device WHERE user_id = $1 ORDER BY update_time DESC", userID)
+	if err != nil {
+		logger.Error("Could not list push devices.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	devices := make([]*apigrpc.PushDevice, 0, 1)
+	for rows.Next() {
+		device := &apigrpc.PushDevice{}
+		var createTime pgtype.Timestamptz
+		var updateTime pgtype.Timestamptz
+		if err := rows.Scan(&device.Token, &device.Platform, &createTime, &updateTime); err != nil {
+			logger.Error("Could not scan push device.", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, err
+		}
+		device.CreateTime = &timestamppb.Timestamp{Seconds: createTime.Time.Unix()}
+		device.UpdateTime = &timestamppb.Timestamp{Seconds: updateTime.Time.Unix()}
+		devices = append(devices, device)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not list push devices.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+
+	return &apigrpc.PushDeviceList{Devices: devices}, nil
+}
+
+func PushPreferencesGet(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (*apigrpc.PushPreferences, error) {
+	rows, err := db.QueryContext(ctx, "SELECT code FROM user_push_preference WHERE user_id = $1 ORDER BY code ASC", userID)
+	if err != nil {
+		logger.Error("Could not get push preferences.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	preferences := &apigrpc.PushPreferences{DisabledCodes: make([]int32, 0)}
+	for rows.Next() {
+		var code int32
+		if err := rows.Scan(&code); err != nil {
+			logger.Error("Could not scan push preference.", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, err
+		}
+		preferences.DisabledCodes = append(preferences.DisabledCodes, code)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Could not get push preferences.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func PushPreferencesUpdate(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, disabledCodes []int32) error {
+	if err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_push_preference WHERE user_id = $1", userID); err != nil {
+			return err
+		}
+		if len(disabledCodes) == 0 {
+			return nil
+		}
+		_, err := tx.ExecContext(ctx, "INSERT INTO user_push_preference (user_id, code) SELECT $1, unnest($2::smallint[]) ON CONFLICT (user_id, code) DO NOTHING", userID, disabledCodes)
+		return err
+	}); err != nil {
+		logger.Error("Could not update push preferences.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
+
+	return nil
+}
+
+func pushDevicesByUser(ctx context.Context, db *sql.DB, userIDs []uuid.UUID) (map[uuid.UUID][]*pushDevice, error) {
+	rows, err := db.QueryContext(ctx, "SELECT user_id, token, platform FROM user_push_device WHERE user_id = ANY($1::UUID[])", userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	devices := make(map[uuid.UUID][]*pushDevice, len(userIDs))
+	for rows.Next() {
+		var userID uuid.UUID
+		device := &pushDevice{}
+		if err := rows.Scan(&userID, &device.Token, &device.Platform); err != nil {
+			return nil, err
+		}
+		devices[userID] = append(devices[userID], device)
+	}
+
+	return devices, rows.Err()
+}
+
+func pushPreferencesDisabledCodes(ctx context.Context, db *sql.DB, userIDs []uuid.UUID) (map[uuid.UUID]map[int32]struct{}, error) {
+	rows, err := db.QueryContext(ctx, "SELECT user_id, code FROM user_push_preference WHERE user_id = ANY($1::UUID[])", userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	disabledCodes := make(map[uuid.UUID]map[int32]struct{}, len(userIDs))
+	for rows.Next() {
+		var userID uuid.UUID
+		var code int32
+		if err := rows.Scan(&userID, &code); err != nil {
+			return nil, err
+		}
+		codes, found := disabledCodes[userID]
+		if !found {
+			codes = make(map[int32]struct{}, 1)
+			disabledCodes[userID] = codes
+		}
+		codes[code] = struct{}{}
+	}
+
+	return disabledCodes, rows.Err()
+}
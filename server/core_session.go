@@ -26,18 +26,22 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// Name of the event sent to runtime event handlers when a refresh token is presented again after it was rotated.
+const RuntimeEventRefreshTokenReuse = "refresh_token_reuse"
+
 var (
-	ErrSessionTokenInvalid = errors.New("session token invalid")
-	ErrRefreshTokenInvalid = errors.New("refresh token invalid")
+	ErrSessionTokenInvalid   = errors.New("session token invalid")
+	ErrRefreshTokenInvalid   = errors.New("refresh token invalid")
+	ErrActiveSessionNotFound = errors.New("active session not found")
 )
 
-func SessionRefresh(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sessionCache SessionCache, token string) (uuid.UUID, string, map[string]string, string, int64, error) {
-	userID, _, vars, exp, tokenId, tokenIssuedAt, ok := parseToken([]byte(config.GetSession().RefreshEncryptionKey), token)
+func SessionRefresh(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sessionCache SessionCache, token string) (uuid.UUID, string, map[string]string, string, string, int64, error) {
+	claims, userID, ok := parseTokenClaims(hmacKeyFunc([]byte(config.GetSession().RefreshEncryptionKey)), token)
 	if !ok {
-		return uuid.Nil, "", nil, "", 0, status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
+		return uuid.Nil, "", nil, "", "", 0, status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
 	}
-	if !sessionCache.IsValidRefresh(userID, exp, tokenId) {
-		return uuid.Nil, "", nil, "", 0, status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
+	if !sessionCache.IsValidRefresh(userID, claims.ExpiresAt, claims.TokenId) {
+		return uuid.Nil, "", nil, "", "", 0, status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
 	}
 
 	// Look for an existing account.
@@ -48,19 +52,19 @@ func SessionRefresh(ctx context.Context, logger *zap.Logger, db *sql.DB, config
 	if err != nil {
 		if err == sql.ErrNoRows {
 			// Account not found and creation is never allowed for this type.
-			return uuid.Nil, "", nil, "", 0, status.Error(codes.NotFound, "User account not found.")
+			return uuid.Nil, "", nil, "", "", 0, status.Error(codes.NotFound, "User account not found.")
 		}
 		logger.Error("Error looking up user by ID.", zap.Error(err), zap.String("id", userID.String()))
-		return uuid.Nil, "", nil, "", 0, status.Error(codes.Internal, "Error finding user account.")
+		return uuid.Nil, "", nil, "", "", 0, status.Error(codes.Internal, "Error finding user account.")
 	}
 
 	// Check if it's disabled.
 	if dbDisableTime.Valid && dbDisableTime.Time.Unix() != 0 {
 		logger.Info("User account is disabled.", zap.String("id", userID.String()))
-		return uuid.Nil, "", nil, "", 0, status.Error(codes.PermissionDenied, "User account banned.")
+		return uuid.Nil, "", nil, "", "", 0, status.Error(codes.PermissionDenied, "User account banned.")
 	}
 
-	return userID, dbUsername, vars, tokenId, tokenIssuedAt, nil
+	return userID, dbUsername, claims.Vars, claims.TokenId, claims.RefreshId, claims.IssuedAt, nil
 }
 
 func SessionLogout(config Config, sessionCache SessionCache, userID uuid.UUID, token, refreshToken string) error {
@@ -69,7 +73,7 @@ func SessionLogout(config Config, sessionCache SessionCache, userID uuid.UUID, t
 	if token != "" {
 		var sessionUserID uuid.UUID
 		var ok bool
-		sessionUserID, _, _, maybeSessionExp, maybeSessionTokenId, _, ok = parseToken([]byte(config.GetSession().EncryptionKey), token)
+		sessionUserID, _, _, maybeSessionExp, maybeSessionTokenId, _, ok = parseToken(sessionTokenKeyFunc(config.GetSession()), token)
 		if !ok || sessionUserID != userID {
 			return ErrSessionTokenInvalid
 		}
@@ -80,7 +84,7 @@ func SessionLogout(config Config, sessionCache SessionCache, userID uuid.UUID, t
 	if refreshToken != "" {
 		var refreshUserID uuid.UUID
 		var ok bool
-		refreshUserID, _, _, maybeRefreshExp, maybeRefreshTokenId, _, ok = parseToken([]byte(config.GetSession().RefreshEncryptionKey), refreshToken)
+		refreshUserID, _, _, maybeRefreshExp, maybeRefreshTokenId, _, ok = parseToken(hmacKeyFunc([]byte(config.GetSession().RefreshEncryptionKey)), refreshToken)
 		if !ok || refreshUserID != userID {
 			return ErrRefreshTokenInvalid
 		}
@@ -94,3 +98,19 @@ func SessionLogout(config Config, sessionCache SessionCache, userID uuid.UUID, t
 	sessionCache.Remove(userID, maybeSessionExp, maybeSessionTokenId, maybeRefreshExp, maybeRefreshTokenId)
 	return nil
 }
+
+// SessionRevoke logs out one of a user's active sessions, invalidating both of its tokens.
+func SessionRevoke(sessionCache SessionCache, userID uuid.UUID, tokenID string) error {
+	for _, active := range sessionCache.List(userID) {
+		if active.TokenID != tokenID {
+			continue
+		}
+		var refreshTokenID string
+		if active.RefreshExpiry > 0 {
+			refreshTokenID = tokenID
+		}
+		sessionCache.Remove(userID, active.SessionExpiry, tokenID, active.RefreshExpiry, refreshTokenID)
+		return nil
+	}
+	return ErrActiveSessionNotFound
+}
@@ -50,7 +50,9 @@ func UnlinkApple(ctx context.Context, logger *zap.Logger, db *sql.DB, config Con
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -81,7 +83,9 @@ func UnlinkCustom(ctx context.Context, logger *zap.Logger, db *sql.DB, id uuid.U
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -110,7 +114,8 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
      OR steam_id IS NOT NULL
      OR email IS NOT NULL
      OR custom_id IS NOT NULL))
-   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1))`, id, deviceID)
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`, id, deviceID)
 		if err != nil {
 			logger.Debug("Could not unlink device ID.", zap.Error(err), zap.Any("input", deviceID))
 			return err
@@ -143,7 +148,7 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
 
 func UnlinkEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, id uuid.UUID, email string) error {
 	params := []any{id}
-	query := `UPDATE users SET email = NULL, password = NULL, update_time = now() WHERE id = $1`
+	query := `UPDATE users SET email = NULL, password = NULL, verify_time = '1970-01-01 00:00:00 UTC', update_time = now() WHERE id = $1`
 
 	if email != "" {
 		cleanEmail := strings.ToLower(email)
@@ -160,7 +165,9 @@ func UnlinkEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, id uuid.UU
       OR steam_id IS NOT NULL
       OR custom_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -199,7 +206,9 @@ func UnlinkFacebook(ctx context.Context, logger *zap.Logger, db *sql.DB, socialC
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -235,7 +244,9 @@ func UnlinkFacebookInstantGame(ctx context.Context, logger *zap.Logger, db *sql.
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -271,7 +282,9 @@ func UnlinkGameCenter(ctx context.Context, logger *zap.Logger, db *sql.DB, socia
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -315,7 +328,9 @@ func UnlinkGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, socialCli
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -328,6 +343,49 @@ func UnlinkGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, socialCli
 	return nil
 }
 
+func UnlinkOIDC(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, id uuid.UUID, providerName, token string) error {
+	provider, err := oidcProvider(config, providerName)
+	if err != nil {
+		return err
+	}
+
+	params := []any{id, providerName}
+	query := `DELETE FROM user_identity WHERE user_id = $1 AND provider = $2`
+
+	if token != "" {
+		oidcProfile, err := socialClient.CheckOIDCToken(ctx, provider, token)
+		if err != nil {
+			logger.Info("Could not authenticate OpenID Connect profile.", zap.Error(err), zap.String("provider", providerName))
+			return status.Error(codes.Unauthenticated, "Could not authenticate OpenID Connect profile.")
+		}
+		params = append(params, oidcProfile.Subject)
+		query = query + ` AND subject = $3`
+	}
+
+	query = query +
+		` AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
+    (apple_id IS NOT NULL
+     OR facebook_id IS NOT NULL
+     OR facebook_instant_game_id IS NOT NULL
+     OR google_id IS NOT NULL
+     OR gamecenter_id IS NOT NULL
+     OR steam_id IS NOT NULL
+     OR email IS NOT NULL
+     OR custom_id IS NOT NULL))
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 AND provider <> $2 LIMIT 1))`
+
+	res, err := db.ExecContext(ctx, query, params...)
+
+	if err != nil {
+		logger.Error("Could not unlink OpenID Connect identity.", zap.Error(err), zap.String("provider", providerName), zap.Any("input", token))
+		return status.Error(codes.Internal, "Error while trying to unlink OpenID Connect identity.")
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return status.Error(codes.PermissionDenied, "Cannot unlink last account identifier. Check profile exists and is not last link.")
+	}
+	return nil
+}
+
 func UnlinkSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, id uuid.UUID, token string) error {
 	params := []any{id}
 	query := `UPDATE users SET steam_id = NULL, update_time = now() WHERE id = $1`
@@ -351,7 +409,9 @@ func UnlinkSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, config Con
       OR google_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
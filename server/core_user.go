@@ -163,8 +163,15 @@ func DeleteUser(ctx context.Context, tx *sql.Tx, userID uuid.UUID) (int64, error
 }
 
 func BanUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sessionCache SessionCache, sessionRegistry SessionRegistry, tracker Tracker, ids []uuid.UUID) error {
-	query := "UPDATE users SET disable_time = now() WHERE id = ANY($1::UUID[])"
-	_, err := db.ExecContext(ctx, query, ids)
+	err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		query := "UPDATE users SET disable_time = now() WHERE id = ANY($1::UUID[])"
+		if _, err := tx.ExecContext(ctx, query, ids); err != nil {
+			return err
+		}
+		// Accounts pending deletion stay banned if they are restored.
+		_, err := tx.ExecContext(ctx, "UPDATE user_deletion SET disable_time = now() WHERE user_id = ANY($1::UUID[])", ids)
+		return err
+	})
 	if err != nil {
 		logger.Error("Error banning user accounts.", zap.Error(err), zap.Any("ids", ids))
 		return err
@@ -184,11 +191,26 @@ func BanUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config
 	return nil
 }
 
+// UnbanUsers enables banned accounts again. Accounts pending deletion are disabled until they are deleted, and must be
+// restored with RestoreAccount instead, so the whole call is rejected if any of them is pending deletion.
 func UnbanUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, sessionCache SessionCache, ids []uuid.UUID) error {
-	query := "UPDATE users SET disable_time = '1970-01-01 00:00:00 UTC' WHERE id = ANY($1::UUID[])"
-	_, err := db.ExecContext(ctx, query, ids)
+	err := ExecuteInTx(ctx, db, func(tx *sql.Tx) error {
+		var pending bool
+		if err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM user_deletion WHERE user_id = ANY($1::UUID[]))", ids).Scan(&pending); err != nil {
+			return err
+		}
+		if pending {
+			return ErrAccountDeletionPending
+		}
+
+		query := "UPDATE users SET disable_time = '1970-01-01 00:00:00 UTC' WHERE id = ANY($1::UUID[])"
+		_, err := tx.ExecContext(ctx, query, ids)
+		return err
+	})
 	if err != nil {
-		logger.Error("Error unbanning user accounts.", zap.Error(err), zap.Any("ids", ids))
+		if err != ErrAccountDeletionPending {
+			logger.Error("Error unbanning user accounts.", zap.Error(err), zap.Any("ids", ids))
+		}
 		return err
 	}
 
@@ -24,7 +24,8 @@ import (
 )
 
 const (
-	dbErrorUniqueViolation = pgerrcode.UniqueViolation
+	dbErrorUniqueViolation     = pgerrcode.UniqueViolation
+	dbErrorForeignKeyViolation = pgerrcode.ForeignKeyViolation
 )
 
 var ErrRowsAffectedCount = errors.New("rows_affected_count")
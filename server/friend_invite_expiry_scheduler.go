@@ -0,0 +1,95 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
+)
+
+const friendInviteExpiryBatchSize = 1000
+
+// FriendInviteExpiryScheduler periodically removes pending friend invites that have expired.
+type FriendInviteExpiryScheduler interface {
+	Stop()
+}
+
+type LocalFriendInviteExpiryScheduler struct {
+	logger *zap.Logger
+	db     *sql.DB
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewLocalFriendInviteExpiryScheduler(logger *zap.Logger, db *sql.DB, config Config) FriendInviteExpiryScheduler {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	s := &LocalFriendInviteExpiryScheduler{
+		logger: logger,
+		db:     db,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	// Friend invites only expire if configured to do so.
+	expirySec := config.GetFriend().InviteExpirySec
+	if expirySec <= 0 {
+		return s
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(config.GetFriend().InviteCleanupSec) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				var total int64
+				for {
+					var count int64
+					if err := ExecuteInTx(s.ctx, s.db, func(tx *sql.Tx) error {
+						var err error
+						count, err = friendInvitesDeleteExpired(s.ctx, s.logger, tx, expirySec, uuid.Nil, friendInviteExpiryBatchSize)
+						return err
+					}); err != nil {
+						s.logger.Error("Could not remove expired friend invites.", zap.Error(err))
+						break
+					}
+					total += count
+					if count < friendInviteExpiryBatchSize {
+						break
+					}
+				}
+				if total > 0 {
+					s.logger.Debug("Removed expired friend invites", zap.Int64("count", total))
+				}
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *LocalFriendInviteExpiryScheduler) Stop() {
+	s.ctxCancelFn()
+}
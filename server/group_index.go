@@ -0,0 +1,322 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/blugelabs/bluge"
+	"github.com/blugelabs/bluge/search"
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"go.uber.org/zap"
+)
+
+var (
+	errGroupIndexCursorInvalid = errors.New("invalid group search cursor")
+	errGroupIndexQueryInvalid  = errors.New("invalid group search query")
+)
+
+// GroupIndex is an in-memory search index over all active groups, queried with the same query string syntax as
+// match listings and storage indices. Group fields are indexed under their own names, and metadata fields under
+// a "metadata." prefix.
+type GroupIndex interface {
+	Write(ctx context.Context, groups []*api.Group)
+	Delete(ctx context.Context, groupIDs []uuid.UUID)
+	Refresh(ctx context.Context, groupIDs []uuid.UUID)
+	List(ctx context.Context, query string, limit int, order []string, cursor string) (*api.GroupList, error)
+	Load(ctx context.Context) error
+}
+
+type LocalGroupIndex struct {
+	logger *zap.Logger
+	db     *sql.DB
+	index  *bluge.Writer
+}
+
+func NewLocalGroupIndex(logger *zap.Logger, db *sql.DB) (GroupIndex, error) {
+	idx, err := bluge.OpenWriter(BlugeInMemoryConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalGroupIndex{
+		logger: logger,
+		db:     db,
+		index:  idx,
+	}, nil
+}
+
+func (gi *LocalGroupIndex) Write(ctx context.Context, groups []*api.Group) {
+	batch := bluge.NewBatch()
+	for _, group := range groups {
+		doc, err := groupIndexDocument(group)
+		if err != nil {
+			gi.logger.Warn("Failed to map group to index document", zap.String("group_id", group.Id), zap.Error(err))
+			continue
+		}
+		batch.Update(doc.ID(), doc)
+	}
+
+	if err := gi.index.Batch(batch); err != nil {
+		gi.logger.Error("Failed to update group index", zap.Error(err))
+	}
+}
+
+func (gi *LocalGroupIndex) Delete(ctx context.Context, groupIDs []uuid.UUID) {
+	batch := bluge.NewBatch()
+	for _, groupID := range groupIDs {
+		batch.Delete(bluge.Identifier(groupID.String()))
+	}
+
+	if err := gi.index.Batch(batch); err != nil {
+		gi.logger.Error("Failed to evict entries from group index", zap.Error(err))
+	}
+}
+
+// Refresh re-reads the given groups from the database, updating their index entries or removing entries for groups
+// that no longer exist or have been disabled.
+func (gi *LocalGroupIndex) Refresh(ctx context.Context, groupIDs []uuid.UUID) {
+	ids := make([]string, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		ids = append(ids, groupID.String())
+	}
+
+	groups, err := GetGroups(ctx, gi.logger, gi.db, ids)
+	if err != nil {
+		gi.logger.Error("Failed to read groups to refresh group index", zap.Error(err))
+		return
+	}
+
+	found := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		found[group.Id] = struct{}{}
+	}
+	removed := make([]uuid.UUID, 0)
+	for _, groupID := range groupIDs {
+		if _, ok := found[groupID.String()]; !ok {
+			removed = append(removed, groupID)
+		}
+	}
+
+	if len(groups) > 0 {
+		gi.Write(ctx, groups)
+	}
+	if len(removed) > 0 {
+		gi.Delete(ctx, removed)
+	}
+}
+
+func (gi *LocalGroupIndex) List(ctx context.Context, query string, limit int, order []string, cursor string) (*api.GroupList, error) {
+	if query == "" {
+		query = "*"
+	}
+
+	var idxCursor *indexListCursor
+	if cursor != "" {
+		idxCursor = &indexListCursor{}
+		cb, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, errGroupIndexCursorInvalid
+		}
+		if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(idxCursor); err != nil {
+			return nil, errGroupIndexCursorInvalid
+		}
+		if query != idxCursor.Query || limit != idxCursor.Limit || !slices.Equal(order, idxCursor.Order) {
+			return nil, errGroupIndexCursorInvalid
+		}
+	}
+
+	parsedQuery, err := ParseQueryString(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errGroupIndexQueryInvalid, err.Error())
+	}
+
+	searchReq := bluge.NewTopNSearch(limit+1, parsedQuery)
+	if len(order) != 0 {
+		searchReq.SortBy(order)
+	}
+	if idxCursor != nil {
+		searchReq.SetFrom(idxCursor.Offset)
+	}
+
+	reader, err := gi.index.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	results, err := reader.Search(ctx, searchReq)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := groupIndexMatchesToIds(results)
+	if err != nil {
+		return nil, err
+	}
+
+	var newCursor string
+	if len(ids) > limit {
+		ids = ids[:limit]
+		offset := 0
+		if idxCursor != nil {
+			offset = idxCursor.Offset
+		}
+		cursorBuf := new(bytes.Buffer)
+		if err := gob.NewEncoder(cursorBuf).Encode(&indexListCursor{
+			Query:  query,
+			Offset: offset + limit,
+			Limit:  limit,
+			Order:  order,
+		}); err != nil {
+			gi.logger.Error("Failed to create new group search cursor.", zap.Error(err))
+			return nil, err
+		}
+		newCursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
+	}
+
+	if len(ids) == 0 {
+		return &api.GroupList{Groups: []*api.Group{}}, nil
+	}
+
+	groups, err := GetGroups(ctx, gi.logger, gi.db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return groups in the order the index ranked them, GetGroups does not guarantee any ordering.
+	groupsByID := make(map[string]*api.Group, len(groups))
+	for _, group := range groups {
+		groupsByID[group.Id] = group
+	}
+	sortedGroups := make([]*api.Group, 0, len(groups))
+	for _, id := range ids {
+		if group, ok := groupsByID[id]; ok {
+			sortedGroups = append(sortedGroups, group)
+		}
+	}
+
+	return &api.GroupList{Groups: sortedGroups, Cursor: newCursor}, nil
+}
+
+func (gi *LocalGroupIndex) Load(ctx context.Context) error {
+	t := time.Now()
+
+	query := `
+SELECT id, creator_id, name, description, avatar_url, state, edge_count, lang_tag, max_count, metadata, create_time, update_time
+FROM groups
+WHERE disable_time = '1970-01-01 00:00:00 UTC'
+ORDER BY id
+LIMIT $1`
+	params := []any{10_000}
+
+	var count int
+	for {
+		rows, err := gi.db.QueryContext(ctx, query, params...)
+		if err != nil {
+			return err
+		}
+		// Rows closed in groupConvertRows()
+
+		groups, _, err := groupConvertRows(rows, 10_000)
+		if err != nil {
+			return err
+		}
+		if len(groups) == 0 {
+			break
+		}
+
+		gi.Write(ctx, groups)
+		count += len(groups)
+
+		query = `
+SELECT id, creator_id, name, description, avatar_url, state, edge_count, lang_tag, max_count, metadata, create_time, update_time
+FROM groups
+WHERE disable_time = '1970-01-01 00:00:00 UTC' AND id > $2
+ORDER BY id
+LIMIT $1`
+		params = []any{10_000, groups[len(groups)-1].Id}
+	}
+
+	gi.logger.Info("Group index loaded.", zap.Int("count", count), zap.Int64("elapsed_time_ms", time.Since(t).Milliseconds()))
+
+	return nil
+}
+
+func groupIndexDocument(group *api.Group) (*bluge.Document, error) {
+	if group.Id == "" {
+		return nil, errors.New("insufficient fields to create group index document id")
+	}
+
+	open := "F"
+	if group.Open.GetValue() {
+		open = "T"
+	}
+
+	rv := bluge.NewDocument(group.Id)
+	rv.AddField(bluge.NewKeywordField("name", group.Name).Sortable())
+	rv.AddField(bluge.NewKeywordField("lang_tag", group.LangTag).Sortable())
+	rv.AddField(bluge.NewKeywordField("creator_id", group.CreatorId))
+	rv.AddField(bluge.NewKeywordField("open", open))
+	rv.AddField(bluge.NewNumericField("edge_count", float64(group.EdgeCount)).Sortable())
+	rv.AddField(bluge.NewNumericField("max_count", float64(group.MaxCount)).Sortable())
+	rv.AddField(bluge.NewDateTimeField("create_time", group.CreateTime.AsTime()).Sortable())
+	rv.AddField(bluge.NewDateTimeField("update_time", group.UpdateTime.AsTime()).Sortable())
+
+	// Metadata that is not a JSON object is left out of the index, the group's own fields remain searchable.
+	var metadata map[string]any
+	if err := json.Unmarshal([]byte(group.Metadata), &metadata); err == nil {
+		// Top level metadata fields can be sorted on, nested fields can only be queried.
+		sortMap := make(map[string]bool, len(metadata))
+		for key := range metadata {
+			sortMap["metadata."+key] = true
+		}
+		BlugeWalkDocument(metadata, []string{"metadata"}, sortMap, rv)
+	}
+
+	return rv, nil
+}
+
+func groupIndexMatchesToIds(dmi search.DocumentMatchIterator) ([]string, error) {
+	ids := make([]string, 0)
+	next, err := dmi.Next()
+	for err == nil && next != nil {
+		err = next.VisitStoredFields(func(field string, value []byte) bool {
+			if field == "_id" {
+				ids = append(ids, string(value))
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		next, err = dmi.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
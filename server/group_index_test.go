@@ -0,0 +1,82 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blugelabs/bluge"
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestGroupIndexQuery(t *testing.T) {
+	ctx := context.Background()
+	gi, err := NewLocalGroupIndex(logger, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	newGroup := func(name string, edgeCount int32, open bool, metadata string, updated time.Duration) *api.Group {
+		return &api.Group{
+			Id:         uuid.Must(uuid.NewV4()).String(),
+			Name:       name,
+			LangTag:    "en",
+			Open:       wrapperspb.Bool(open),
+			EdgeCount:  edgeCount,
+			MaxCount:   50,
+			Metadata:   metadata,
+			CreateTime: timestamppb.New(now),
+			UpdateTime: timestamppb.New(now.Add(updated)),
+		}
+	}
+	eu := newGroup("eu raiders", 10, true, `{"region":"eu","level":30,"style":{"pvp":true}}`, time.Minute)
+	us := newGroup("us raiders", 40, true, `{"region":"us","level":10}`, 2*time.Minute)
+	closed := newGroup("eu casuals", 5, false, `{"region":"eu","level":5}`, 3*time.Minute)
+	noMeta := newGroup("no metadata", 1, true, `[]`, 0)
+	gi.Write(ctx, []*api.Group{eu, us, closed, noMeta})
+
+	search := func(query string, order []string) []string {
+		parsed, err := ParseQueryString(query)
+		require.NoError(t, err)
+		req := bluge.NewTopNSearch(10, parsed)
+		if len(order) > 0 {
+			req.SortBy(order)
+		}
+		reader, err := gi.(*LocalGroupIndex).index.Reader()
+		require.NoError(t, err)
+		defer reader.Close()
+		results, err := reader.Search(ctx, req)
+		require.NoError(t, err)
+		ids, err := groupIndexMatchesToIds(results)
+		require.NoError(t, err)
+		return ids
+	}
+
+	assert.ElementsMatch(t, []string{eu.Id, closed.Id}, search("+metadata.region:eu", nil))
+	assert.Equal(t, []string{eu.Id}, search("+metadata.region:eu +open:T", nil))
+	assert.Equal(t, []string{eu.Id}, search("+metadata.level:>=20 +metadata.style.pvp:T", nil))
+	assert.Equal(t, []string{us.Id, eu.Id, closed.Id, noMeta.Id}, search("*", []string{"-edge_count"}))
+	assert.Equal(t, []string{closed.Id, us.Id, eu.Id}, search("+lang_tag:en +metadata.level:>0", []string{"-update_time"}))
+	assert.Equal(t, []string{closed.Id, us.Id, eu.Id}, search("+metadata.level:>0", []string{"metadata.level"}))
+
+	gi.Delete(ctx, []uuid.UUID{uuid.Must(uuid.FromString(closed.Id))})
+	assert.Equal(t, []string{eu.Id}, search("+metadata.region:eu", nil))
+}
@@ -16,8 +16,15 @@ package server
 
 import (
 	"context"
+	"database/sql"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
+
+	"github.com/heroiclabs/nakama-common/api"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type LockoutType uint8
@@ -28,13 +35,9 @@ const (
 	LockoutTypeIp
 )
 
-const (
-	maxAttemptsAccount   = 5
-	lockoutPeriodAccount = time.Minute * 1
-
-	maxAttemptsIp   = 10
-	lockoutPeriodIp = time.Minute * 10
-)
+// Name of the event sent to runtime event handlers when an account or IP address is locked out after repeated failed
+// login attempts.
+const RuntimeEventLoginLockout = "login_lockout"
 
 type LoginAttemptCache interface {
 	Stop()
@@ -44,6 +47,18 @@ type LoginAttemptCache interface {
 	Add(account, ip string) (LockoutType, time.Time)
 	// Reset account attempts on successful login.
 	Reset(account string)
+	// List active lockouts, only those for the given account or IP if either is set.
+	List(account, ip string) []*LoginLockout
+	// Clear lockouts and failed attempts for an account, an IP, or both.
+	Clear(account, ip string) error
+}
+
+// LoginLockout is an account or IP address locked out after repeated failed login attempts.
+type LoginLockout struct {
+	Type LockoutType
+	// The account or IP address locked out.
+	Key         string
+	LockedUntil time.Time
 }
 
 type lockoutStatus struct {
@@ -65,19 +80,33 @@ func (ls *lockoutStatus) trim(now time.Time, retentionPeriod time.Duration) bool
 	return ls.lockedUntil.IsZero() && len(ls.attempts) == 0
 }
 
+// LocalLoginAttemptCache counts failed login attempts in memory, and stores active lockouts in the database so they
+// are restored when the server restarts.
 type LocalLoginAttemptCache struct {
 	sync.RWMutex
 	ctx         context.Context
 	ctxCancelFn context.CancelFunc
 
+	logger  *zap.Logger
+	db      *sql.DB
+	config  Config
+	version string
+	runtime *Runtime
+
 	accountCache map[string]*lockoutStatus
 	ipCache      map[string]*lockoutStatus
 }
 
-func NewLocalLoginAttemptCache() LoginAttemptCache {
+func NewLocalLoginAttemptCache(logger *zap.Logger, db *sql.DB, config Config, version string, runtime *Runtime) LoginAttemptCache {
 	ctx, ctxCancelFn := context.WithCancel(context.Background())
 
 	c := &LocalLoginAttemptCache{
+		logger:  logger,
+		db:      db,
+		config:  config,
+		version: version,
+		runtime: runtime,
+
 		accountCache: make(map[string]*lockoutStatus),
 		ipCache:      make(map[string]*lockoutStatus),
 
@@ -85,6 +114,12 @@ func NewLocalLoginAttemptCache() LoginAttemptCache {
 		ctxCancelFn: ctxCancelFn,
 	}
 
+	if db != nil {
+		if err := c.load(); err != nil {
+			logger.Error("Error loading login lockouts.", zap.Error(err))
+		}
+	}
+
 	go func() {
 		ticker := time.NewTicker(10 * time.Minute)
 		for {
@@ -94,18 +129,26 @@ func NewLocalLoginAttemptCache() LoginAttemptCache {
 				return
 			case t := <-ticker.C:
 				now := t.UTC()
+				accountWindow := time.Duration(c.config.GetLockout().AccountWindowSec) * time.Second
+				ipWindow := time.Duration(c.config.GetLockout().IpWindowSec) * time.Second
 				c.Lock()
 				for account, status := range c.accountCache {
-					if status.trim(now, lockoutPeriodAccount) {
+					if status.trim(now, accountWindow) {
 						delete(c.accountCache, account)
 					}
 				}
 				for ip, status := range c.ipCache {
-					if status.trim(now, lockoutPeriodIp) {
+					if status.trim(now, ipWindow) {
 						delete(c.ipCache, ip)
 					}
 				}
 				c.Unlock()
+
+				if c.db != nil {
+					if _, err := c.db.ExecContext(c.ctx, "DELETE FROM login_lockout WHERE locked_until <= $1", now); err != nil && c.ctx.Err() == nil {
+						c.logger.Error("Error removing expired login lockouts.", zap.Error(err))
+					}
+				}
 			}
 		}
 	}()
@@ -113,6 +156,32 @@ func NewLocalLoginAttemptCache() LoginAttemptCache {
 	return c
 }
 
+func (c *LocalLoginAttemptCache) load() error {
+	rows, err := c.db.QueryContext(c.ctx, "SELECT lockout_type, key, locked_until FROM login_lockout WHERE locked_until > now()")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	c.Lock()
+	defer c.Unlock()
+	for rows.Next() {
+		var lockoutType LockoutType
+		var key string
+		var lockedUntil time.Time
+		if err = rows.Scan(&lockoutType, &key, &lockedUntil); err != nil {
+			return err
+		}
+		switch lockoutType {
+		case LockoutTypeAccount:
+			c.accountCache[key] = &lockoutStatus{lockedUntil: lockedUntil.UTC()}
+		case LockoutTypeIp:
+			c.ipCache[key] = &lockoutStatus{lockedUntil: lockedUntil.UTC()}
+		}
+	}
+	return rows.Err()
+}
+
 func (c *LocalLoginAttemptCache) Stop() {
 	c.ctxCancelFn()
 }
@@ -138,37 +207,129 @@ func (c *LocalLoginAttemptCache) Reset(account string) {
 
 func (c *LocalLoginAttemptCache) Add(account, ip string) (LockoutType, time.Time) {
 	now := time.Now().UTC()
-	var lockoutType LockoutType
-	var lockedUntil time.Time
+	lockoutConfig := c.config.GetLockout()
+	var lockouts []*LoginLockout
 	c.Lock()
-	defer c.Unlock()
-	if account != "" {
+	if account != "" && lockoutConfig.AccountMaxAttempts > 0 {
 		status, found := c.accountCache[account]
 		if !found {
 			status = &lockoutStatus{}
 			c.accountCache[account] = status
 		}
 		status.attempts = append(status.attempts, now)
-		_ = status.trim(now, lockoutPeriodAccount)
-		if len(status.attempts) >= maxAttemptsAccount {
-			status.lockedUntil = now.Add(lockoutPeriodAccount)
-			lockedUntil = status.lockedUntil
-			lockoutType = LockoutTypeAccount
+		_ = status.trim(now, time.Duration(lockoutConfig.AccountWindowSec)*time.Second)
+		if len(status.attempts) >= lockoutConfig.AccountMaxAttempts {
+			status.lockedUntil = now.Add(time.Duration(lockoutConfig.AccountLockoutSec) * time.Second)
+			lockouts = append(lockouts, &LoginLockout{Type: LockoutTypeAccount, Key: account, LockedUntil: status.lockedUntil})
+		}
+	}
+	if ip != "" && lockoutConfig.IpMaxAttempts > 0 {
+		status, found := c.ipCache[ip]
+		if !found {
+			status = &lockoutStatus{}
+			c.ipCache[ip] = status
+		}
+		status.attempts = append(status.attempts, now)
+		_ = status.trim(now, time.Duration(lockoutConfig.IpWindowSec)*time.Second)
+		if len(status.attempts) >= lockoutConfig.IpMaxAttempts {
+			status.lockedUntil = now.Add(time.Duration(lockoutConfig.IpLockoutSec) * time.Second)
+			lockouts = append(lockouts, &LoginLockout{Type: LockoutTypeIp, Key: ip, LockedUntil: status.lockedUntil})
+		}
+	}
+	c.Unlock()
+
+	if len(lockouts) == 0 {
+		return LockoutTypeNone, time.Time{}
+	}
+	for _, lockout := range lockouts {
+		c.store(lockout)
+		c.fireLockoutEvent(lockout, account, ip)
+	}
+	// An IP lockout takes precedence as it is the wider of the two.
+	lockout := lockouts[len(lockouts)-1]
+	return lockout.Type, lockout.LockedUntil
+}
+
+func (c *LocalLoginAttemptCache) List(account, ip string) []*LoginLockout {
+	now := time.Now().UTC()
+	lockouts := make([]*LoginLockout, 0)
+	c.RLock()
+	for key, status := range c.accountCache {
+		if (account != "" && key != account) || (account == "" && ip != "") || !status.lockedUntil.After(now) {
+			continue
+		}
+		lockouts = append(lockouts, &LoginLockout{Type: LockoutTypeAccount, Key: key, LockedUntil: status.lockedUntil})
+	}
+	for key, status := range c.ipCache {
+		if (ip != "" && key != ip) || (ip == "" && account != "") || !status.lockedUntil.After(now) {
+			continue
+		}
+		lockouts = append(lockouts, &LoginLockout{Type: LockoutTypeIp, Key: key, LockedUntil: status.lockedUntil})
+	}
+	c.RUnlock()
+
+	sort.Slice(lockouts, func(i, j int) bool {
+		if lockouts[i].Type != lockouts[j].Type {
+			return lockouts[i].Type < lockouts[j].Type
 		}
+		return lockouts[i].Key < lockouts[j].Key
+	})
+	return lockouts
+}
+
+func (c *LocalLoginAttemptCache) Clear(account, ip string) error {
+	c.Lock()
+	if account != "" {
+		delete(c.accountCache, account)
+	}
+	if ip != "" {
+		delete(c.ipCache, ip)
+	}
+	c.Unlock()
+
+	if c.db == nil {
+		return nil
+	}
+	_, err := c.db.ExecContext(c.ctx, "DELETE FROM login_lockout WHERE (lockout_type = $1 AND key = $2) OR (lockout_type = $3 AND key = $4)", LockoutTypeAccount, account, LockoutTypeIp, ip)
+	return err
+}
+
+func (c *LocalLoginAttemptCache) store(lockout *LoginLockout) {
+	if c.db == nil {
+		return
+	}
+	if _, err := c.db.ExecContext(c.ctx, `
+INSERT INTO login_lockout (lockout_type, key, locked_until)
+VALUES ($1, $2, $3)
+ON CONFLICT (lockout_type, key) DO UPDATE SET create_time = now(), locked_until = $3`, lockout.Type, lockout.Key, lockout.LockedUntil); err != nil {
+		// The lockout still applies until the server restarts.
+		c.logger.Error("Error storing login lockout.", zap.Error(err), zap.Uint8("lockout_type", uint8(lockout.Type)), zap.String("key", lockout.Key))
+	}
+}
+
+func (c *LocalLoginAttemptCache) fireLockoutEvent(lockout *LoginLockout, account, ip string) {
+	if c.runtime == nil {
+		return
+	}
+	fn := c.runtime.Event()
+	if fn == nil {
+		return
+	}
+
+	lockoutType := "account"
+	if lockout.Type == LockoutTypeIp {
+		lockoutType = "ip"
 	}
-	//if ip != "" {
-	//	status, found := c.ipCache[ip]
-	//	if !found {
-	//		status = &lockoutStatus{}
-	//		c.ipCache[ip] = status
-	//	}
-	//	status.attempts = append(status.attempts, now)
-	//	_ = status.trim(now, lockoutPeriodIp)
-	//	if len(status.attempts) >= maxAttemptsIp {
-	//		status.lockedUntil = now.Add(lockoutPeriodIp)
-	//		lockedUntil = status.lockedUntil
-	//		lockoutType = LockoutTypeIp
-	//	}
-	//}
-	return lockoutType, lockedUntil
+	evtCtx := NewRuntimeGoContext(c.ctx, c.config.GetName(), c.version, c.config.GetRuntime().Environment, RuntimeExecutionModeEvent, nil, nil, 0, "", "", nil, "", ip, "", "")
+	fn(evtCtx, &api.Event{
+		Name: RuntimeEventLoginLockout,
+		Properties: map[string]string{
+			"lockout_type": lockoutType,
+			"account":      account,
+			"client_ip":    ip,
+			"locked_until": strconv.FormatInt(lockout.LockedUntil.Unix(), 10),
+		},
+		Timestamp: timestamppb.Now(),
+		External:  false,
+	})
 }
@@ -0,0 +1,64 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginAttemptCacheLockout(t *testing.T) {
+	config := NewConfig(loggerForTest(t))
+	config.Lockout.AccountMaxAttempts = 3
+	config.Lockout.IpMaxAttempts = 4
+
+	cache := NewLocalLoginAttemptCache(loggerForTest(t), nil, config, "", nil)
+	defer cache.Stop()
+
+	for i := 0; i < 2; i++ {
+		lockout, _ := cache.Add("admin", "10.0.0.1")
+		assert.Equal(t, LockoutTypeNone, lockout)
+	}
+	lockout, _ := cache.Add("admin", "10.0.0.1")
+	assert.Equal(t, LockoutTypeAccount, lockout)
+	assert.False(t, cache.Allow("admin", "10.0.0.2"))
+	assert.True(t, cache.Allow("other", "10.0.0.1"))
+
+	lockout, _ = cache.Add("other", "10.0.0.1")
+	assert.Equal(t, LockoutTypeIp, lockout)
+	assert.False(t, cache.Allow("other", "10.0.0.1"))
+
+	lockouts := cache.List("", "")
+	require.Len(t, lockouts, 2)
+	assert.Equal(t, LockoutTypeAccount, lockouts[0].Type)
+	assert.Equal(t, "admin", lockouts[0].Key)
+	assert.Equal(t, LockoutTypeIp, lockouts[1].Type)
+	assert.Equal(t, "10.0.0.1", lockouts[1].Key)
+	assert.Len(t, cache.List("admin", ""), 1)
+	assert.Empty(t, cache.List("other", ""))
+
+	require.NoError(t, cache.Clear("admin", "10.0.0.1"))
+	assert.True(t, cache.Allow("admin", "10.0.0.1"))
+	assert.Empty(t, cache.List("", ""))
+
+	// Lockouts are off when max attempts is 0.
+	config.Lockout.AccountMaxAttempts = 0
+	for i := 0; i < 5; i++ {
+		lockout, _ = cache.Add("admin", "")
+		assert.Equal(t, LockoutTypeNone, lockout)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/api"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Ways account emails can be delivered. Account emails are disabled if no sender is set.
+const (
+	MailSenderNone    = ""
+	MailSenderLog     = "log"
+	MailSenderSMTP    = "smtp"
+	MailSenderRuntime = "runtime"
+)
+
+// RuntimeEventAccountEmail is the name of the event runtime event handlers receive for each account email to deliver,
+// when account emails are delivered by the runtime.
+const RuntimeEventAccountEmail = "account_email"
+
+// MailMessage is an account email sent to a user, such as an email verification or password reset code.
+type MailMessage struct {
+	// The kind of email, one of the email code purposes.
+	Type    string
+	UserID  string
+	To      string
+	Subject string
+	Body    string
+	// The code included in the email, and the link built from it if one is configured.
+	Code string
+	URL  string
+}
+
+// MailSender delivers account emails.
+type MailSender interface {
+	Send(ctx context.Context, msg *MailMessage) error
+}
+
+// NewMailSender returns the configured mail sender, or nil if account emails are disabled.
+func NewMailSender(logger *zap.Logger, config Config, version string, runtime *Runtime) MailSender {
+	switch config.GetMail().Sender {
+	case MailSenderLog:
+		return &LocalMailSender{logger: logger}
+	case MailSenderSMTP:
+		return &SMTPMailSender{config: config.GetMail()}
+	case MailSenderRuntime:
+		return &RuntimeMailSender{logger: logger, config: config, version: version, runtime: runtime}
+	default:
+		return nil
+	}
+}
+
+// LocalMailSender writes account emails to the server log instead of delivering them, for local development and
+// testing.
+type LocalMailSender struct {
+	logger *zap.Logger
+}
+
+func (s *LocalMailSender) Send(ctx context.Context, msg *MailMessage) error {
+	s.logger.Info("Account email", zap.String("type", msg.Type), zap.String("uid", msg.UserID), zap.String("to", msg.To), zap.String("subject", msg.Subject), zap.String("body", msg.Body))
+	return nil
+}
+
+// Upper limit on the time taken to deliver an email through SMTP, unless the request context ends sooner.
+const smtpSendTimeout = 30 * time.Second
+
+// SMTPMailSender delivers account emails through an SMTP server, using STARTTLS when the server supports it.
+type SMTPMailSender struct {
+	config *MailConfig
+}
+
+func (s *SMTPMailSender) Send(ctx context.Context, msg *MailMessage) error {
+	if strings.ContainsAny(msg.To, "\r\n") {
+		return errors.New("invalid recipient address")
+	}
+
+	var auth smtp.Auth
+	if s.config.SmtpUsername != "" {
+		auth = smtp.PlainAuth("", s.config.SmtpUsername, s.config.SmtpPassword, s.config.SmtpHost)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	ctx, cancel := context.WithTimeout(ctx, smtpSendTimeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(s.config.SmtpHost, strconv.Itoa(s.config.SmtpPort))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// Bound every read and write of the exchange, and abort it if the request ends first.
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	c, err := smtp.NewClient(conn, s.config.SmtpHost)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: s.config.SmtpHost}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if err = c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(s.config.From); err != nil {
+		return err
+	}
+	if err = c.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write([]byte(b.String())); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// RuntimeMailSender passes account emails to runtime event handlers, which are responsible for delivering them.
+type RuntimeMailSender struct {
+	logger  *zap.Logger
+	config  Config
+	version string
+	runtime *Runtime
+}
+
+func (s *RuntimeMailSender) Send(ctx context.Context, msg *MailMessage) error {
+	fn := s.runtime.Event()
+	if fn == nil {
+		return errors.New("no runtime event handler registered to deliver account emails")
+	}
+
+	evtCtx := NewRuntimeGoContext(ctx, s.config.GetName(), s.version, s.config.GetRuntime().Environment, RuntimeExecutionModeEvent, nil, nil, 0, msg.UserID, "", nil, "", "", "", "")
+	fn(evtCtx, &api.Event{
+		Name: RuntimeEventAccountEmail,
+		Properties: map[string]string{
+			"type":    msg.Type,
+			"user_id": msg.UserID,
+			"to":      msg.To,
+			"subject": msg.Subject,
+			"body":    msg.Body,
+			"code":    msg.Code,
+			"url":     msg.URL,
+		},
+		Timestamp: timestamppb.Now(),
+		External:  false,
+	})
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPMailSenderStalledServer(t *testing.T) {
+	// A server that accepts connections but never responds.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	config := NewMailConfig()
+	config.From = "noreply@example.com"
+	config.SmtpHost = "127.0.0.1"
+	config.SmtpPort = ln.Addr().(*net.TCPAddr).Port
+	sender := &SMTPMailSender{config: config}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = sender.Send(ctx, &MailMessage{To: "player@example.com", Subject: "subject", Body: "body"})
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama-common/runtime"
 	"go.uber.org/atomic"
@@ -176,6 +177,8 @@ func (s *testMetrics) CountDroppedEvents(delta int64)
 func (s *testMetrics) CountWebsocketOpened(delta int64)                                     {}
 func (s *testMetrics) CountWebsocketClosed(delta int64)                                     {}
 func (m *testMetrics) CountUntaggedGrpcStatsCalls(delta int64)                              {}
+func (s *testMetrics) CountRealtimeRateLimited(category string, delta int64)                {}
+func (s *testMetrics) CountApiRateLimited(method, rpcID string, delta int64)                {}
 func (s *testMetrics) GaugeSessions(value float64)                                          {}
 func (s *testMetrics) GaugePresences(value float64)                                         {}
 func (s *testMetrics) Matchmaker(tickets, activeTickets float64, processTime time.Duration) {}
@@ -199,6 +202,7 @@ func (s *testMessageRouter) SendToPresenceIDs(_ *zap.Logger, presences []*Presen
 func (s *testMessageRouter) SendToStream(*zap.Logger, PresenceStream, *rtapi.Envelope, bool) {}
 func (s *testMessageRouter) SendDeferred(*zap.Logger, []*DeferredMessage)                    {}
 func (s *testMessageRouter) SendToAll(*zap.Logger, *rtapi.Envelope, bool)                    {}
+func (s *testMessageRouter) SendToPush(*zap.Logger, map[uuid.UUID][]*api.Notification)       {}
 
 // testTracker implements the Tracker interface and does nothing
 type testTracker struct{}
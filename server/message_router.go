@@ -15,6 +15,8 @@
 package server
 
 import (
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -30,24 +32,28 @@ type DeferredMessage struct {
 }
 
 // MessageRouter is responsible for sending a message to a list of presences or to an entire stream.
+// Notifications for users with no active sessions may be handed off to push delivery.
 type MessageRouter interface {
 	SendToPresenceIDs(*zap.Logger, []*PresenceID, *rtapi.Envelope, bool)
 	SendToStream(*zap.Logger, PresenceStream, *rtapi.Envelope, bool)
 	SendDeferred(*zap.Logger, []*DeferredMessage)
 	SendToAll(*zap.Logger, *rtapi.Envelope, bool)
+	SendToPush(*zap.Logger, map[uuid.UUID][]*api.Notification)
 }
 
 type LocalMessageRouter struct {
 	protojsonMarshaler *protojson.MarshalOptions
 	sessionRegistry    SessionRegistry
 	tracker            Tracker
+	pushNotifier       PushNotifier
 }
 
-func NewLocalMessageRouter(sessionRegistry SessionRegistry, tracker Tracker, protojsonMarshaler *protojson.MarshalOptions) MessageRouter {
+func NewLocalMessageRouter(sessionRegistry SessionRegistry, tracker Tracker, pushNotifier PushNotifier, protojsonMarshaler *protojson.MarshalOptions) MessageRouter {
 	return &LocalMessageRouter{
 		protojsonMarshaler: protojsonMarshaler,
 		sessionRegistry:    sessionRegistry,
 		tracker:            tracker,
+		pushNotifier:       pushNotifier,
 	}
 }
 
@@ -148,3 +154,10 @@ func (r *LocalMessageRouter) SendToAll(logger *zap.Logger, envelope *rtapi.Envel
 		return true
 	})
 }
+
+func (r *LocalMessageRouter) SendToPush(logger *zap.Logger, notifications map[uuid.UUID][]*api.Notification) {
+	if r.pushNotifier == nil {
+		return
+	}
+	r.pushNotifier.Push(logger, notifications)
+}
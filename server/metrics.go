@@ -54,6 +54,8 @@ type Metrics interface {
 	CountWebsocketOpened(delta int64)
 	CountWebsocketClosed(delta int64)
 	CountUntaggedGrpcStatsCalls(delta int64)
+	CountRealtimeRateLimited(category string, delta int64)
+	CountApiRateLimited(method, rpcID string, delta int64)
 	GaugeSessions(value float64)
 	GaugePresences(value float64)
 	GaugeStorageIndexEntries(indexName string, value float64)
@@ -414,6 +416,20 @@ func (m *LocalMetrics) CountUntaggedGrpcStatsCalls(delta int64) {
 	m.PrometheusScope.Counter("untagged_grpc_stats_calls").Inc(delta)
 }
 
+// Increment the number of realtime messages rejected by the rate limiter.
+func (m *LocalMetrics) CountRealtimeRateLimited(category string, delta int64) {
+	m.PrometheusScope.Tagged(map[string]string{"category": category}).Counter("socket_rate_limited").Inc(delta)
+}
+
+// Increment the number of API requests rejected by the rate limiter.
+func (m *LocalMetrics) CountApiRateLimited(method, rpcID string, delta int64) {
+	tags := map[string]string{"method": method}
+	if rpcID != "" {
+		tags["rpc_id"] = rpcID
+	}
+	m.PrometheusScope.Tagged(tags).Counter("api_rate_limited").Inc(delta)
+}
+
 // Set the absolute value of currently active sessions.
 func (m *LocalMetrics) GaugeSessions(value float64) {
 	m.PrometheusScope.Gauge("sessions").Update(value)
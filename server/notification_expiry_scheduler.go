@@ -0,0 +1,77 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const notificationExpiryBatchSize = 1000
+
+// NotificationExpiryScheduler periodically removes notifications whose expiry time has passed.
+type NotificationExpiryScheduler interface {
+	Stop()
+}
+
+type LocalNotificationExpiryScheduler struct {
+	logger *zap.Logger
+	db     *sql.DB
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewLocalNotificationExpiryScheduler(logger *zap.Logger, db *sql.DB, config Config) NotificationExpiryScheduler {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	s := &LocalNotificationExpiryScheduler{
+		logger: logger,
+		db:     db,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(config.GetNotification().ExpiryCleanupSec) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				// Expired notifications are already excluded from listings, this only reclaims storage.
+				count, err := NotificationsDeleteExpired(s.ctx, s.logger, s.db, notificationExpiryBatchSize)
+				if err != nil {
+					continue
+				}
+				if count > 0 {
+					s.logger.Debug("Removed expired notifications", zap.Int64("count", count))
+				}
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *LocalNotificationExpiryScheduler) Stop() {
+	s.ctxCancelFn()
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"go.uber.org/zap"
+)
+
+// How long to wait before trying again to send scheduled notifications that failed.
+const notificationScheduleRetryDelay = time.Minute
+
+// NotificationScheduler sends scheduled notifications once their send time is reached.
+type NotificationScheduler interface {
+	Stop()
+}
+
+// Scheduled notifications sent together as they share an expiry.
+type notificationScheduledSend struct {
+	notifications map[uuid.UUID][]*api.Notification
+	persistent    map[uuid.UUID][]*api.Notification
+	expiryTime    time.Time
+}
+
+type LocalNotificationScheduler struct {
+	logger        *zap.Logger
+	db            *sql.DB
+	tracker       Tracker
+	messageRouter MessageRouter
+	batchSize     int
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewLocalNotificationScheduler(logger *zap.Logger, db *sql.DB, config Config, tracker Tracker, messageRouter MessageRouter) NotificationScheduler {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	s := &LocalNotificationScheduler{
+		logger:        logger,
+		db:            db,
+		tracker:       tracker,
+		messageRouter: messageRouter,
+		batchSize:     config.GetNotification().ScheduleBatchSize,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(config.GetNotification().ScheduleIntervalMs) * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				// Keep sending while full batches are due, to catch up quickly after a backlog builds.
+				for s.process() >= s.batchSize {
+					if s.ctx.Err() != nil {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *LocalNotificationScheduler) Stop() {
+	s.ctxCancelFn()
+}
+
+func (s *LocalNotificationScheduler) process() int {
+	var scheduled []*notificationScheduled
+	var failedIDs []string
+	sends := make(map[int]*notificationScheduledSend, 1)
+	err := ExecuteInTx(s.ctx, s.db, func(tx *sql.Tx) error {
+		clear(sends)
+		failedIDs = failedIDs[:0]
+		var err error
+		scheduled, err = notificationScheduleClaimDue(s.ctx, tx, s.batchSize)
+		if err != nil || len(scheduled) == 0 {
+			return err
+		}
+
+		// Group notifications that share an expiry so they're sent together.
+		now := time.Now()
+		ids := make([]string, 0, len(scheduled))
+		for _, sn := range scheduled {
+			ids = append(ids, sn.Notification.Id)
+			send, found := sends[sn.ExpirySec]
+			if !found {
+				send = &notificationScheduledSend{
+					notifications: make(map[uuid.UUID][]*api.Notification, len(scheduled)),
+					persistent:    make(map[uuid.UUID][]*api.Notification, len(scheduled)),
+				}
+				if sn.ExpirySec > 0 {
+					send.expiryTime = now.Add(time.Duration(sn.ExpirySec) * time.Second)
+				}
+				sends[sn.ExpirySec] = send
+			}
+			send.notifications[sn.UserID] = append(send.notifications[sn.UserID], sn.Notification)
+			if sn.Notification.Persistent {
+				send.persistent[sn.UserID] = append(send.persistent[sn.UserID], sn.Notification)
+			}
+		}
+
+		// Persistent notifications are stored in the same transaction that removes them from the schedule, so they are
+		// neither lost nor stored twice if sending fails part way. Those that can't be stored stay scheduled, and are
+		// neither sent nor allowed to hold up the rest of the batch.
+		failed := make(map[string]struct{})
+		for _, send := range sends {
+			if len(send.persistent) == 0 {
+				continue
+			}
+			if err := notificationScheduleSave(s.ctx, s.logger, tx, send.persistent, send.expiryTime, failed); err != nil {
+				return err
+			}
+			if len(failed) > 0 {
+				send.remove(failed)
+			}
+		}
+		if len(failed) == 0 {
+			return notificationScheduleDelete(s.ctx, tx, ids)
+		}
+		sentIDs := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if _, found := failed[id]; found {
+				failedIDs = append(failedIDs, id)
+			} else {
+				sentIDs = append(sentIDs, id)
+			}
+		}
+		return notificationScheduleDelete(s.ctx, tx, sentIDs)
+	})
+	if err != nil {
+		if s.ctx.Err() != nil {
+			return 0
+		}
+		s.logger.Error("Failed to send scheduled notifications", zap.Int("count", len(scheduled)), zap.Error(err))
+		if len(scheduled) > 0 {
+			ids := make([]string, 0, len(scheduled))
+			for _, sn := range scheduled {
+				ids = append(ids, sn.Notification.Id)
+			}
+			if err := notificationScheduleRetry(s.ctx, s.db, ids, notificationScheduleRetryDelay); err != nil {
+				s.logger.Error("Failed to reschedule scheduled notifications", zap.Int("count", len(ids)), zap.Error(err))
+			}
+		}
+		return 0
+	}
+
+	if len(failedIDs) > 0 {
+		s.logger.Error("Failed to store scheduled notifications", zap.Int("count", len(failedIDs)))
+		if err := notificationScheduleRetry(s.ctx, s.db, failedIDs, notificationScheduleRetryDelay); err != nil {
+			s.logger.Error("Failed to reschedule scheduled notifications", zap.Int("count", len(failedIDs)), zap.Error(err))
+		}
+	}
+
+	for _, send := range sends {
+		notificationDeliver(s.logger, s.tracker, s.messageRouter, send.notifications, send.persistent)
+	}
+
+	return len(scheduled)
+}
+
+// Drop notifications that could not be stored, so they are not delivered until they are retried.
+func (send *notificationScheduledSend) remove(ids map[string]struct{}) {
+	for _, byUser := range []map[uuid.UUID][]*api.Notification{send.notifications, send.persistent} {
+		for userID, notifications := range byUser {
+			kept := make([]*api.Notification, 0, len(notifications))
+			for _, n := range notifications {
+				if _, found := ids[n.Id]; !found {
+					kept = append(kept, n)
+				}
+			}
+			if len(kept) == 0 {
+				delete(byUser, userID)
+			} else {
+				byUser[userID] = kept
+			}
+		}
+	}
+}
+
+// Store persistent scheduled notifications, all together if possible. If that fails each one is stored on its own, and
+// the IDs of those that still fail are added to the failed set. Each attempt runs in a savepoint so a failure leaves
+// the rest of the transaction usable.
+func notificationScheduleSave(ctx context.Context, logger *zap.Logger, tx *sql.Tx, notifications map[uuid.UUID][]*api.Notification, expiryTime time.Time, failed map[string]struct{}) error {
+	saved, err := notificationScheduleSavepoint(ctx, logger, tx, notifications, expiryTime)
+	if err != nil || saved {
+		return err
+	}
+
+	for userID, userNotifications := range notifications {
+		for _, n := range userNotifications {
+			saved, err = notificationScheduleSavepoint(ctx, logger, tx, map[uuid.UUID][]*api.Notification{userID: {n}}, expiryTime)
+			if err != nil {
+				return err
+			}
+			if !saved {
+				failed[n.Id] = struct{}{}
+			}
+		}
+	}
+	return nil
+}
+
+func notificationScheduleSavepoint(ctx context.Context, logger *zap.Logger, tx *sql.Tx, notifications map[uuid.UUID][]*api.Notification, expiryTime time.Time) (bool, error) {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT notification_save"); err != nil {
+		return false, err
+	}
+	if err := notificationSave(ctx, logger, tx, notifications, expiryTime); err != nil {
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT notification_save"); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT notification_save")
+	return err == nil, err
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationSchedulerProcess(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	logger := loggerForTest(t)
+	ctx := context.Background()
+
+	userID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userID)
+
+	schedule := func(subject string, sendTime time.Time) string {
+		id, err := NotificationSchedule(ctx, logger, db, userID, &api.Notification{Subject: subject, Content: "{}", Code: 1, SenderId: uuid.Nil.String(), Persistent: true}, 0, sendTime)
+		require.NoError(t, err)
+		return id
+	}
+	scheduled := func(id string) (time.Time, bool) {
+		var sendTime time.Time
+		if err := db.QueryRowContext(ctx, "SELECT send_time FROM notification_schedule WHERE id = $1", id).Scan(&sendTime); err != nil {
+			return time.Time{}, false
+		}
+		return sendTime, true
+	}
+	stored := func(id string) bool {
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT count(*) FROM notification WHERE id = $1 AND user_id = $2", id, userID).Scan(&count))
+		return count == 1
+	}
+
+	s := &LocalNotificationScheduler{
+		logger:        logger,
+		db:            db,
+		tracker:       &LocalTracker{},
+		messageRouter: &DummyMessageRouter{},
+		batchSize:     100,
+		ctx:           ctx,
+	}
+
+	// A notification that can't be stored stays scheduled and is tried again later, without holding up the
+	// notifications it was claimed with.
+	failID := schedule("fail", time.Now().Add(-time.Second))
+	goodID := schedule("good", time.Now().Add(-time.Second))
+	_, err := db.ExecContext(ctx, "INSERT INTO notification (id, user_id, subject, content, code, sender_id) VALUES ($1, $2, 'conflict', '{}', 1, $3)", failID, userID, uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.process())
+	sendTime, found := scheduled(failID)
+	require.True(t, found)
+	assert.True(t, sendTime.After(time.Now()))
+	_, found = scheduled(goodID)
+	assert.False(t, found)
+	assert.True(t, stored(goodID))
+
+	// Once the problem is resolved it is sent along with other due notifications.
+	_, err = db.ExecContext(ctx, "DELETE FROM notification WHERE id = $1", failID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "UPDATE notification_schedule SET send_time = now() - INTERVAL '1 second' WHERE id = $1", failID)
+	require.NoError(t, err)
+	dueID := schedule("due", time.Now().Add(-time.Second))
+	laterID := schedule("later", time.Now().Add(time.Hour))
+
+	assert.GreaterOrEqual(t, s.process(), 2)
+	for _, id := range []string{failID, dueID} {
+		_, found = scheduled(id)
+		assert.False(t, found)
+		assert.True(t, stored(id))
+	}
+	_, found = scheduled(laterID)
+	assert.True(t, found)
+	assert.False(t, stored(laterID))
+}
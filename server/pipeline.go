@@ -37,11 +37,13 @@ type Pipeline struct {
 	matchmaker           Matchmaker
 	tracker              Tracker
 	router               MessageRouter
+	chatModerator        ChatModerator
+	channelRateLimiter   *keyedRateLimiter
 	runtime              *Runtime
 	node                 string
 }
 
-func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, sessionRegistry SessionRegistry, statusRegistry StatusRegistry, matchRegistry MatchRegistry, partyRegistry PartyRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, runtime *Runtime) *Pipeline {
+func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, sessionRegistry SessionRegistry, statusRegistry StatusRegistry, matchRegistry MatchRegistry, partyRegistry PartyRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, chatModerator ChatModerator, runtime *Runtime) *Pipeline {
 	return &Pipeline{
 		logger:               logger,
 		config:               config,
@@ -55,6 +57,8 @@ func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, protojsonMarshal
 		matchmaker:           matchmaker,
 		tracker:              tracker,
 		router:               router,
+		chatModerator:        chatModerator,
+		channelRateLimiter:   newChannelRateLimiter(config.GetRateLimit().Realtime),
 		runtime:              runtime,
 		node:                 config.GetName(),
 	}
@@ -41,6 +41,8 @@ const (
 	ChannelMessageTypeGroupPromote
 	ChannelMessageTypeGroupBan
 	ChannelMessageTypeGroupDemote
+	ChannelMessageTypeReaction
+	ChannelMessageTypeReply
 )
 
 var controlCharsRegex = regexp.MustCompilePOSIX("[[:cntrl:]]+")
@@ -215,8 +217,20 @@ func (p *Pipeline) channelMessageSend(logger *zap.Logger, session Session, envel
 		return false, nil
 	}
 
-	ack, err := ChannelMessageSend(session.Context(), p.logger, p.db, p.router, streamConversionResult.Stream, incoming.ChannelId, incoming.Content, session.UserID().String(), session.Username(), meta.Persistence)
+	ack, err := ChannelMessageSend(session.Context(), p.logger, p.db, p.router, p.chatModerator, streamConversionResult.Stream, incoming.ChannelId, incoming.Content, session.UserID().String(), session.Username(), meta.Persistence)
 	switch err {
+	case errChannelMessageRejected:
+		_ = session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Message rejected by chat moderation",
+		}}}, true)
+		return false, nil
+	case errChannelMessageSenderMuted:
+		_ = session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Muted users cannot send messages",
+		}}}, true)
+		return false, nil
 	case errChannelMessagePersist:
 		_ = session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
 			Code:    int32(rtapi.Error_RUNTIME_EXCEPTION),
@@ -268,8 +282,20 @@ func (p *Pipeline) channelMessageUpdate(logger *zap.Logger, session Session, env
 		return false, nil
 	}
 
-	ack, err := ChannelMessageUpdate(session.Context(), p.logger, p.db, p.router, streamConversionResult.Stream, incoming.ChannelId, incoming.MessageId, incoming.Content, session.UserID().String(), session.Username(), meta.Persistence)
+	ack, err := ChannelMessageUpdate(session.Context(), p.logger, p.db, p.router, p.chatModerator, streamConversionResult.Stream, incoming.ChannelId, incoming.MessageId, incoming.Content, session.UserID().String(), session.Username(), meta.Persistence)
 	switch err {
+	case errChannelMessageRejected:
+		_ = session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Message rejected by chat moderation",
+		}}}, true)
+		return false, nil
+	case errChannelMessageSenderMuted:
+		_ = session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Muted users cannot send messages",
+		}}}, true)
+		return false, nil
 	case errChannelMessageNotFound:
 		_ = session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
 			Code:    int32(rtapi.Error_BAD_INPUT),
@@ -15,6 +15,7 @@
 package server
 
 import (
+	"context"
 	"crypto"
 	"fmt"
 	"strings"
@@ -180,6 +181,8 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 	var mode uint8
 	var label *wrapperspb.StringValue
 	var presences []*rtapi.UserPresence
+	// Whether the user has just joined the match, rather than already being part of it.
+	var joined bool
 	username := session.Username()
 	if node == "" {
 		// Relayed match.
@@ -195,6 +198,7 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 		}
 
 		isNew := p.tracker.GetLocalBySessionIDStreamUserID(session.ID(), stream, session.UserID()) == nil
+		joined = isNew
 		if isNew {
 			m := PresenceMeta{
 				Username: username,
@@ -266,6 +270,7 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 			}
 		}
 
+		joined = isNew
 		label = &wrapperspb.StringValue{Value: l}
 		presences = make([]*rtapi.UserPresence, 0, len(ps))
 		for _, p := range ps {
@@ -282,6 +287,15 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 		}
 	}
 
+	if joined && len(presences) > 0 {
+		otherUserIDs := make([]uuid.UUID, 0, len(presences))
+		for _, presence := range presences {
+			otherUserIDs = append(otherUserIDs, uuid.FromStringOrNil(presence.UserId))
+		}
+		// Recorded in the background so the join is not held up by a database write.
+		go friendRecommendationMatchRecord(context.Background(), logger, p.db, p.config.GetFriend(), session.UserID(), otherUserIDs)
+	}
+
 	out := &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Match{Match: &rtapi.Match{
 		MatchId:       matchIDString,
 		Authoritative: mode == StreamModeMatchAuthoritative,
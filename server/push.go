@@ -0,0 +1,158 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/zap"
+)
+
+// ErrPushTokenInvalid is returned by push providers when the push service reports a device token is no longer valid.
+var ErrPushTokenInvalid = errors.New("push device token invalid")
+
+// PushProvider delivers a notification to a single device token through an external push service.
+type PushProvider interface {
+	Send(ctx context.Context, token string, notification *api.Notification) error
+}
+
+// PushNotifier is responsible for delivering persistent notifications to the registered devices of users with no active sessions.
+type PushNotifier interface {
+	Push(logger *zap.Logger, notifications map[uuid.UUID][]*api.Notification)
+	Stop()
+}
+
+type LocalPushNotifier struct {
+	logger    *zap.Logger
+	db        *sql.DB
+	providers map[apigrpc.PushDevice_Platform]PushProvider
+
+	ch chan map[uuid.UUID][]*api.Notification
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewLocalPushNotifier(logger *zap.Logger, db *sql.DB, config Config) PushNotifier {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	providers := make(map[apigrpc.PushDevice_Platform]PushProvider, 2)
+	if fcmConfig := config.GetNotification().Fcm; fcmConfig.Enabled() {
+		providers[apigrpc.PushDevice_FCM] = NewPushProviderFcm(httpc, fcmConfig)
+	}
+	if apnsConfig := config.GetNotification().Apns; apnsConfig.Enabled() {
+		providers[apigrpc.PushDevice_APNS] = NewPushProviderApns(httpc, apnsConfig)
+	}
+
+	p := &LocalPushNotifier{
+		logger:    logger,
+		db:        db,
+		providers: providers,
+
+		ch: make(chan map[uuid.UUID][]*api.Notification, config.GetNotification().PushQueueSize),
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	if len(providers) == 0 {
+		// No push services configured, nothing to deliver.
+		return p
+	}
+
+	// Start a fixed number of workers.
+	for i := 0; i < config.GetNotification().PushQueueWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-p.ctx.Done():
+					return
+				case notifications := <-p.ch:
+					p.deliver(notifications)
+				}
+			}
+		}()
+	}
+
+	return p
+}
+
+func (p *LocalPushNotifier) Push(logger *zap.Logger, notifications map[uuid.UUID][]*api.Notification) {
+	if len(p.providers) == 0 || len(notifications) == 0 {
+		return
+	}
+
+	select {
+	case p.ch <- notifications:
+		// Notifications queued successfully.
+	default:
+		// Push queue is full, drop them to avoid blocking the caller. They remain available to list.
+		logger.Warn("Push notification queue full, push notifications may be lost")
+	}
+}
+
+func (p *LocalPushNotifier) Stop() {
+	p.ctxCancelFn()
+}
+
+func (p *LocalPushNotifier) deliver(notifications map[uuid.UUID][]*api.Notification) {
+	userIDs := make([]uuid.UUID, 0, len(notifications))
+	for userID := range notifications {
+		userIDs = append(userIDs, userID)
+	}
+
+	disabledCodes, err := pushPreferencesDisabledCodes(p.ctx, p.db, userIDs)
+	if err != nil {
+		p.logger.Error("Failed to load push notification preferences", zap.Error(err))
+		return
+	}
+
+	devices, err := pushDevicesByUser(p.ctx, p.db, userIDs)
+	if err != nil {
+		p.logger.Error("Failed to load push notification devices", zap.Error(err))
+		return
+	}
+
+	for userID, userDevices := range devices {
+		for _, notification := range notifications[userID] {
+			if _, disabled := disabledCodes[userID][notification.Code]; disabled {
+				continue
+			}
+
+			for _, device := range userDevices {
+				provider, found := p.providers[device.Platform]
+				if !found {
+					continue
+				}
+
+				if err := provider.Send(p.ctx, device.Token, notification); err != nil {
+					if errors.Is(err, ErrPushTokenInvalid) {
+						// The push service will never accept this token again.
+						if err := PushDeviceDelete(p.ctx, p.logger, p.db, userID, device.Token); err != nil {
+							p.logger.Warn("Failed to remove invalid push device token", zap.String("uid", userID.String()), zap.Error(err))
+						}
+						continue
+					}
+					p.logger.Warn("Failed to deliver push notification", zap.String("uid", userID.String()), zap.String("platform", device.Platform.String()), zap.Error(err))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/heroiclabs/nakama-common/api"
+)
+
+// Push provider tokens are refreshed ahead of their expiry to avoid racing in-flight requests.
+const pushProviderTokenRefreshMargin = 5 * time.Minute
+
+func pushNotificationData(notification *api.Notification) map[string]string {
+	data := map[string]string{
+		"id":      notification.Id,
+		"subject": notification.Subject,
+		"content": notification.Content,
+		"code":    strconv.Itoa(int(notification.Code)),
+	}
+	if notification.SenderId != "" {
+		data["sender_id"] = notification.SenderId
+	}
+	return data
+}
+
+// PushProviderFcm delivers notifications through the Firebase Cloud Messaging HTTP v1 API.
+type PushProviderFcm struct {
+	sync.Mutex
+	httpc  *http.Client
+	config *NotificationFcmConfig
+
+	accessToken       string
+	accessTokenExpiry time.Time
+}
+
+func NewPushProviderFcm(httpc *http.Client, config *NotificationFcmConfig) *PushProviderFcm {
+	return &PushProviderFcm{
+		httpc:  httpc,
+		config: config,
+	}
+}
+
+func (p *PushProviderFcm) Send(ctx context.Context, token string, notification *api.Notification) error {
+	accessToken, err := p.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"message": map[string]any{
+			"token": token,
+			"notification": map[string]string{
+				"title": notification.Subject,
+			},
+			"data": pushNotificationData(notification),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v1/projects/%s/messages:send", strings.TrimSuffix(p.config.Url, "/"), url.PathEscape(p.config.ProjectId)), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := p.httpc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		// FCM reports tokens that are no longer registered as not found.
+		return ErrPushTokenInvalid
+	case http.StatusBadRequest:
+		var fcmError struct {
+			Error struct {
+				Details []struct {
+					ErrorCode string `json:"errorCode"`
+				} `json:"details"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(buf, &fcmError); err == nil {
+			for _, detail := range fcmError.Error.Details {
+				// INVALID_ARGUMENT is also returned for problems with the message itself, so only UNREGISTERED is
+				// taken to mean the token should be removed.
+				if detail.ErrorCode == "UNREGISTERED" {
+					return ErrPushTokenInvalid
+				}
+			}
+		}
+		fallthrough
+	default:
+		return fmt.Errorf("fcm push delivery failed with status %d: %s", resp.StatusCode, string(buf))
+	}
+}
+
+// Request an access token for the Firebase Cloud Messaging API using the configured service account.
+// https://developers.google.com/identity/protocols/oauth2/service-account#httprest
+func (p *PushProviderFcm) getAccessToken(ctx context.Context) (string, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.accessToken != "" && time.Now().Before(p.accessTokenExpiry) {
+		return p.accessToken, nil
+	}
+
+	block, _ := pem.Decode([]byte(p.config.PrivateKey))
+	if block == nil {
+		return "", errors.New("fcm private key invalid")
+	}
+	pk, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   p.config.ClientEmail,
+		"scope": "https://www.googleapis.com/auth/firebase.messaging",
+		"aud":   p.config.TokenUrl,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(pk)
+	if err != nil {
+		return "", err
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	data.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.TokenUrl, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpc.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fcm access token request failed with status %d: %s", resp.StatusCode, string(buf))
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(buf, &token); err != nil {
+		return "", err
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("fcm access token response missing access token")
+	}
+
+	p.accessToken = token.AccessToken
+	p.accessTokenExpiry = now.Add(time.Duration(token.ExpiresIn)*time.Second - pushProviderTokenRefreshMargin)
+
+	return p.accessToken, nil
+}
+
+// PushProviderApns delivers notifications through the Apple Push Notification service HTTP/2 API.
+type PushProviderApns struct {
+	sync.Mutex
+	httpc  *http.Client
+	config *NotificationApnsConfig
+
+	providerToken       string
+	providerTokenExpiry time.Time
+}
+
+func NewPushProviderApns(httpc *http.Client, config *NotificationApnsConfig) *PushProviderApns {
+	return &PushProviderApns{
+		httpc:  httpc,
+		config: config,
+	}
+}
+
+func (p *PushProviderApns) Send(ctx context.Context, token string, notification *api.Notification) error {
+	providerToken, err := p.getProviderToken()
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{
+		"aps": map[string]any{
+			"alert": map[string]string{
+				"title": notification.Subject,
+			},
+		},
+	}
+	for k, v := range pushNotificationData(notification) {
+		payload[k] = v
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/3/device/%s", strings.TrimSuffix(p.config.Url, "/"), url.PathEscape(token)), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", p.config.BundleId)
+	req.Header.Set("apns-push-type", "alert")
+	if notification.Id != "" {
+		req.Header.Set("apns-id", notification.Id)
+	}
+
+	resp, err := p.httpc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusGone:
+		// The device token is no longer active for the topic.
+		return ErrPushTokenInvalid
+	case http.StatusBadRequest:
+		var apnsError struct {
+			Reason string `json:"reason"`
+		}
+		if err := json.Unmarshal(buf, &apnsError); err == nil && (apnsError.Reason == "BadDeviceToken" || apnsError.Reason == "DeviceTokenNotForTopic") {
+			return ErrPushTokenInvalid
+		}
+		fallthrough
+	default:
+		return fmt.Errorf("apns push delivery failed with status %d: %s", resp.StatusCode, string(buf))
+	}
+}
+
+// Sign a provider authentication token, APNs rejects tokens older than one hour and throttles tokens refreshed too often.
+// https://developer.apple.com/documentation/usernotifications/establishing-a-token-based-connection-to-apns
+func (p *PushProviderApns) getProviderToken() (string, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.providerToken != "" && time.Now().Before(p.providerTokenExpiry) {
+		return p.providerToken, nil
+	}
+
+	block, _ := pem.Decode([]byte(p.config.PrivateKey))
+	if block == nil {
+		return "", errors.New("apns private key invalid")
+	}
+	pk, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	ecdsaKey, ok := pk.(*ecdsa.PrivateKey)
+	if !ok {
+		return "", errors.New("apns private key must be an ECDSA key")
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": p.config.TeamId,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = p.config.KeyId
+	signed, err := token.SignedString(ecdsaKey)
+	if err != nil {
+		return "", err
+	}
+
+	p.providerToken = signed
+	p.providerTokenExpiry = now.Add(time.Hour - pushProviderTokenRefreshMargin)
+
+	return p.providerToken, nil
+}
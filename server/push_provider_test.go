@@ -0,0 +1,152 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pushTestPrivateKeyPEM(t *testing.T, key any) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestPushProviderFcm(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var tokenRequests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests.Add(1)
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.FormValue("grant_type"))
+		assert.NotEmpty(t, r.FormValue("assertion"))
+		_, _ = w.Write([]byte(`{"access_token":"access-token","expires_in":3600}`))
+	})
+	mux.HandleFunc("/v1/projects/project/messages:send", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+		var body struct {
+			Message struct {
+				Token string            `json:"token"`
+				Data  map[string]string `json:"data"`
+			} `json:"message"`
+		}
+		buf, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(buf, &body))
+		assert.Equal(t, "101", body.Message.Data["code"])
+
+		switch body.Message.Token {
+		case "unregistered":
+			w.WriteHeader(http.StatusNotFound)
+		case "stale":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"details":[{"errorCode":"UNREGISTERED"}]}}`))
+		case "bad":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"details":[{"errorCode":"INVALID_ARGUMENT"}]}}`))
+		case "unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	config := NewNotificationConfig().Fcm
+	config.ProjectId = "project"
+	config.ClientEmail = "nakama@project.iam.gserviceaccount.com"
+	config.PrivateKey = pushTestPrivateKeyPEM(t, rsaKey)
+	config.Url = ts.URL
+	config.TokenUrl = ts.URL + "/token"
+	provider := NewPushProviderFcm(ts.Client(), config)
+
+	notification := &api.Notification{Id: "id", Subject: "subject", Content: "{}", Code: 101}
+
+	assert.NoError(t, provider.Send(context.Background(), "valid", notification))
+	assert.ErrorIs(t, provider.Send(context.Background(), "unregistered", notification), ErrPushTokenInvalid)
+	assert.ErrorIs(t, provider.Send(context.Background(), "stale", notification), ErrPushTokenInvalid)
+
+	// An invalid argument may be caused by the message rather than the token, so the token is kept.
+	err = provider.Send(context.Background(), "bad", notification)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrPushTokenInvalid)
+
+	err = provider.Send(context.Background(), "unavailable", notification)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrPushTokenInvalid)
+
+	// The access token is cached between sends.
+	assert.EqualValues(t, 1, tokenRequests.Load())
+}
+
+func TestPushProviderApns(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var authorization atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "com.example.game", r.Header.Get("apns-topic"))
+		if previous := authorization.Swap(r.Header.Get("Authorization")); previous != nil {
+			// The provider token is reused between sends.
+			assert.Equal(t, previous, r.Header.Get("Authorization"))
+		}
+
+		switch r.URL.Path {
+		case "/3/device/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/3/device/bad":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		case "/3/device/payload":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"PayloadTooLarge"}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := NewNotificationConfig().Apns
+	config.KeyId = "key"
+	config.TeamId = "team"
+	config.BundleId = "com.example.game"
+	config.PrivateKey = pushTestPrivateKeyPEM(t, ecdsaKey)
+	config.Url = ts.URL
+	provider := NewPushProviderApns(ts.Client(), config)
+
+	notification := &api.Notification{Id: "id", Subject: "subject", Content: "{}", Code: 101}
+
+	assert.NoError(t, provider.Send(context.Background(), "valid", notification))
+	assert.ErrorIs(t, provider.Send(context.Background(), "gone", notification), ErrPushTokenInvalid)
+	assert.ErrorIs(t, provider.Send(context.Background(), "bad", notification), ErrPushTokenInvalid)
+
+	err = provider.Send(context.Background(), "payload", notification)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrPushTokenInvalid)
+}
@@ -0,0 +1,303 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/rtapi"
+)
+
+// Realtime error code sent in reply to messages rejected by the rate limiter, following on from the rtapi.Error_Code values.
+const RealtimeErrorRateLimited int32 = 8
+
+const (
+	rateLimitCategoryChat  = "chat"
+	rateLimitCategoryData  = "data"
+	rateLimitCategoryRpc   = "rpc"
+	rateLimitCategoryOther = "other"
+)
+
+// How often idle buckets are swept from a keyed rate limiter.
+const rateLimiterSweepInterval = time.Minute
+
+// A token bucket refilled continuously at a fixed rate, up to a maximum burst. Not safe for concurrent use.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// Take a token if one is available.
+func (b *tokenBucket) allow(now time.Time) bool {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// A bucket is idle once it has refilled completely, at which point dropping it loses no state.
+func (b *tokenBucket) idle(now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
+
+// Group realtime messages into the budgets they are rate limited against. Messages with no category are never limited.
+func realtimeRateLimitCategory(envelope *rtapi.Envelope) (string, string) {
+	switch msg := envelope.Message.(type) {
+	case *rtapi.Envelope_ChannelMessageSend:
+		return rateLimitCategoryChat, msg.ChannelMessageSend.ChannelId
+	case *rtapi.Envelope_ChannelMessageUpdate:
+		return rateLimitCategoryChat, msg.ChannelMessageUpdate.ChannelId
+	case *rtapi.Envelope_ChannelMessageRemove:
+		return rateLimitCategoryChat, msg.ChannelMessageRemove.ChannelId
+	case *rtapi.Envelope_MatchDataSend, *rtapi.Envelope_PartyDataSend:
+		return rateLimitCategoryData, ""
+	case *rtapi.Envelope_Rpc:
+		return rateLimitCategoryRpc, ""
+	case *rtapi.Envelope_Ping, *rtapi.Envelope_Pong, nil:
+		// Heartbeats are paced by the server, and empty messages are rejected by the pipeline.
+		return "", ""
+	default:
+		return rateLimitCategoryOther, ""
+	}
+}
+
+// Rate limits by an arbitrary key such as a channel ID or client IP address, safe for concurrent use.
+type keyedRateLimiter struct {
+	sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func newKeyedRateLimiter() *keyedRateLimiter {
+	return &keyedRateLimiter{
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// The rate and burst only take effect when a key has no bucket, callers should use the same values for each key.
+func (l *keyedRateLimiter) allow(key string, rate float64, burst int, now time.Time) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	if now.Sub(l.lastSweep) >= rateLimiterSweepInterval {
+		for k, bucket := range l.buckets {
+			if bucket.idle(now) {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	bucket, found := l.buckets[key]
+	if !found {
+		bucket = newTokenBucket(rate, burst, now)
+		l.buckets[key] = bucket
+	}
+	return bucket.allow(now)
+}
+
+// Rate limits chat messages per channel, shared by all sessions on this node. Returns nil if not configured.
+func newChannelRateLimiter(config *RealtimeRateLimitConfig) *keyedRateLimiter {
+	if config.ChannelChatRate <= 0 {
+		return nil
+	}
+	return newKeyedRateLimiter()
+}
+
+// Rate limits the realtime messages of a single session. Not safe for concurrent use, a session reads its messages
+// sequentially.
+type sessionRateLimiter struct {
+	buckets      map[string]*tokenBucket
+	channels     *keyedRateLimiter
+	channelRate  float64
+	channelBurst int
+
+	closeThreshold   int
+	closeWindow      time.Duration
+	closeWindowStart time.Time
+	limitedCount     int
+}
+
+// Returns nil if no realtime rate limits are configured.
+func newSessionRateLimiter(config *RealtimeRateLimitConfig, channels *keyedRateLimiter) *sessionRateLimiter {
+	now := time.Now()
+	buckets := make(map[string]*tokenBucket, 4)
+	for category, limit := range map[string]struct {
+		rate  float64
+		burst int
+	}{
+		rateLimitCategoryChat:  {config.ChatRate, config.ChatBurst},
+		rateLimitCategoryData:  {config.DataRate, config.DataBurst},
+		rateLimitCategoryRpc:   {config.RpcRate, config.RpcBurst},
+		rateLimitCategoryOther: {config.OtherRate, config.OtherBurst},
+	} {
+		if limit.rate > 0 {
+			buckets[category] = newTokenBucket(limit.rate, limit.burst, now)
+		}
+	}
+
+	if len(buckets) == 0 && channels == nil {
+		return nil
+	}
+
+	return &sessionRateLimiter{
+		buckets:      buckets,
+		channels:     channels,
+		channelRate:  config.ChannelChatRate,
+		channelBurst: config.ChannelChatBurst,
+
+		closeThreshold: config.CloseThreshold,
+		closeWindow:    time.Duration(config.CloseWindowSec) * time.Second,
+	}
+}
+
+// Check a message against the session's budgets, returning its category and whether it may be processed.
+func (l *sessionRateLimiter) allow(envelope *rtapi.Envelope, now time.Time) (string, bool) {
+	category, channelID := realtimeRateLimitCategory(envelope)
+	if category == "" {
+		return category, true
+	}
+
+	if bucket, found := l.buckets[category]; found && !bucket.allow(now) {
+		l.limited(now)
+		return category, false
+	}
+	if category == rateLimitCategoryChat && l.channels != nil && !l.channels.allow(channelID, l.channelRate, l.channelBurst, now) {
+		// Channel-wide limits are not held against the session's close threshold, other senders may be to blame.
+		return category, false
+	}
+
+	return category, true
+}
+
+func (l *sessionRateLimiter) limited(now time.Time) {
+	if now.Sub(l.closeWindowStart) > l.closeWindow {
+		l.closeWindowStart = now
+		l.limitedCount = 0
+	}
+	l.limitedCount++
+}
+
+// Report if the session has been rate limited often enough within the close window that it should be disconnected.
+func (l *sessionRateLimiter) exceeded() bool {
+	return l.closeThreshold > 0 && l.limitedCount >= l.closeThreshold
+}
+
+// Holds chat messages sent through the API, such as message replies, to the same chat rates as realtime sessions. The
+// chat rate applies per user rather than per session, and the channel chat rate is shared with realtime messages sent
+// on this node.
+type apiChatRateLimiter struct {
+	users        *keyedRateLimiter
+	userRate     float64
+	userBurst    int
+	channels     *keyedRateLimiter
+	channelRate  float64
+	channelBurst int
+}
+
+// Returns nil if no chat rate limits are configured.
+func newApiChatRateLimiter(config *RealtimeRateLimitConfig, channels *keyedRateLimiter) *apiChatRateLimiter {
+	var users *keyedRateLimiter
+	if config.ChatRate > 0 {
+		users = newKeyedRateLimiter()
+	}
+	if users == nil && channels == nil {
+		return nil
+	}
+	return &apiChatRateLimiter{
+		users:        users,
+		userRate:     config.ChatRate,
+		userBurst:    config.ChatBurst,
+		channels:     channels,
+		channelRate:  config.ChannelChatRate,
+		channelBurst: config.ChannelChatBurst,
+	}
+}
+
+func (l *apiChatRateLimiter) allow(userID uuid.UUID, channelID string, now time.Time) bool {
+	if l.users != nil && !l.users.allow(userID.String(), l.userRate, l.userBurst, now) {
+		return false
+	}
+	return l.channels == nil || l.channels.allow(channelID, l.channelRate, l.channelBurst, now)
+}
+
+// ApiRateLimiter limits HTTP and gRPC API requests by client IP address, and by caller for each API method or RPC
+// function.
+type ApiRateLimiter struct {
+	config  *ApiRateLimitConfig
+	runtime *Runtime
+	ips     *keyedRateLimiter
+	methods *keyedRateLimiter
+}
+
+func NewApiRateLimiter(config Config, runtime *Runtime) *ApiRateLimiter {
+	return &ApiRateLimiter{
+		config:  config.GetRateLimit().Api,
+		runtime: runtime,
+		ips:     newKeyedRateLimiter(),
+		methods: newKeyedRateLimiter(),
+	}
+}
+
+// Allow reports if a request may proceed. The user ID is nil for requests not authenticated with a session token, in
+// which case the method limit applies to the client IP address instead. The RPC ID is only set for RPC requests, and
+// selects any rate limit registered with the RPC function over the configured method limit.
+func (l *ApiRateLimiter) Allow(clientIP string, userID uuid.UUID, method, rpcID string, now time.Time) bool {
+	if l.config.IpRate > 0 && clientIP != "" && !l.ips.allow(clientIP, l.config.IpRate, l.config.IpBurst, now) {
+		return false
+	}
+
+	rate, burst := l.config.MethodRate, l.config.MethodBurst
+	if rpcID != "" {
+		method = "rpc/" + rpcID
+		if l.runtime != nil {
+			if limit := l.runtime.RpcRateLimit(rpcID); limit != nil {
+				rate, burst = limit.Rate, limit.Burst
+			}
+		}
+	}
+	if rate <= 0 {
+		return true
+	}
+
+	caller := clientIP
+	if userID != uuid.Nil {
+		caller = userID.String()
+	}
+	return l.methods.allow(method+"/"+caller, rate, burst, now)
+}
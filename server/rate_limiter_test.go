@@ -0,0 +1,187 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2, 3, now)
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, bucket.allow(now), "burst %d", i)
+	}
+	assert.False(t, bucket.allow(now))
+
+	// Half a second at 2 per second refills a single token.
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, bucket.allow(now))
+	assert.False(t, bucket.allow(now))
+
+	// Refills never exceed the burst.
+	now = now.Add(time.Hour)
+	assert.True(t, bucket.idle(now))
+	for i := 0; i < 3; i++ {
+		assert.True(t, bucket.allow(now))
+	}
+	assert.False(t, bucket.allow(now))
+}
+
+func TestSessionRateLimiter(t *testing.T) {
+	config := NewRateLimitConfig().Realtime
+	require.Nil(t, newSessionRateLimiter(config, nil), "no limits configured")
+
+	config.ChatRate = 1
+	config.ChatBurst = 1
+	config.CloseThreshold = 2
+	limiter := newSessionRateLimiter(config, nil)
+	require.NotNil(t, limiter)
+
+	now := time.Now()
+	chat := &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessageSend{ChannelMessageSend: &rtapi.ChannelMessageSend{ChannelId: "2...room"}}}
+	rpc := &rtapi.Envelope{Message: &rtapi.Envelope_Rpc{}}
+
+	category, allowed := limiter.allow(chat, now)
+	assert.Equal(t, rateLimitCategoryChat, category)
+	assert.True(t, allowed)
+
+	_, allowed = limiter.allow(chat, now)
+	assert.False(t, allowed)
+	assert.False(t, limiter.exceeded())
+
+	category, allowed = limiter.allow(rpc, now)
+	assert.Equal(t, rateLimitCategoryRpc, category)
+	assert.True(t, allowed, "budgets are separate per category")
+
+	_, allowed = limiter.allow(chat, now)
+	assert.False(t, allowed)
+	assert.True(t, limiter.exceeded())
+
+	// The close window restarts once it has passed.
+	now = now.Add(time.Duration(config.CloseWindowSec+1) * time.Second)
+	_, allowed = limiter.allow(chat, now)
+	assert.True(t, allowed)
+	_, allowed = limiter.allow(chat, now)
+	assert.False(t, allowed)
+	assert.False(t, limiter.exceeded())
+}
+
+func TestChannelRateLimiter(t *testing.T) {
+	config := NewRateLimitConfig().Realtime
+	config.ChannelChatRate = 1
+	config.ChannelChatBurst = 1
+	channels := newChannelRateLimiter(config)
+	require.NotNil(t, channels)
+
+	now := time.Now()
+	send := func(channelID string) *rtapi.Envelope {
+		return &rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessageSend{ChannelMessageSend: &rtapi.ChannelMessageSend{ChannelId: channelID}}}
+	}
+
+	first := newSessionRateLimiter(config, channels)
+	second := newSessionRateLimiter(config, channels)
+
+	_, allowed := first.allow(send("2...a"), now)
+	assert.True(t, allowed)
+	_, allowed = second.allow(send("2...a"), now)
+	assert.False(t, allowed, "channel budget is shared between sessions")
+	assert.False(t, second.exceeded())
+	_, allowed = second.allow(send("2...b"), now)
+	assert.True(t, allowed)
+
+	// Idle channels are swept.
+	now = now.Add(rateLimiterSweepInterval)
+	_, allowed = first.allow(send("2...c"), now)
+	assert.True(t, allowed)
+	assert.Len(t, channels.buckets, 1)
+}
+
+func TestApiChatRateLimiter(t *testing.T) {
+	config := NewRateLimitConfig().Realtime
+	require.Nil(t, newApiChatRateLimiter(config, nil), "no limits configured")
+
+	config.ChatRate = 1
+	config.ChatBurst = 1
+	config.ChannelChatRate = 1
+	config.ChannelChatBurst = 2
+	channels := newChannelRateLimiter(config)
+	limiter := newApiChatRateLimiter(config, channels)
+	require.NotNil(t, limiter)
+
+	now := time.Now()
+	userOne := uuid.Must(uuid.NewV4())
+	userTwo := uuid.Must(uuid.NewV4())
+
+	assert.True(t, limiter.allow(userOne, "2...a", now))
+	assert.False(t, limiter.allow(userOne, "2...b", now), "the chat rate applies per user across channels")
+	assert.True(t, limiter.allow(userTwo, "2...a", now))
+
+	// The channel budget is shared with realtime sessions.
+	session := newSessionRateLimiter(config, channels)
+	_, allowed := session.allow(&rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessageSend{ChannelMessageSend: &rtapi.ChannelMessageSend{ChannelId: "2...a"}}}, now)
+	assert.False(t, allowed)
+}
+
+func TestApiRateLimiter(t *testing.T) {
+	cfg := NewConfig(loggerForTest(t))
+	cfg.RateLimit.Api.IpRate = 1
+	cfg.RateLimit.Api.IpBurst = 3
+	cfg.RateLimit.Api.MethodRate = 1
+	cfg.RateLimit.Api.MethodBurst = 1
+
+	rpcRateLimits := &MapOf[string, *RuntimeRpcRateLimit]{}
+	rpcRateLimits.Store("unlimited", &RuntimeRpcRateLimit{Rate: 0, Burst: 1})
+	rpcRateLimits.Store("bursty", &RuntimeRpcRateLimit{Rate: 1, Burst: 2})
+	limiter := NewApiRateLimiter(cfg, &Runtime{rpcRateLimits: rpcRateLimits})
+
+	now := time.Now()
+	userOne := uuid.Must(uuid.NewV4())
+	userTwo := uuid.Must(uuid.NewV4())
+
+	t.Run("per caller and method", func(t *testing.T) {
+		assert.True(t, limiter.Allow("10.0.0.1", userOne, "GetAccount", "", now))
+		assert.False(t, limiter.Allow("10.0.0.1", userOne, "GetAccount", "", now))
+		assert.True(t, limiter.Allow("10.0.0.1", userTwo, "GetAccount", "", now), "users have separate budgets")
+		assert.False(t, limiter.Allow("10.0.0.1", userOne, "ListFriends", "", now), "the IP budget is shared across methods")
+	})
+
+	t.Run("anonymous callers are limited by IP", func(t *testing.T) {
+		assert.True(t, limiter.Allow("10.0.0.2", uuid.Nil, "AuthenticateEmail", "", now))
+		assert.False(t, limiter.Allow("10.0.0.2", uuid.Nil, "AuthenticateEmail", "", now))
+		assert.True(t, limiter.Allow("10.0.0.3", uuid.Nil, "AuthenticateEmail", "", now))
+	})
+
+	t.Run("rpc overrides", func(t *testing.T) {
+		cfg.RateLimit.Api.IpRate = 0
+		defer func() { cfg.RateLimit.Api.IpRate = 1 }()
+
+		for i := 0; i < 5; i++ {
+			assert.True(t, limiter.Allow("10.0.0.4", userOne, "RpcFunc", "unlimited", now))
+		}
+		assert.True(t, limiter.Allow("10.0.0.4", userOne, "RpcFunc", "bursty", now))
+		assert.True(t, limiter.Allow("10.0.0.4", userOne, "RpcFunc", "bursty", now))
+		assert.False(t, limiter.Allow("10.0.0.4", userOne, "RpcFunc", "bursty", now))
+		assert.True(t, limiter.Allow("10.0.0.4", userOne, "RpcFunc", "other", now), "other RPCs use the method limit")
+		assert.False(t, limiter.Allow("10.0.0.4", userOne, "RpcFunc", "other", now))
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"github.com/heroiclabs/nakama/v3/social"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -80,6 +81,10 @@ type (
 	RuntimeAfterAuthenticateGoogleFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateGoogleRequest) error
 	RuntimeBeforeAuthenticateSteamFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AuthenticateSteamRequest) (*api.AuthenticateSteamRequest, error, codes.Code)
 	RuntimeAfterAuthenticateSteamFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateSteamRequest) error
+	RuntimeBeforeAuthenticateOIDCFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AuthenticateOIDCRequest) (*apigrpc.AuthenticateOIDCRequest, error, codes.Code)
+	RuntimeBeforeAuthenticateMFAFunction                   func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AuthenticateMFARequest) (*apigrpc.AuthenticateMFARequest, error, codes.Code)
+	RuntimeAfterAuthenticateOIDCFunction                   func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *apigrpc.AuthenticateOIDCRequest) error
+	RuntimeAfterAuthenticateMFAFunction                    func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *apigrpc.AuthenticateMFARequest) error
 	RuntimeBeforeListChannelMessagesFunction               func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.ListChannelMessagesRequest) (*api.ListChannelMessagesRequest, error, codes.Code)
 	RuntimeAfterListChannelMessagesFunction                func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.ChannelMessageList, in *api.ListChannelMessagesRequest) error
 	RuntimeBeforeListFriendsFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.ListFriendsRequest) (*api.ListFriendsRequest, error, codes.Code)
@@ -150,6 +155,8 @@ type (
 	RuntimeAfterLinkGoogleFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountGoogle) error
 	RuntimeBeforeLinkSteamFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.LinkSteamRequest) (*api.LinkSteamRequest, error, codes.Code)
 	RuntimeAfterLinkSteamFunction                          func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.LinkSteamRequest) error
+	RuntimeBeforeLinkOIDCFunction                          func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AccountOIDC) (*apigrpc.AccountOIDC, error, codes.Code)
+	RuntimeAfterLinkOIDCFunction                           func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AccountOIDC) error
 	RuntimeBeforeListMatchesFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.ListMatchesRequest) (*api.ListMatchesRequest, error, codes.Code)
 	RuntimeAfterListMatchesFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.MatchList, in *api.ListMatchesRequest) error
 	RuntimeBeforeListNotificationsFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.ListNotificationsRequest) (*api.ListNotificationsRequest, error, codes.Code)
@@ -192,6 +199,8 @@ type (
 	RuntimeAfterUnlinkGoogleFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountGoogle) error
 	RuntimeBeforeUnlinkSteamFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountSteam) (*api.AccountSteam, error, codes.Code)
 	RuntimeAfterUnlinkSteamFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountSteam) error
+	RuntimeBeforeUnlinkOIDCFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AccountOIDC) (*apigrpc.AccountOIDC, error, codes.Code)
+	RuntimeAfterUnlinkOIDCFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AccountOIDC) error
 	RuntimeBeforeGetUsersFunction                          func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.GetUsersRequest) (*api.GetUsersRequest, error, codes.Code)
 	RuntimeAfterGetUsersFunction                           func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Users, in *api.GetUsersRequest) error
 	RuntimeBeforeEventFunction                             func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.Event) (*api.Event, error, codes.Code)
@@ -365,6 +374,8 @@ type RuntimeBeforeReqFunctions struct {
 	beforeAuthenticateGameCenterFunction            RuntimeBeforeAuthenticateGameCenterFunction
 	beforeAuthenticateGoogleFunction                RuntimeBeforeAuthenticateGoogleFunction
 	beforeAuthenticateSteamFunction                 RuntimeBeforeAuthenticateSteamFunction
+	beforeAuthenticateOIDCFunction                  RuntimeBeforeAuthenticateOIDCFunction
+	beforeAuthenticateMFAFunction                   RuntimeBeforeAuthenticateMFAFunction
 	beforeListChannelMessagesFunction               RuntimeBeforeListChannelMessagesFunction
 	beforeListFriendsFunction                       RuntimeBeforeListFriendsFunction
 	beforeListFriendsOfFriendsFunction              RuntimeBeforeListFriendsOfFriendsFunction
@@ -400,6 +411,7 @@ type RuntimeBeforeReqFunctions struct {
 	beforeLinkGameCenterFunction                    RuntimeBeforeLinkGameCenterFunction
 	beforeLinkGoogleFunction                        RuntimeBeforeLinkGoogleFunction
 	beforeLinkSteamFunction                         RuntimeBeforeLinkSteamFunction
+	beforeLinkOIDCFunction                          RuntimeBeforeLinkOIDCFunction
 	beforeListMatchesFunction                       RuntimeBeforeListMatchesFunction
 	beforeListNotificationsFunction                 RuntimeBeforeListNotificationsFunction
 	beforeDeleteNotificationsFunction               RuntimeBeforeDeleteNotificationsFunction
@@ -421,6 +433,7 @@ type RuntimeBeforeReqFunctions struct {
 	beforeUnlinkGameCenterFunction                  RuntimeBeforeUnlinkGameCenterFunction
 	beforeUnlinkGoogleFunction                      RuntimeBeforeUnlinkGoogleFunction
 	beforeUnlinkSteamFunction                       RuntimeBeforeUnlinkSteamFunction
+	beforeUnlinkOIDCFunction                        RuntimeBeforeUnlinkOIDCFunction
 	beforeGetUsersFunction                          RuntimeBeforeGetUsersFunction
 	beforeEventFunction                             RuntimeBeforeEventFunction
 	beforeValidatePurchaseAppleFunction             RuntimeBeforeValidatePurchaseAppleFunction
@@ -449,6 +462,8 @@ type RuntimeAfterReqFunctions struct {
 	afterAuthenticateGameCenterFunction            RuntimeAfterAuthenticateGameCenterFunction
 	afterAuthenticateGoogleFunction                RuntimeAfterAuthenticateGoogleFunction
 	afterAuthenticateSteamFunction                 RuntimeAfterAuthenticateSteamFunction
+	afterAuthenticateOIDCFunction                  RuntimeAfterAuthenticateOIDCFunction
+	afterAuthenticateMFAFunction                   RuntimeAfterAuthenticateMFAFunction
 	afterListChannelMessagesFunction               RuntimeAfterListChannelMessagesFunction
 	afterListFriendsFunction                       RuntimeAfterListFriendsFunction
 	afterListFriendsOfFriendsFunction              RuntimeAfterListFriendsOfFriendsFunction
@@ -484,6 +499,7 @@ type RuntimeAfterReqFunctions struct {
 	afterLinkGameCenterFunction                    RuntimeAfterLinkGameCenterFunction
 	afterLinkGoogleFunction                        RuntimeAfterLinkGoogleFunction
 	afterLinkSteamFunction                         RuntimeAfterLinkSteamFunction
+	afterLinkOIDCFunction                          RuntimeAfterLinkOIDCFunction
 	afterListMatchesFunction                       RuntimeAfterListMatchesFunction
 	afterListNotificationsFunction                 RuntimeAfterListNotificationsFunction
 	afterDeleteNotificationsFunction               RuntimeAfterDeleteNotificationsFunction
@@ -505,6 +521,7 @@ type RuntimeAfterReqFunctions struct {
 	afterUnlinkGameCenterFunction                  RuntimeAfterUnlinkGameCenterFunction
 	afterUnlinkGoogleFunction                      RuntimeAfterUnlinkGoogleFunction
 	afterUnlinkSteamFunction                       RuntimeAfterUnlinkSteamFunction
+	afterUnlinkOIDCFunction                        RuntimeAfterUnlinkOIDCFunction
 	afterGetUsersFunction                          RuntimeAfterGetUsersFunction
 	afterEventFunction                             RuntimeAfterEventFunction
 	afterValidatePurchaseAppleFunction             RuntimeAfterValidatePurchaseAppleFunction
@@ -518,10 +535,17 @@ type RuntimeAfterReqFunctions struct {
 	afterGetMatchmakerStatsFunction                RuntimeAfterGetMatchmakerStatsFunction
 }
 
+// RuntimeRpcRateLimit overrides the configured API rate limit for a single RPC function.
+type RuntimeRpcRateLimit struct {
+	Rate  float64
+	Burst int
+}
+
 type Runtime struct {
 	matchCreateFunction RuntimeMatchCreateFunction
 
-	rpcFunctions map[string]RuntimeRpcFunction
+	rpcFunctions  map[string]RuntimeRpcFunction
+	rpcRateLimits *MapOf[string, *RuntimeRpcRateLimit]
 
 	beforeRtFunctions map[string]RuntimeBeforeRtFunction
 	afterRtFunctions  map[string]RuntimeAfterRtFunction
@@ -650,7 +674,7 @@ func CheckRuntime(logger *zap.Logger, config Config, version string) error {
 	return nil
 }
 
-func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, storageIndex StorageIndex, fmCallbackHandler runtime.FmCallbackHandler) (*Runtime, *RuntimeInfo, error) {
+func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, storageIndex StorageIndex, groupIndex GroupIndex, fmCallbackHandler runtime.FmCallbackHandler) (*Runtime, *RuntimeInfo, error) {
 	runtimeConfig := config.GetRuntime()
 	startupLogger.Info("Initialising runtime", zap.String("path", runtimeConfig.Path))
 
@@ -664,20 +688,21 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	startupLogger.Info("Runtime event queue processor started", zap.Int("size", config.GetRuntime().EventQueueSize), zap.Int("workers", config.GetRuntime().EventQueueWorkers))
 
 	matchProvider := NewMatchProvider()
+	rpcRateLimits := &MapOf[string, *RuntimeRpcRateLimit]{}
 
-	goModules, goRPCFns, goBeforeRtFns, goAfterRtFns, goBeforeReqFns, goAfterReqFns, goMatchmakerMatchedFn, goMatchmakerCustomMatchingFn, goTournamentEndFn, goTournamentResetFn, goLeaderboardResetFn, goShutdownFn, goPurchaseNotificationAppleFn, goSubscriptionNotificationAppleFn, goPurchaseNotificationGoogleFn, goSubscriptionNotificationGoogleFn, goIndexFilterFns, fleetManager, httpHandlers, allEventFns, goMatchNamesListFn, err := NewRuntimeProviderGo(ctx, logger, startupLogger, db, protojsonMarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, storageIndex, runtimeConfig.Path, paths, eventQueue, matchProvider, fmCallbackHandler)
+	goModules, goRPCFns, goBeforeRtFns, goAfterRtFns, goBeforeReqFns, goAfterReqFns, goMatchmakerMatchedFn, goMatchmakerCustomMatchingFn, goTournamentEndFn, goTournamentResetFn, goLeaderboardResetFn, goShutdownFn, goPurchaseNotificationAppleFn, goSubscriptionNotificationAppleFn, goPurchaseNotificationGoogleFn, goSubscriptionNotificationGoogleFn, goIndexFilterFns, fleetManager, httpHandlers, allEventFns, goMatchNamesListFn, err := NewRuntimeProviderGo(ctx, logger, startupLogger, db, protojsonMarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, storageIndex, groupIndex, runtimeConfig.Path, paths, eventQueue, matchProvider, rpcRateLimits, fmCallbackHandler)
 	if err != nil {
 		startupLogger.Error("Error initialising Go runtime provider", zap.Error(err))
 		return nil, nil, err
 	}
 
-	luaModules, luaRPCFns, luaBeforeRtFns, luaAfterRtFns, luaBeforeReqFns, luaAfterReqFns, luaMatchmakerMatchedFn, luaTournamentEndFn, luaTournamentResetFn, luaLeaderboardResetFn, luaShutdownFn, luaPurchaseNotificationAppleFn, luaSubscriptionNotificationAppleFn, luaPurchaseNotificationGoogleFn, luaSubscriptionNotificationGoogleFn, luaIndexFilterFns, err := NewRuntimeProviderLua(ctx, logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, allEventFns.eventFunction, runtimeConfig.Path, paths, matchProvider, storageIndex)
+	luaModules, luaRPCFns, luaBeforeRtFns, luaAfterRtFns, luaBeforeReqFns, luaAfterReqFns, luaMatchmakerMatchedFn, luaTournamentEndFn, luaTournamentResetFn, luaLeaderboardResetFn, luaShutdownFn, luaPurchaseNotificationAppleFn, luaSubscriptionNotificationAppleFn, luaPurchaseNotificationGoogleFn, luaSubscriptionNotificationGoogleFn, luaIndexFilterFns, err := NewRuntimeProviderLua(ctx, logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, allEventFns.eventFunction, runtimeConfig.Path, paths, matchProvider, rpcRateLimits, storageIndex, groupIndex)
 	if err != nil {
 		startupLogger.Error("Error initialising Lua runtime provider", zap.Error(err))
 		return nil, nil, err
 	}
 
-	jsModules, jsRPCFns, jsBeforeRtFns, jsAfterRtFns, jsBeforeReqFns, jsAfterReqFns, jsMatchmakerMatchedFn, jsTournamentEndFn, jsTournamentResetFn, jsLeaderboardResetFn, jsShutdownFn, jsPurchaseNotificationAppleFn, jsSubscriptionNotificationAppleFn, jsPurchaseNotificationGoogleFn, jsSubscriptionNotificationGoogleFn, jsIndexFilterFns, err := NewRuntimeProviderJS(ctx, logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, allEventFns.eventFunction, runtimeConfig.Path, runtimeConfig.JsEntrypoint, matchProvider, storageIndex)
+	jsModules, jsRPCFns, jsBeforeRtFns, jsAfterRtFns, jsBeforeReqFns, jsAfterReqFns, jsMatchmakerMatchedFn, jsTournamentEndFn, jsTournamentResetFn, jsLeaderboardResetFn, jsShutdownFn, jsPurchaseNotificationAppleFn, jsSubscriptionNotificationAppleFn, jsPurchaseNotificationGoogleFn, jsSubscriptionNotificationGoogleFn, jsIndexFilterFns, err := NewRuntimeProviderJS(ctx, logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, allEventFns.eventFunction, runtimeConfig.Path, runtimeConfig.JsEntrypoint, matchProvider, rpcRateLimits, storageIndex, groupIndex)
 	if err != nil {
 		startupLogger.Error("Error initialising JavaScript runtime provider", zap.Error(err))
 		return nil, nil, err
@@ -721,6 +746,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		goRpcIDs[id] = true
 		startupLogger.Info("Registered Go runtime RPC function invocation", zap.String("id", id))
 	}
+	rpcRateLimits.Range(func(id string, limit *RuntimeRpcRateLimit) bool {
+		startupLogger.Info("Registered runtime RPC function rate limit", zap.String("id", id), zap.Float64("rate", limit.Rate), zap.Int("burst", limit.Burst))
+		return true
+	})
 
 	allBeforeRtFunctions := make(map[string]RuntimeBeforeRtFunction, len(jsBeforeRtFns)+len(luaBeforeRtFns)+len(goBeforeRtFns))
 	for id, fn := range jsBeforeRtFns {
@@ -797,6 +826,12 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allBeforeReqFunctions.beforeAuthenticateSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if allBeforeReqFunctions.beforeAuthenticateOIDCFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
+	if allBeforeReqFunctions.beforeAuthenticateMFAFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "authenticatemfa"))
+	}
 	if allBeforeReqFunctions.beforeListChannelMessagesFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "listchannelmessages"))
 	}
@@ -902,6 +937,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allBeforeReqFunctions.beforeLinkSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "linksteam"))
 	}
+	if allBeforeReqFunctions.beforeLinkOIDCFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "linkoidc"))
+	}
 	if allBeforeReqFunctions.beforeListMatchesFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "listmatches"))
 	}
@@ -965,6 +1003,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allBeforeReqFunctions.beforeUnlinkSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "unlinksteam"))
 	}
+	if allBeforeReqFunctions.beforeUnlinkOIDCFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if allBeforeReqFunctions.beforeGetUsersFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "getusers"))
 	}
@@ -1057,6 +1098,14 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeAuthenticateSteamFunction = luaBeforeReqFns.beforeAuthenticateSteamFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if luaBeforeReqFns.beforeAuthenticateOIDCFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateOIDCFunction = luaBeforeReqFns.beforeAuthenticateOIDCFunction
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
+	if luaBeforeReqFns.beforeAuthenticateMFAFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateMFAFunction = luaBeforeReqFns.beforeAuthenticateMFAFunction
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticatemfa"))
+	}
 	if luaBeforeReqFns.beforeListChannelMessagesFunction != nil {
 		allBeforeReqFunctions.beforeListChannelMessagesFunction = luaBeforeReqFns.beforeListChannelMessagesFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listchannelmessages"))
@@ -1193,6 +1242,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeLinkSteamFunction = luaBeforeReqFns.beforeLinkSteamFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "linksteam"))
 	}
+	if luaBeforeReqFns.beforeLinkOIDCFunction != nil {
+		allBeforeReqFunctions.beforeLinkOIDCFunction = luaBeforeReqFns.beforeLinkOIDCFunction
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "linkoidc"))
+	}
 	if luaBeforeReqFns.beforeListMatchesFunction != nil {
 		allBeforeReqFunctions.beforeListMatchesFunction = luaBeforeReqFns.beforeListMatchesFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listmatches"))
@@ -1277,6 +1330,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeUnlinkSteamFunction = luaBeforeReqFns.beforeUnlinkSteamFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "unlinksteam"))
 	}
+	if luaBeforeReqFns.beforeUnlinkOIDCFunction != nil {
+		allBeforeReqFunctions.beforeUnlinkOIDCFunction = luaBeforeReqFns.beforeUnlinkOIDCFunction
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if luaBeforeReqFns.beforeGetUsersFunction != nil {
 		allBeforeReqFunctions.beforeGetUsersFunction = luaBeforeReqFns.beforeGetUsersFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "getusers"))
@@ -1379,6 +1436,14 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeAuthenticateSteamFunction = goBeforeReqFns.beforeAuthenticateSteamFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if goBeforeReqFns.beforeAuthenticateMFAFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateMFAFunction = goBeforeReqFns.beforeAuthenticateMFAFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticatemfa"))
+	}
+	if goBeforeReqFns.beforeAuthenticateOIDCFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateOIDCFunction = goBeforeReqFns.beforeAuthenticateOIDCFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if goBeforeReqFns.beforeListChannelMessagesFunction != nil {
 		allBeforeReqFunctions.beforeListChannelMessagesFunction = goBeforeReqFns.beforeListChannelMessagesFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listchannelmessages"))
@@ -1511,6 +1576,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeLinkGoogleFunction = goBeforeReqFns.beforeLinkGoogleFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "linkgoogle"))
 	}
+	if goBeforeReqFns.beforeLinkOIDCFunction != nil {
+		allBeforeReqFunctions.beforeLinkOIDCFunction = goBeforeReqFns.beforeLinkOIDCFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "linkoidc"))
+	}
 	if goBeforeReqFns.beforeLinkSteamFunction != nil {
 		allBeforeReqFunctions.beforeLinkSteamFunction = goBeforeReqFns.beforeLinkSteamFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "linksteam"))
@@ -1595,6 +1664,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeUnlinkGoogleFunction = goBeforeReqFns.beforeUnlinkGoogleFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinkgoogle"))
 	}
+	if goBeforeReqFns.beforeUnlinkOIDCFunction != nil {
+		allBeforeReqFunctions.beforeUnlinkOIDCFunction = goBeforeReqFns.beforeUnlinkOIDCFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if goBeforeReqFns.beforeUnlinkSteamFunction != nil {
 		allBeforeReqFunctions.beforeUnlinkSteamFunction = goBeforeReqFns.beforeUnlinkSteamFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinksteam"))
@@ -1687,6 +1760,12 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allAfterReqFunctions.afterAuthenticateSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if allAfterReqFunctions.afterAuthenticateOIDCFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
+	if allAfterReqFunctions.afterAuthenticateMFAFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "authenticatemfa"))
+	}
 	if allAfterReqFunctions.afterListChannelMessagesFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "listchannelmessages"))
 	}
@@ -1789,6 +1868,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allAfterReqFunctions.afterLinkSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "linksteam"))
 	}
+	if allAfterReqFunctions.afterLinkOIDCFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "linkoidc"))
+	}
 	if allAfterReqFunctions.afterListMatchesFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "listmatches"))
 	}
@@ -1852,6 +1934,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allAfterReqFunctions.afterUnlinkSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "unlinksteam"))
 	}
+	if allAfterReqFunctions.afterUnlinkOIDCFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if allAfterReqFunctions.afterGetUsersFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "getusers"))
 	}
@@ -1944,6 +2029,14 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterAuthenticateSteamFunction = luaAfterReqFns.afterAuthenticateSteamFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if luaAfterReqFns.afterAuthenticateOIDCFunction != nil {
+		allAfterReqFunctions.afterAuthenticateOIDCFunction = luaAfterReqFns.afterAuthenticateOIDCFunction
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
+	if luaAfterReqFns.afterAuthenticateMFAFunction != nil {
+		allAfterReqFunctions.afterAuthenticateMFAFunction = luaAfterReqFns.afterAuthenticateMFAFunction
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticatemfa"))
+	}
 	if luaAfterReqFns.afterListChannelMessagesFunction != nil {
 		allAfterReqFunctions.afterListChannelMessagesFunction = luaAfterReqFns.afterListChannelMessagesFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listchannelmessages"))
@@ -2076,6 +2169,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterLinkSteamFunction = luaAfterReqFns.afterLinkSteamFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "linksteam"))
 	}
+	if luaAfterReqFns.afterLinkOIDCFunction != nil {
+		allAfterReqFunctions.afterLinkOIDCFunction = luaAfterReqFns.afterLinkOIDCFunction
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "linkoidc"))
+	}
 	if luaAfterReqFns.afterListMatchesFunction != nil {
 		allAfterReqFunctions.afterListMatchesFunction = luaAfterReqFns.afterListMatchesFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listmatches"))
@@ -2160,6 +2257,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterUnlinkSteamFunction = luaAfterReqFns.afterUnlinkSteamFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "unlinksteam"))
 	}
+	if luaAfterReqFns.afterUnlinkOIDCFunction != nil {
+		allAfterReqFunctions.afterUnlinkOIDCFunction = luaAfterReqFns.afterUnlinkOIDCFunction
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if luaAfterReqFns.afterGetUsersFunction != nil {
 		allAfterReqFunctions.afterGetUsersFunction = luaAfterReqFns.afterGetUsersFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "getusers"))
@@ -2258,6 +2359,14 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterAuthenticateSteamFunction = goAfterReqFns.afterAuthenticateSteamFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if goAfterReqFns.afterAuthenticateMFAFunction != nil {
+		allAfterReqFunctions.afterAuthenticateMFAFunction = goAfterReqFns.afterAuthenticateMFAFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticatemfa"))
+	}
+	if goAfterReqFns.afterAuthenticateOIDCFunction != nil {
+		allAfterReqFunctions.afterAuthenticateOIDCFunction = goAfterReqFns.afterAuthenticateOIDCFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if goAfterReqFns.afterListChannelMessagesFunction != nil {
 		allAfterReqFunctions.afterListChannelMessagesFunction = goAfterReqFns.afterListChannelMessagesFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listchannelmessages"))
@@ -2394,6 +2503,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterLinkGoogleFunction = goAfterReqFns.afterLinkGoogleFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "linkgoogle"))
 	}
+	if goAfterReqFns.afterLinkOIDCFunction != nil {
+		allAfterReqFunctions.afterLinkOIDCFunction = goAfterReqFns.afterLinkOIDCFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "linkoidc"))
+	}
 	if goAfterReqFns.afterLinkSteamFunction != nil {
 		allAfterReqFunctions.afterLinkSteamFunction = goAfterReqFns.afterLinkSteamFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "linksteam"))
@@ -2478,6 +2591,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterUnlinkGoogleFunction = goAfterReqFns.afterUnlinkGoogleFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinkgoogle"))
 	}
+	if goAfterReqFns.afterUnlinkOIDCFunction != nil {
+		allAfterReqFunctions.afterUnlinkOIDCFunction = goAfterReqFns.afterUnlinkOIDCFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if goAfterReqFns.afterUnlinkSteamFunction != nil {
 		allAfterReqFunctions.afterUnlinkSteamFunction = goAfterReqFns.afterUnlinkSteamFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinksteam"))
@@ -2684,6 +2801,7 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	return &Runtime{
 		matchCreateFunction:                    matchProvider.CreateMatch,
 		rpcFunctions:                           allRPCFunctions,
+		rpcRateLimits:                          rpcRateLimits,
 		beforeRtFunctions:                      allBeforeRtFunctions,
 		afterRtFunctions:                       allAfterRtFunctions,
 		beforeReqFunctions:                     allBeforeReqFunctions,
@@ -2783,6 +2901,11 @@ func (r *Runtime) Rpc(id string) RuntimeRpcFunction {
 	return r.rpcFunctions[id]
 }
 
+func (r *Runtime) RpcRateLimit(id string) *RuntimeRpcRateLimit {
+	limit, _ := r.rpcRateLimits.Load(id)
+	return limit
+}
+
 func (r *Runtime) BeforeRt(id string) RuntimeBeforeRtFunction {
 	return r.beforeRtFunctions[id]
 }
@@ -2911,6 +3034,21 @@ func (r *Runtime) AfterAuthenticateSteam() RuntimeAfterAuthenticateSteamFunction
 	return r.afterReqFunctions.afterAuthenticateSteamFunction
 }
 
+func (r *Runtime) BeforeAuthenticateOIDC() RuntimeBeforeAuthenticateOIDCFunction {
+	return r.beforeReqFunctions.beforeAuthenticateOIDCFunction
+}
+
+func (r *Runtime) AfterAuthenticateOIDC() RuntimeAfterAuthenticateOIDCFunction {
+	return r.afterReqFunctions.afterAuthenticateOIDCFunction
+}
+func (r *Runtime) BeforeAuthenticateMFA() RuntimeBeforeAuthenticateMFAFunction {
+	return r.beforeReqFunctions.beforeAuthenticateMFAFunction
+}
+
+func (r *Runtime) AfterAuthenticateMFA() RuntimeAfterAuthenticateMFAFunction {
+	return r.afterReqFunctions.afterAuthenticateMFAFunction
+}
+
 func (r *Runtime) BeforeListChannelMessages() RuntimeBeforeListChannelMessagesFunction {
 	return r.beforeReqFunctions.beforeListChannelMessagesFunction
 }
@@ -3191,6 +3329,14 @@ func (r *Runtime) AfterLinkSteam() RuntimeAfterLinkSteamFunction {
 	return r.afterReqFunctions.afterLinkSteamFunction
 }
 
+func (r *Runtime) BeforeLinkOIDC() RuntimeBeforeLinkOIDCFunction {
+	return r.beforeReqFunctions.beforeLinkOIDCFunction
+}
+
+func (r *Runtime) AfterLinkOIDC() RuntimeAfterLinkOIDCFunction {
+	return r.afterReqFunctions.afterLinkOIDCFunction
+}
+
 func (r *Runtime) BeforeListMatches() RuntimeBeforeListMatchesFunction {
 	return r.beforeReqFunctions.beforeListMatchesFunction
 }
@@ -3359,6 +3505,14 @@ func (r *Runtime) AfterUnlinkSteam() RuntimeAfterUnlinkSteamFunction {
 	return r.afterReqFunctions.afterUnlinkSteamFunction
 }
 
+func (r *Runtime) BeforeUnlinkOIDC() RuntimeBeforeUnlinkOIDCFunction {
+	return r.beforeReqFunctions.beforeUnlinkOIDCFunction
+}
+
+func (r *Runtime) AfterUnlinkOIDC() RuntimeAfterUnlinkOIDCFunction {
+	return r.afterReqFunctions.afterUnlinkOIDCFunction
+}
+
 func (r *Runtime) BeforeGetUsers() RuntimeBeforeGetUsersFunction {
 	return r.beforeReqFunctions.beforeGetUsersFunction
 }
@@ -65,9 +65,10 @@ type RuntimeGoNakamaModule struct {
 	satori               runtime.Satori
 	fleetManager         runtime.FleetManager
 	storageIndex         StorageIndex
+	groupIndex           GroupIndex
 }
 
-func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, storageIndex StorageIndex) *RuntimeGoNakamaModule {
+func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, storageIndex StorageIndex, groupIndex GroupIndex) *RuntimeGoNakamaModule {
 	return &RuntimeGoNakamaModule{
 		logger:               logger,
 		db:                   db,
@@ -86,6 +87,7 @@ func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler
 		streamManager:        streamManager,
 		router:               router,
 		storageIndex:         storageIndex,
+		groupIndex:           groupIndex,
 
 		node: config.GetName(),
 
@@ -433,8 +435,8 @@ func (n *RuntimeGoNakamaModule) AuthenticateTokenGenerate(userID, username strin
 
 	tokenId := uuid.Must(uuid.NewV4()).String()
 	tokenIssuedAt := time.Now().Unix()
-	token, exp := generateTokenWithExpiry(n.config.GetSession().EncryptionKey, tokenId, tokenIssuedAt, userID, username, vars, exp)
-	n.sessionCache.Add(uid, exp, tokenId, 0, "")
+	token, exp := generateTokenWithExpiry(n.config.GetSession(), tokenId, tokenIssuedAt, userID, username, vars, exp)
+	n.sessionCache.Add(uid, exp, tokenId, 0, "", &SessionDevice{AuthMethod: "runtime"})
 	return token, exp, nil
 }
 
@@ -550,7 +552,7 @@ func (n *RuntimeGoNakamaModule) AccountDeleteId(ctx context.Context, userID stri
 		return errors.New("expects user ID to be a valid identifier")
 	}
 
-	return DeleteAccount(ctx, n.logger, n.db, n.config, n.leaderboardCache, n.leaderboardRankCache, n.sessionRegistry, n.sessionCache, n.tracker, u, recorded)
+	return DeleteAccount(ctx, n.logger, n.db, n.config, n.leaderboardCache, n.leaderboardRankCache, n.groupIndex, n.sessionRegistry, n.sessionCache, n.tracker, u, recorded)
 }
 
 // @group accounts
@@ -694,7 +696,7 @@ func (n *RuntimeGoNakamaModule) UsersBanId(ctx context.Context, userIDs []string
 }
 
 // @group users
-// @summary Unban one or more users by ID.
+// @summary Unban one or more users by ID. Fails if any of the users is scheduled for deletion, those accounts must be restored instead.
 // @param ctx(type=context.Context) The context object represents information about the server and requester.
 // @param userIds(type=[]string) An array of user IDs to unban.
 // @return error(error) An optional error value if an error occurred.
@@ -1794,7 +1796,7 @@ func (n *RuntimeGoNakamaModule) NotificationsDelete(ctx context.Context, notific
 	}
 
 	for uid, notificationIDs := range ns {
-		err := NotificationDelete(ctx, n.logger, n.db, uid, notificationIDs)
+		err := NotificationDelete(ctx, n.logger, n.db, n.router, uid, notificationIDs)
 		if err != nil {
 			return err
 		}
@@ -1835,7 +1837,7 @@ func (n *RuntimeGoNakamaModule) NotificationsList(ctx context.Context, userID st
 		return nil, "", errors.New("expects a valid user id")
 	}
 
-	list, err := NotificationList(ctx, n.logger, n.db, uid, limit, cursor, false)
+	list, err := NotificationList(ctx, n.logger, n.db, uid, limit, cursor, false, nil)
 	if err != nil {
 		return nil, "", err
 	}
@@ -1850,7 +1852,7 @@ func (n *RuntimeGoNakamaModule) NotificationsList(ctx context.Context, userID st
 // @param ids(type=[]string) A list of notification ids.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeGoNakamaModule) NotificationsDeleteId(ctx context.Context, userID string, ids []string) error {
-	return NotificationsDeleteId(ctx, n.logger, n.db, userID, ids...)
+	return NotificationsDeleteId(ctx, n.logger, n.db, n.router, userID, ids...)
 }
 
 // @group notifications
@@ -3525,7 +3527,7 @@ func (n *RuntimeGoNakamaModule) GroupCreate(ctx context.Context, userID, name, c
 		return nil, errors.New("expects max_count to be >= 1")
 	}
 
-	return CreateGroup(ctx, n.logger, n.db, uid, cid, name, langTag, description, avatarUrl, metadataStr, open, maxCount)
+	return CreateGroup(ctx, n.logger, n.db, n.groupIndex, uid, cid, name, langTag, description, avatarUrl, metadataStr, open, maxCount)
 }
 
 // @group groups
@@ -3598,7 +3600,7 @@ func (n *RuntimeGoNakamaModule) GroupUpdate(ctx context.Context, id, userID, nam
 		metadataWrapper = &wrapperspb.StringValue{Value: string(metadataBytes)}
 	}
 
-	return UpdateGroup(ctx, n.logger, n.db, groupID, uid, creator, nameWrapper, langTagWrapper, descriptionWrapper, avatarURLWrapper, metadataWrapper, openWrapper, maxCount)
+	return UpdateGroup(ctx, n.logger, n.db, n.groupIndex, groupID, uid, creator, nameWrapper, langTagWrapper, descriptionWrapper, avatarURLWrapper, metadataWrapper, openWrapper, maxCount)
 }
 
 // @group groups
@@ -3612,7 +3614,7 @@ func (n *RuntimeGoNakamaModule) GroupDelete(ctx context.Context, id string) erro
 		return errors.New("expects group ID to be a valid identifier")
 	}
 
-	return DeleteGroup(ctx, n.logger, n.db, n.tracker, groupID, uuid.Nil)
+	return DeleteGroup(ctx, n.logger, n.db, n.groupIndex, n.tracker, groupID, uuid.Nil)
 }
 
 // @group groups
@@ -3637,7 +3639,7 @@ func (n *RuntimeGoNakamaModule) GroupUserJoin(ctx context.Context, groupID, user
 		return errors.New("expects a username string")
 	}
 
-	return JoinGroup(ctx, n.logger, n.db, n.tracker, n.router, group, user, username)
+	return JoinGroup(ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, group, user, username)
 }
 
 // @group groups
@@ -3662,7 +3664,7 @@ func (n *RuntimeGoNakamaModule) GroupUserLeave(ctx context.Context, groupID, use
 		return errors.New("expects a username string")
 	}
 
-	return LeaveGroup(ctx, n.logger, n.db, n.tracker, n.router, n.streamManager, group, user, username)
+	return LeaveGroup(ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, group, user, username)
 }
 
 // @group groups
@@ -3702,7 +3704,7 @@ func (n *RuntimeGoNakamaModule) GroupUsersAdd(ctx context.Context, callerID, gro
 		users = append(users, uid)
 	}
 
-	return AddGroupUsers(ctx, n.logger, n.db, n.tracker, n.router, caller, group, users)
+	return AddGroupUsers(ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, caller, group, users)
 }
 
 // @group groups
@@ -3742,7 +3744,7 @@ func (n *RuntimeGoNakamaModule) GroupUsersBan(ctx context.Context, callerID, gro
 		users = append(users, uid)
 	}
 
-	return BanGroupUsers(ctx, n.logger, n.db, n.tracker, n.router, n.streamManager, caller, group, users)
+	return BanGroupUsers(ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, caller, group, users)
 }
 
 // @group groups
@@ -3782,7 +3784,7 @@ func (n *RuntimeGoNakamaModule) GroupUsersKick(ctx context.Context, callerID, gr
 		users = append(users, uid)
 	}
 
-	return KickGroupUsers(ctx, n.logger, n.db, n.tracker, n.router, n.streamManager, caller, group, users, false)
+	return KickGroupUsers(ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, caller, group, users, false)
 }
 
 // @group groups
@@ -3822,7 +3824,7 @@ func (n *RuntimeGoNakamaModule) GroupUsersPromote(ctx context.Context, callerID,
 		users = append(users, uid)
 	}
 
-	return PromoteGroupUsers(ctx, n.logger, n.db, n.router, caller, group, users)
+	return PromoteGroupUsers(ctx, n.logger, n.db, n.groupIndex, n.router, caller, group, users)
 }
 
 // @group groups
@@ -4154,7 +4156,7 @@ func (n *RuntimeGoNakamaModule) FriendsAdd(ctx context.Context, userID string, u
 	allIDs = append(allIDs, ids...)
 	allIDs = append(allIDs, fetchIDs...)
 
-	err = AddFriends(ctx, n.logger, n.db, n.tracker, n.router, userUUID, username, allIDs)
+	err = AddFriends(ctx, n.logger, n.db, n.tracker, n.router, n.config.GetFriend(), userUUID, username, allIDs, "")
 	if err != nil {
 		return err
 	}
@@ -4309,7 +4311,7 @@ func (n *RuntimeGoNakamaModule) ChannelMessageSend(ctx context.Context, channelI
 		contentStr = string(contentBytes)
 	}
 
-	return ChannelMessageSend(ctx, n.logger, n.db, n.router, channelIdToStreamResult.Stream, channelId, contentStr, senderId, senderUsername, persist)
+	return ChannelMessageSend(ctx, n.logger, n.db, n.router, nil, channelIdToStreamResult.Stream, channelId, contentStr, senderId, senderUsername, persist)
 }
 
 // @group chat
@@ -4342,7 +4344,7 @@ func (n *RuntimeGoNakamaModule) ChannelMessageUpdate(ctx context.Context, channe
 		contentStr = string(contentBytes)
 	}
 
-	return ChannelMessageUpdate(ctx, n.logger, n.db, n.router, channelIdToStreamResult.Stream, channelId, messageId, contentStr, senderId, senderUsername, persist)
+	return ChannelMessageUpdate(ctx, n.logger, n.db, n.router, nil, channelIdToStreamResult.Stream, channelId, messageId, contentStr, senderId, senderUsername, persist)
 }
 
 // @group chat
@@ -62,6 +62,7 @@ type jsMatchHandlers struct {
 
 type RuntimeJavascriptCallbacks struct {
 	Rpc                            map[string]string
+	RpcRateLimits                  map[string]*RuntimeRpcRateLimit
 	Before                         map[string]string
 	After                          map[string]string
 	StorageIndexFilter             map[string]string
@@ -140,6 +141,10 @@ func (im *RuntimeJavascriptInitModule) mappings(r *goja.Runtime) map[string]func
 		"registerAfterAuthenticateGoogle":                 im.registerAfterAuthenticateGoogle(r),
 		"registerBeforeAuthenticateSteam":                 im.registerBeforeAuthenticateSteam(r),
 		"registerAfterAuthenticateSteam":                  im.registerAfterAuthenticateSteam(r),
+		"registerBeforeAuthenticateOIDC":                  im.registerBeforeAuthenticateOIDC(r),
+		"registerAfterAuthenticateOIDC":                   im.registerAfterAuthenticateOIDC(r),
+		"registerBeforeAuthenticateMFA":                   im.registerBeforeAuthenticateMFA(r),
+		"registerAfterAuthenticateMFA":                    im.registerAfterAuthenticateMFA(r),
 		"registerBeforeSessionRefresh":                    im.registerBeforeSessionRefresh(r),
 		"registerAfterSessionRefresh":                     im.registerAfterSessionRefresh(r),
 		"registerBeforeListChannelMessages":               im.registerBeforeListChannelMessages(r),
@@ -212,6 +217,8 @@ func (im *RuntimeJavascriptInitModule) mappings(r *goja.Runtime) map[string]func
 		"registerAfterLinkGoogle":                         im.registerAfterLinkGoogle(r),
 		"registerBeforeLinkSteam":                         im.registerBeforeLinkSteam(r),
 		"registerAfterLinkSteam":                          im.registerAfterLinkSteam(r),
+		"registerBeforeLinkOIDC":                          im.registerBeforeLinkOIDC(r),
+		"registerAfterLinkOIDC":                           im.registerAfterLinkOIDC(r),
 		"registerBeforeListMatches":                       im.registerBeforeListMatches(r),
 		"registerAfterListMatches":                        im.registerAfterListMatches(r),
 		"registerBeforeListNotifications":                 im.registerBeforeListNotifications(r),
@@ -254,6 +261,8 @@ func (im *RuntimeJavascriptInitModule) mappings(r *goja.Runtime) map[string]func
 		"registerAfterUnlinkGoogle":                       im.registerAfterUnlinkGoogle(r),
 		"registerBeforeUnlinkSteam":                       im.registerBeforeUnlinkSteam(r),
 		"registerAfterUnlinkSteam":                        im.registerAfterUnlinkSteam(r),
+		"registerBeforeUnlinkOIDC":                        im.registerBeforeUnlinkOIDC(r),
+		"registerAfterUnlinkOIDC":                         im.registerAfterUnlinkOIDC(r),
 		"registerBeforeGetUsers":                          im.registerBeforeGetUsers(r),
 		"registerAfterGetUsers":                           im.registerAfterGetUsers(r),
 		"registerBeforeValidatePurchaseApple":             im.registerBeforeValidatePurchaseApple(r),
@@ -411,12 +420,38 @@ func (im *RuntimeJavascriptInitModule) registerRpc(r *goja.Runtime) func(goja.Fu
 			panic(r.NewTypeError("expects a function"))
 		}
 
+		var rateLimit *RuntimeRpcRateLimit
+		if rateLimitIn := f.Argument(2); !goja.IsUndefined(rateLimitIn) && !goja.IsNull(rateLimitIn) {
+			rateLimitMap, ok := rateLimitIn.Export().(map[string]any)
+			if !ok {
+				panic(r.NewTypeError("expects rate limit to be an object"))
+			}
+			rate, ok := rateLimitMap["rate"]
+			if !ok {
+				panic(r.NewTypeError("expects rate limit rate to be set"))
+			}
+			burst, ok := rateLimitMap["burst"]
+			if !ok {
+				panic(r.NewTypeError("expects rate limit burst to be set"))
+			}
+			rateLimit = &RuntimeRpcRateLimit{Rate: getJsFloat(r, r.ToValue(rate)), Burst: int(getJsInt(r, r.ToValue(burst)))}
+			if rateLimit.Rate < 0 {
+				panic(r.NewTypeError("expects rate limit rate to be 0 or greater"))
+			}
+			if rateLimit.Burst < 1 {
+				panic(r.NewTypeError("expects rate limit burst to be 1 or greater"))
+			}
+		}
+
 		fnKey, err := im.extractRpcFn(r, key)
 		if err != nil {
 			panic(r.NewGoError(err))
 		}
 
 		lKey := strings.ToLower(key)
+		if rateLimit != nil {
+			im.Callbacks.RpcRateLimits[lKey] = rateLimit
+		}
 		im.registerCallbackFn(RuntimeExecutionModeRPC, lKey, fnKey)
 		im.announceCallbackFn(RuntimeExecutionModeRPC, lKey)
 
@@ -603,6 +638,22 @@ func (im *RuntimeJavascriptInitModule) registerAfterAuthenticateSteam(r *goja.Ru
 	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterAuthenticateSteam", "authenticatesteam")
 }
 
+func (im *RuntimeJavascriptInitModule) registerBeforeAuthenticateOIDC(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeAuthenticateOIDC", "authenticateoidc")
+}
+
+func (im *RuntimeJavascriptInitModule) registerAfterAuthenticateOIDC(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterAuthenticateOIDC", "authenticateoidc")
+}
+
+func (im *RuntimeJavascriptInitModule) registerBeforeAuthenticateMFA(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeAuthenticateMFA", "authenticatemfa")
+}
+
+func (im *RuntimeJavascriptInitModule) registerAfterAuthenticateMFA(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterAuthenticateMFA", "authenticatemfa")
+}
+
 func (im *RuntimeJavascriptInitModule) registerBeforeSessionRefresh(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeSessionRefresh", "sessionrefresh")
 }
@@ -891,6 +942,14 @@ func (im *RuntimeJavascriptInitModule) registerAfterLinkSteam(r *goja.Runtime) f
 	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterLinkSteam", "linksteam")
 }
 
+func (im *RuntimeJavascriptInitModule) registerBeforeLinkOIDC(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeLinkOIDC", "linkoidc")
+}
+
+func (im *RuntimeJavascriptInitModule) registerAfterLinkOIDC(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterLinkOIDC", "linkoidc")
+}
+
 func (im *RuntimeJavascriptInitModule) registerBeforeListMatches(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeListMatches", "listmatches")
 }
@@ -1059,6 +1118,14 @@ func (im *RuntimeJavascriptInitModule) registerAfterUnlinkSteam(r *goja.Runtime)
 	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterUnlinkSteam", "unlinksteam")
 }
 
+func (im *RuntimeJavascriptInitModule) registerBeforeUnlinkOIDC(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeUnlinkOIDC", "unlinkoidc")
+}
+
+func (im *RuntimeJavascriptInitModule) registerAfterUnlinkOIDC(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterUnlinkOIDC", "unlinkoidc")
+}
+
 func (im *RuntimeJavascriptInitModule) registerBeforeGetUsers(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeGetUsers", "getusers")
 }
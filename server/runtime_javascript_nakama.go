@@ -47,6 +47,7 @@ import (
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"github.com/heroiclabs/nakama/v3/internal/cronexpr"
 	"github.com/heroiclabs/nakama/v3/internal/satori"
 	"github.com/heroiclabs/nakama/v3/social"
@@ -80,6 +81,7 @@ type RuntimeJavascriptNakamaModule struct {
 	streamManager        StreamManager
 	router               MessageRouter
 	storageIndex         StorageIndex
+	groupIndex           GroupIndex
 
 	node          string
 	matchCreateFn RuntimeMatchCreateFunction
@@ -88,7 +90,7 @@ type RuntimeJavascriptNakamaModule struct {
 	satori runtime.Satori
 }
 
-func NewRuntimeJavascriptNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, storageIndex StorageIndex, localCache *RuntimeJavascriptLocalCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, eventFn RuntimeEventCustomFunction, matchCreateFn RuntimeMatchCreateFunction) *RuntimeJavascriptNakamaModule {
+func NewRuntimeJavascriptNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, storageIndex StorageIndex, groupIndex GroupIndex, localCache *RuntimeJavascriptLocalCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, eventFn RuntimeEventCustomFunction, matchCreateFn RuntimeMatchCreateFunction) *RuntimeJavascriptNakamaModule {
 	return &RuntimeJavascriptNakamaModule{
 		ctx:                  context.Background(),
 		logger:               logger,
@@ -112,6 +114,7 @@ func NewRuntimeJavascriptNakamaModule(logger *zap.Logger, db *sql.DB, protojsonM
 		httpClient:           &http.Client{},
 		httpClientInsecure:   &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}},
 		storageIndex:         storageIndex,
+		groupIndex:           groupIndex,
 
 		node:          config.GetName(),
 		eventFn:       eventFn,
@@ -191,6 +194,7 @@ func (n *RuntimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"accountsGetId":                        n.accountsGetId(r),
 		"accountUpdateId":                      n.accountUpdateId(r),
 		"accountDeleteId":                      n.accountDeleteId(r),
+		"accountMerge":                         n.accountMerge(r),
 		"accountExportId":                      n.accountExportId(r),
 		"usersGetId":                           n.usersGetId(r),
 		"usersGetUsername":                     n.usersGetUsername(r),
@@ -233,6 +237,8 @@ func (n *RuntimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"matchList":                            n.matchList(r),
 		"matchSignal":                          n.matchSignal(r),
 		"notificationSend":                     n.notificationSend(r),
+		"notificationSchedule":                 n.notificationSchedule(r),
+		"notificationScheduleCancel":           n.notificationScheduleCancel(r),
 		"notificationSendAll":                  n.notificationSendAll(r),
 		"notificationsList":                    n.notificationsList(r),
 		"notificationsSend":                    n.notificationsSend(r),
@@ -287,10 +293,27 @@ func (n *RuntimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"groupUpdate":                          n.groupUpdate(r),
 		"groupDelete":                          n.groupDelete(r),
 		"groupUsersKick":                       n.groupUsersKick(r),
+		"groupRoleSet":                         n.groupRoleSet(r),
+		"groupRoleDelete":                      n.groupRoleDelete(r),
+		"groupRolesList":                       n.groupRolesList(r),
+		"groupUserRoleAssign":                  n.groupUserRoleAssign(r),
+		"groupUserRoleUnassign":                n.groupUserRoleUnassign(r),
+		"groupUserRolesList":                   n.groupUserRolesList(r),
+		"groupUserPermissionCheck":             n.groupUserPermissionCheck(r),
+		"groupInvitesSend":                     n.groupInvitesSend(r),
+		"groupInvitesRevoke":                   n.groupInvitesRevoke(r),
+		"groupInviteAccept":                    n.groupInviteAccept(r),
+		"groupInviteDecline":                   n.groupInviteDecline(r),
+		"groupInvitesList":                     n.groupInvitesList(r),
+		"groupUserInvitesList":                 n.groupUserInvitesList(r),
+		"groupEventsList":                      n.groupEventsList(r),
 		"groupUsersList":                       n.groupUsersList(r),
 		"userGroupsList":                       n.userGroupsList(r),
 		"friendsList":                          n.friendsList(r),
 		"friendsOfFriendsList":                 n.friendsOfFriendsList(r),
+		"friendRecommendationsList":            n.friendRecommendationsList(r),
+		"friendRecommendationSignalSet":        n.friendRecommendationSignalSet(r),
+		"friendRecommendationSignalDelete":     n.friendRecommendationSignalDelete(r),
 		"friendsAdd":                           n.friendsAdd(r),
 		"friendsDelete":                        n.friendsDelete(r),
 		"friendsBlock":                         n.friendsBlock(r),
@@ -301,6 +324,7 @@ func (n *RuntimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"groupUsersPromote":                    n.groupUsersPromote(r),
 		"groupUsersDemote":                     n.groupUsersDemote(r),
 		"groupsList":                           n.groupsList(r),
+		"groupsSearch":                         n.groupsSearch(r),
 		"groupsGetRandom":                      n.groupsGetRandom(r),
 		"fileRead":                             n.fileRead(r),
 		"localcacheGet":                        n.localcacheGet(r),
@@ -312,6 +336,9 @@ func (n *RuntimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"channelMessageRemove":                 n.channelMessageRemove(r),
 		"channelMessagesList":                  n.channelMessagesList(r),
 		"channelIdBuild":                       n.channelIdBuild(r),
+		"channelRetentionSet":                  n.channelRetentionSet(r),
+		"channelRetentionGet":                  n.channelRetentionGet(r),
+		"channelRetentionDelete":               n.channelRetentionDelete(r),
 		"binaryToString":                       n.binaryToString(r),
 		"stringToBinary":                       n.stringToBinary(r),
 		"storageIndexList":                     n.storageIndexList(r),
@@ -1881,8 +1908,8 @@ func (n *RuntimeJavascriptNakamaModule) authenticateTokenGenerate(r *goja.Runtim
 
 		tokenId := uuid.Must(uuid.NewV4()).String()
 		tokenIssuedAt := time.Now().Unix()
-		token, exp := generateTokenWithExpiry(n.config.GetSession().EncryptionKey, tokenId, tokenIssuedAt, userIDString, username, vars, exp)
-		n.sessionCache.Add(uid, exp, tokenId, 0, "")
+		token, exp := generateTokenWithExpiry(n.config.GetSession(), tokenId, tokenIssuedAt, userIDString, username, vars, exp)
+		n.sessionCache.Add(uid, exp, tokenId, 0, "", &SessionDevice{AuthMethod: "runtime"})
 
 		return r.ToValue(map[string]interface{}{
 			"token": token,
@@ -2056,7 +2083,7 @@ func (n *RuntimeJavascriptNakamaModule) accountDeleteId(r *goja.Runtime) func(go
 			recorded = getJsBool(r, f.Argument(1))
 		}
 
-		if err := DeleteAccount(n.ctx, n.logger, n.db, n.config, n.leaderboardCache, n.rankCache, n.sessionRegistry, n.sessionCache, n.tracker, userID, recorded); err != nil {
+		if err := DeleteAccount(n.ctx, n.logger, n.db, n.config, n.leaderboardCache, n.rankCache, n.groupIndex, n.sessionRegistry, n.sessionCache, n.tracker, userID, recorded); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to delete account: %v", err.Error())))
 		}
 
@@ -2064,6 +2091,41 @@ func (n *RuntimeJavascriptNakamaModule) accountDeleteId(r *goja.Runtime) func(go
 	}
 }
 
+// @group accounts
+// @summary Merge a duplicate account into another account. Linked identities, storage objects, wallet balances and ledger, friends, group memberships and leaderboard records move to the target account, and the source account is deleted and recorded.
+// @param sourceUserId(type=string) User ID for the account to merge and delete. Must be valid UUID.
+// @param targetUserId(type=string) User ID for the account to merge into. Must be valid UUID.
+// @param storagePolicy(type=string, optional=true, default="target") How to handle storage objects with the same collection and key in both accounts: "target" keeps the target account's object, "source" keeps the source account's object, and "fail" aborts the merge.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) accountMerge(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		sourceID, err := uuid.FromString(getJsString(r, f.Argument(0)))
+		if err != nil {
+			panic(r.NewTypeError("invalid source user id"))
+		}
+
+		targetID, err := uuid.FromString(getJsString(r, f.Argument(1)))
+		if err != nil {
+			panic(r.NewTypeError("invalid target user id"))
+		}
+
+		storagePolicy := AccountMergeStorageKeepTarget
+		if !goja.IsUndefined(f.Argument(2)) && !goja.IsNull(f.Argument(2)) {
+			var ok bool
+			storagePolicy, ok = accountMergeStoragePolicies[getJsString(r, f.Argument(2))]
+			if !ok {
+				panic(r.NewTypeError("expects storage policy to be one of 'target', 'source' or 'fail'"))
+			}
+		}
+
+		if err := MergeAccounts(n.ctx, n.logger, n.db, n.config, n.leaderboardCache, n.rankCache, n.sessionRegistry, n.sessionCache, n.tracker, n.storageIndex, n.groupIndex, sourceID, targetID, storagePolicy); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to merge accounts: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
 // @group accounts
 // @summary Export account information for a specified user ID.
 // @param userId(type=string) User ID for the account to be exported. Must be valid UUID.
@@ -2299,7 +2361,7 @@ func (n *RuntimeJavascriptNakamaModule) usersBanId(r *goja.Runtime) func(goja.Fu
 }
 
 // @group users
-// @summary Unban one or more users by ID.
+// @summary Unban one or more users by ID. Fails if any of the users is scheduled for deletion, those accounts must be restored instead.
 // @param userIds(type=string[]) An array of user IDs to unban.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeJavascriptNakamaModule) usersUnbanId(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
@@ -3716,6 +3778,7 @@ func (n *RuntimeJavascriptNakamaModule) matchSignal(r *goja.Runtime) func(goja.F
 // @param code(type=number) Notification code to use. Must be equal or greater than 0.
 // @param sender(type=string, optional=true) The sender of this notification. If left empty, it will be assumed that it is a system notification.
 // @param persistent(type=bool, optional=true, default=false) Whether to record this in the database for later listing.
+// @param expirySec(type=number, optional=true, default=0) Number of seconds after which a persistent notification expires and is no longer listed. 0 means it never expires.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeJavascriptNakamaModule) notificationSend(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return func(f goja.FunctionCall) goja.Value {
@@ -3764,6 +3827,17 @@ func (n *RuntimeJavascriptNakamaModule) notificationSend(r *goja.Runtime) func(g
 			persistent = getJsBool(r, f.Argument(5))
 		}
 
+		var expiryTime time.Time
+		if f.Argument(6) != goja.Undefined() && f.Argument(6) != goja.Null() {
+			expirySec := getJsInt(r, f.Argument(6))
+			if expirySec < 0 {
+				panic(r.NewTypeError("expects expirySec to be 0 or a positive integer"))
+			}
+			if expirySec > 0 {
+				expiryTime = time.Now().Add(time.Duration(expirySec) * time.Second)
+			}
+		}
+
 		nots := []*api.Notification{{
 			Id:         uuid.Must(uuid.NewV4()).String(),
 			Subject:    subject,
@@ -3777,7 +3851,7 @@ func (n *RuntimeJavascriptNakamaModule) notificationSend(r *goja.Runtime) func(g
 			userID: nots,
 		}
 
-		if err := NotificationSend(n.ctx, n.logger, n.db, n.tracker, n.router, notifications); err != nil {
+		if err := NotificationSendWithExpiry(n.ctx, n.logger, n.db, n.tracker, n.router, notifications, expiryTime); err != nil {
 			panic(fmt.Sprintf("failed to send notifications: %s", err.Error()))
 		}
 
@@ -3785,6 +3859,111 @@ func (n *RuntimeJavascriptNakamaModule) notificationSend(r *goja.Runtime) func(g
 	}
 }
 
+// @group notifications
+// @summary Schedule one in-app notification to be sent to a user at a future time.
+// @param userId(type=string) The user ID of the user to be sent the notification.
+// @param subject(type=string) Notification subject.
+// @param content(type=object) Notification content. Must be set but can be empty object.
+// @param code(type=number) Notification code to use. Must be equal or greater than 0.
+// @param sendTime(type=number) The time to send the notification at, in UTC seconds since epoch.
+// @param sender(type=string, optional=true) The sender of this notification. If left empty, it will be assumed that it is a system notification.
+// @param persistent(type=bool, optional=true, default=false) Whether to record this in the database for later listing.
+// @param expirySec(type=number, optional=true, default=0) Number of seconds after sending that a persistent notification expires and is no longer listed. 0 means it never expires.
+// @return id(string) The ID of the scheduled notification, which is also used as the ID of the notification once sent.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) notificationSchedule(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userIDString := getJsString(r, f.Argument(0))
+		userID, err := uuid.FromString(userIDString)
+		if err != nil {
+			panic(r.NewTypeError("expects valid user id"))
+		}
+
+		subject := getJsString(r, f.Argument(1))
+		if subject == "" {
+			panic(r.NewTypeError("expects subject to be a non empty string"))
+		}
+
+		contentIn := f.Argument(2)
+		if contentIn == goja.Undefined() {
+			panic(r.NewTypeError("expects content"))
+		}
+		contentMap, ok := contentIn.Export().(map[string]interface{})
+		if !ok {
+			panic(r.NewTypeError("expects content to be an object"))
+		}
+		contentBytes, err := json.Marshal(contentMap)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to convert content: %s", err.Error())))
+		}
+
+		code := getJsInt(r, f.Argument(3))
+		if code <= 0 {
+			panic(r.NewGoError(errors.New("expects code number to be a positive integer")))
+		}
+
+		sendTime := getJsInt(r, f.Argument(4))
+		if sendTime <= 0 {
+			panic(r.NewTypeError("expects sendTime to be a positive UTC seconds timestamp"))
+		}
+
+		senderIdIn := f.Argument(5)
+		senderID := uuid.Nil.String()
+		if senderIdIn != goja.Undefined() && senderIdIn != goja.Null() {
+			suid, err := uuid.FromString(getJsString(r, senderIdIn))
+			if err != nil {
+				panic(r.NewTypeError("expects senderId to either be not set, empty string or a valid UUID"))
+			}
+			senderID = suid.String()
+		}
+
+		persistent := false
+		if f.Argument(6) != goja.Undefined() {
+			persistent = getJsBool(r, f.Argument(6))
+		}
+
+		var expirySec int64
+		if f.Argument(7) != goja.Undefined() && f.Argument(7) != goja.Null() {
+			expirySec = getJsInt(r, f.Argument(7))
+			if expirySec < 0 {
+				panic(r.NewTypeError("expects expirySec to be 0 or a positive integer"))
+			}
+		}
+
+		id, err := NotificationSchedule(n.ctx, n.logger, n.db, userID, &api.Notification{
+			Subject:    subject,
+			Content:    string(contentBytes),
+			Code:       int32(code),
+			SenderId:   senderID,
+			Persistent: persistent,
+		}, int(expirySec), time.Unix(sendTime, 0))
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to schedule notification: %s", err.Error())))
+		}
+
+		return r.ToValue(id)
+	}
+}
+
+// @group notifications
+// @summary Cancel a scheduled notification that has not been sent yet.
+// @param id(type=string) The ID of the scheduled notification.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) notificationScheduleCancel(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		id, err := uuid.FromString(getJsString(r, f.Argument(0)))
+		if err != nil {
+			panic(r.NewTypeError("expects id to be a valid UUID"))
+		}
+
+		if err := NotificationScheduleCancel(n.ctx, n.logger, n.db, id); err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to cancel scheduled notification: %s", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
 // @group notifications
 // @summary List notifications by user id.
 // @param userID(type=string) Optional userID to scope results to that user only.
@@ -3816,7 +3995,7 @@ func (n *RuntimeJavascriptNakamaModule) notificationsList(r *goja.Runtime) func(
 			cursor = getJsString(r, f.Argument(2))
 		}
 
-		list, err := NotificationList(n.ctx, n.logger, n.db, userID, limit, cursor, false)
+		list, err := NotificationList(n.ctx, n.logger, n.db, userID, limit, cursor, false, nil)
 		if err != nil {
 			panic(r.ToValue(r.NewGoError(fmt.Errorf("failed to list notifications: %s", err.Error()))))
 		}
@@ -4076,7 +4255,7 @@ func (n *RuntimeJavascriptNakamaModule) notificationsDelete(r *goja.Runtime) fun
 		}
 
 		for uid, notificationIDs := range notifications {
-			if err := NotificationDelete(n.ctx, n.logger, n.db, uid, notificationIDs); err != nil {
+			if err := NotificationDelete(n.ctx, n.logger, n.db, n.router, uid, notificationIDs); err != nil {
 				panic(r.NewGoError(fmt.Errorf("failed to delete notifications: %s", err.Error())))
 			}
 		}
@@ -4246,7 +4425,7 @@ func (n *RuntimeJavascriptNakamaModule) notificationsDeleteId(r *goja.Runtime) f
 			userId = getJsString(r, userIdIn)
 		}
 
-		if err := NotificationsDeleteId(n.ctx, n.logger, n.db, userId, notifIds...); err != nil {
+		if err := NotificationsDeleteId(n.ctx, n.logger, n.db, n.router, userId, notifIds...); err != nil {
 			panic(r.NewGoError(fmt.Errorf("failed to get notifications by id: %s", err.Error())))
 		}
 
@@ -7186,7 +7365,7 @@ func (n *RuntimeJavascriptNakamaModule) groupCreate(r *goja.Runtime) func(goja.F
 			maxCount = int(getJsInt(r, f.Argument(8)))
 		}
 
-		group, err := CreateGroup(n.ctx, n.logger, n.db, userID, creatorID, name, lang, desc, avatarURL, metadataStr, open, maxCount)
+		group, err := CreateGroup(n.ctx, n.logger, n.db, n.groupIndex, userID, creatorID, name, lang, desc, avatarURL, metadataStr, open, maxCount)
 		if err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to create group: %v", err.Error())))
 		}
@@ -7305,7 +7484,7 @@ func (n *RuntimeJavascriptNakamaModule) groupUpdate(r *goja.Runtime) func(goja.F
 			maxCount = int(getJsInt(r, f.Argument(9)))
 		}
 
-		if err = UpdateGroup(n.ctx, n.logger, n.db, groupID, userId, creatorID, name, lang, desc, avatarURL, metadata, open, maxCount); err != nil {
+		if err = UpdateGroup(n.ctx, n.logger, n.db, n.groupIndex, groupID, userId, creatorID, name, lang, desc, avatarURL, metadata, open, maxCount); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to update group: %v", err.Error())))
 		}
 
@@ -7325,7 +7504,7 @@ func (n *RuntimeJavascriptNakamaModule) groupDelete(r *goja.Runtime) func(goja.F
 			panic(r.NewTypeError("expects group ID to be a valid identifier"))
 		}
 
-		if err = DeleteGroup(n.ctx, n.logger, n.db, n.tracker, groupID, uuid.Nil); err != nil {
+		if err = DeleteGroup(n.ctx, n.logger, n.db, n.groupIndex, n.tracker, groupID, uuid.Nil); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to delete group: %v", err.Error())))
 		}
 
@@ -7378,7 +7557,7 @@ func (n *RuntimeJavascriptNakamaModule) groupUsersKick(r *goja.Runtime) func(goj
 			callerID = cid
 		}
 
-		if err := KickGroupUsers(n.ctx, n.logger, n.db, n.tracker, n.router, n.streamManager, callerID, groupID, userIDs, false); err != nil {
+		if err := KickGroupUsers(n.ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, callerID, groupID, userIDs, false); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to kick users from a group: %v", err.Error())))
 		}
 
@@ -7387,14 +7566,12 @@ func (n *RuntimeJavascriptNakamaModule) groupUsersKick(r *goja.Runtime) func(goj
 }
 
 // @group groups
-// @summary List all members, admins and superadmins which belong to a group. This also list incoming join requests.
-// @param groupId(type=string) The ID of the group to list members for.
-// @param limit(type=int, optional=true, default=100) The maximum number of entries in the listing.
-// @param state(type=int, optional=true, default=null) The state of the user within the group. If unspecified this returns users in all states.
-// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
-// @return groupUsers(nkruntime.GroupUserList) The user information for members, admins and superadmins for the group. Also users who sent a join request.
+// @summary Create a custom role in a group, or replace the permissions of an existing role with the same name.
+// @param groupId(type=string) The ID of the group to create the role in.
+// @param name(type=string) The name of the role, between 1 and 64 characters.
+// @param permissions(type=string[]) Permission names granted by the role: "kick", "ban", "invite", "promote", "edit_metadata", "manage_chat" or "spend_wallet".
 // @return error(error) An optional error value if an error occurred.
-func (n *RuntimeJavascriptNakamaModule) groupUsersList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+func (n *RuntimeJavascriptNakamaModule) groupRoleSet(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return func(f goja.FunctionCall) goja.Value {
 		groupIDStr := getJsString(r, f.Argument(0))
 		groupID, err := uuid.FromString(groupIDStr)
@@ -7402,143 +7579,644 @@ func (n *RuntimeJavascriptNakamaModule) groupUsersList(r *goja.Runtime) func(goj
 			panic(r.NewTypeError("expects group ID to be a valid identifier"))
 		}
 
-		limit := 100
-		if !goja.IsUndefined(f.Argument(1)) && !goja.IsNull(f.Argument(1)) {
-			limit = int(getJsInt(r, f.Argument(1)))
-			if limit < 1 || limit > 10000 {
-				panic(r.NewTypeError("expects limit to be 1-10000"))
-			}
-		}
+		name := getJsString(r, f.Argument(1))
 
-		var stateWrapper *wrapperspb.Int32Value
-		if !goja.IsUndefined(f.Argument(2)) && !goja.IsNull(f.Argument(2)) {
-			state := getJsInt(r, f.Argument(2))
-			if state != -1 {
-				if state < 0 || state > 4 {
-					panic(r.NewTypeError("expects state to be 0-4"))
-				}
-				stateWrapper = &wrapperspb.Int32Value{Value: int32(state)}
-			}
+		permissionsArg := f.Argument(2)
+		if goja.IsUndefined(permissionsArg) || goja.IsNull(permissionsArg) {
+			panic(r.NewTypeError("expects an array of permissions"))
 		}
-
-		cursor := ""
-		if !goja.IsUndefined(f.Argument(3)) && !goja.IsNull(f.Argument(3)) {
-			cursor = getJsString(r, f.Argument(3))
+		names, err := exportToSlice[[]string](permissionsArg)
+		if err != nil {
+			panic(r.NewTypeError("expects an array of strings"))
 		}
-
-		res, err := ListGroupUsers(n.ctx, n.logger, n.db, n.statusRegistry, groupID, limit, stateWrapper, cursor)
+		permissions, err := GroupPermissionsParse(names)
 		if err != nil {
-			panic(r.NewGoError(fmt.Errorf("error while trying to list users in a group: %v", err.Error())))
+			panic(r.NewTypeError(err.Error()))
 		}
 
-		groupUsers := make([]interface{}, 0, len(res.GroupUsers))
-		for _, gu := range res.GroupUsers {
-			u := gu.User
-
-			guMap := make(map[string]interface{}, 18)
-
-			guMap["userId"] = u.Id
-			guMap["username"] = u.Username
-			guMap["displayName"] = u.DisplayName
-			guMap["avatarUrl"] = u.AvatarUrl
-			guMap["langTag"] = u.LangTag
-			guMap["location"] = u.Location
-			guMap["timezone"] = u.Timezone
-			if u.AppleId != "" {
-				guMap["appleId"] = u.AppleId
-			}
-			if u.FacebookId != "" {
-				guMap["facebookId"] = u.FacebookId
-			}
-			if u.FacebookInstantGameId != "" {
-				guMap["facebookInstantGameId"] = u.FacebookInstantGameId
-			}
-			if u.GoogleId != "" {
-				guMap["googleId"] = u.GoogleId
-			}
-			if u.GamecenterId != "" {
-				guMap["gamecenterId"] = u.GamecenterId
-			}
-			if u.SteamId != "" {
-				guMap["steamId"] = u.SteamId
-			}
-			guMap["online"] = u.Online
-			guMap["edgeCount"] = u.EdgeCount
-			guMap["createTime"] = u.CreateTime.Seconds
-			guMap["updateTime"] = u.UpdateTime.Seconds
+		if err := GroupRoleSet(n.ctx, n.logger, n.db, groupID, name, permissions); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to set group role: %v", err.Error())))
+		}
 
-			metadataMap := make(map[string]interface{})
-			err = json.Unmarshal([]byte(u.Metadata), &metadataMap)
-			if err != nil {
-				panic(r.NewGoError(fmt.Errorf("failed to convert metadata to json: %s", err.Error())))
-			}
-			pointerizeSlices(metadataMap)
-			guMap["metadata"] = metadataMap
+		return goja.Undefined()
+	}
+}
 
-			groupUsers = append(groupUsers, map[string]interface{}{
-				"user":  guMap,
-				"state": gu.State.Value,
-			})
+// @group groups
+// @summary Delete a custom role from a group, unassigning it from any users that held it.
+// @param groupId(type=string) The ID of the group to delete the role from.
+// @param name(type=string) The name of the role to delete.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupRoleDelete(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
 		}
 
-		result := make(map[string]interface{}, 2)
-		result["groupUsers"] = groupUsers
+		name := getJsString(r, f.Argument(1))
 
-		if res.Cursor == "" {
-			result["cursor"] = nil
-		} else {
-			result["cursor"] = res.Cursor
+		if err := GroupRoleDelete(n.ctx, n.logger, n.db, groupID, name); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to delete group role: %v", err.Error())))
 		}
 
-		return r.ToValue(result)
+		return goja.Undefined()
 	}
 }
 
 // @group groups
-// @summary List all groups which a user belongs to and whether they've been accepted or if it's an invite.
-// @param userId(type=string) The ID of the user to list groups for.
-// @return userGroups(nkruntime.UserGroupList) A table of groups with their fields.
-// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any).
+// @summary List the custom roles defined in a group.
+// @param groupId(type=string) The ID of the group to list roles for.
+// @return roles(nkruntime.GroupRole[]) A list of roles with their names and permissions.
 // @return error(error) An optional error value if an error occurred.
-func (n *RuntimeJavascriptNakamaModule) userGroupsList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+func (n *RuntimeJavascriptNakamaModule) groupRolesList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return func(f goja.FunctionCall) goja.Value {
-		userIDStr := getJsString(r, f.Argument(0))
-		userID, err := uuid.FromString(userIDStr)
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
 		if err != nil {
-			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
 		}
 
-		limit := 100
-		if !goja.IsUndefined(f.Argument(1)) && !goja.IsNull(f.Argument(1)) {
-			limit = int(getJsInt(r, f.Argument(1)))
-			if limit < 1 || limit > 100 {
-				panic(r.NewTypeError("expects limit to be 1-100"))
-			}
+		roles, err := GroupRolesList(n.ctx, n.logger, n.db, groupID)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list group roles: %v", err.Error())))
 		}
 
-		var stateWrapper *wrapperspb.Int32Value
-		if !goja.IsUndefined(f.Argument(2)) && !goja.IsNull(f.Argument(2)) {
-			state := getJsInt(r, f.Argument(2))
-			if state != -1 {
-				if state < 0 || state > 4 {
-					panic(r.NewTypeError("expects state to be 0-4"))
-				}
-				stateWrapper = &wrapperspb.Int32Value{Value: int32(state)}
-			}
+		results := make([]any, 0, len(roles))
+		for _, role := range roles {
+			results = append(results, map[string]any{
+				"name":        role.Name,
+				"permissions": role.Permissions.Names(),
+				"createTime":  role.CreateTime.Unix(),
+				"updateTime":  role.UpdateTime.Unix(),
+			})
 		}
 
-		cursor := ""
-		if !goja.IsUndefined(f.Argument(3)) && !goja.IsNull(f.Argument(3)) {
-			cursor = getJsString(r, f.Argument(3))
+		return r.ToValue(results)
+	}
+}
+
+// @group groups
+// @summary Assign a custom role to a group member, replacing any role they already held.
+// @param groupId(type=string) The ID of the group.
+// @param userId(type=string) The ID of the member to assign the role to.
+// @param name(type=string) The name of the role to assign.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupUserRoleAssign(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
 		}
 
-		res, err := ListUserGroups(n.ctx, n.logger, n.db, userID, limit, stateWrapper, cursor)
+		userIDStr := getJsString(r, f.Argument(1))
+		userID, err := uuid.FromString(userIDStr)
 		if err != nil {
-			panic(r.NewGoError(fmt.Errorf("error while trying to list groups for a user: %v", err.Error())))
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
 		}
 
-		userGroups := make([]interface{}, 0, len(res.UserGroups))
-		for _, ug := range res.UserGroups {
+		name := getJsString(r, f.Argument(2))
+
+		if err := GroupUserRoleAssign(n.ctx, n.logger, n.db, groupID, userID, name); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to assign group role: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+// @group groups
+// @summary Remove the custom role held by a group member, if any.
+// @param groupId(type=string) The ID of the group.
+// @param userId(type=string) The ID of the member to remove the role from.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupUserRoleUnassign(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		userIDStr := getJsString(r, f.Argument(1))
+		userID, err := uuid.FromString(userIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		if err := GroupUserRoleUnassign(n.ctx, n.logger, n.db, groupID, userID); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to unassign group role: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+// @group groups
+// @summary List the custom roles assigned to members of a group.
+// @param groupId(type=string) The ID of the group.
+// @return userRoles(nkruntime.GroupUserRole[]) A list of user IDs and the name of the role each holds.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupUserRolesList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		userRoles, err := GroupUserRolesList(n.ctx, n.logger, n.db, groupID)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list group user roles: %v", err.Error())))
+		}
+
+		results := make([]any, 0, len(userRoles))
+		for _, userRole := range userRoles {
+			results = append(results, map[string]any{
+				"userId": userRole.UserID.String(),
+				"role":   userRole.Role,
+			})
+		}
+
+		return r.ToValue(results)
+	}
+}
+
+// @group groups
+// @summary Check whether a user may perform an action in a group, either as an admin or through a custom role.
+// @param groupId(type=string) The ID of the group.
+// @param userId(type=string) The ID of the user to check.
+// @param permission(type=string) The permission to check: "kick", "ban", "invite", "promote", "edit_metadata", "manage_chat" or "spend_wallet".
+// @return allowed(bool) True if the user holds the permission.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupUserPermissionCheck(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		userIDStr := getJsString(r, f.Argument(1))
+		userID, err := uuid.FromString(userIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		permission, err := GroupPermissionParse(getJsString(r, f.Argument(2)))
+		if err != nil {
+			panic(r.NewTypeError(err.Error()))
+		}
+
+		allowed, err := GroupUserPermissionCheck(n.ctx, n.logger, n.db, groupID, userID, permission)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to check group permission: %v", err.Error())))
+		}
+
+		return r.ToValue(allowed)
+	}
+}
+
+// @group groups
+// @summary Invite users to join a group. Invited users become members once they accept the invite.
+// @param groupId(type=string) The ID of the group to invite users to.
+// @param userIds(type=string[]) Table array of user IDs to invite.
+// @param callerId(type=string, optional=true) User ID of the caller, will apply permissions checks of the user. If empty defaults to system user and permission checks are bypassed.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupInvitesSend(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		userIDs := groupInviteJsUserIDs(r, f.Argument(1))
+		callerID := groupInviteJsCallerID(r, f.Argument(2))
+
+		if err := GroupInvitesSend(n.ctx, n.logger, n.db, n.tracker, n.router, n.config.GetGroup(), callerID, groupID, userIDs); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to invite users to a group: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+// @group groups
+// @summary Revoke pending invites sent by a group.
+// @param groupId(type=string) The ID of the group the invites were sent from.
+// @param userIds(type=string[]) Table array of user IDs whose invites to revoke.
+// @param callerId(type=string, optional=true) User ID of the caller, will apply permissions checks of the user. If empty defaults to system user and permission checks are bypassed.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupInvitesRevoke(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		userIDs := groupInviteJsUserIDs(r, f.Argument(1))
+		callerID := groupInviteJsCallerID(r, f.Argument(2))
+
+		if err := GroupInvitesRevoke(n.ctx, n.logger, n.db, callerID, groupID, userIDs); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to revoke group invites: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+func groupInviteJsUserIDs(r *goja.Runtime, users goja.Value) []uuid.UUID {
+	if goja.IsUndefined(users) || goja.IsNull(users) {
+		panic(r.NewTypeError("expects an array of user ids"))
+	}
+	usersSlice, err := exportToSlice[[]string](users)
+	if err != nil {
+		panic(r.NewTypeError("expects an array of strings"))
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(usersSlice))
+	for _, id := range usersSlice {
+		userID, err := uuid.FromString(id)
+		if err != nil || userID == uuid.Nil {
+			panic(r.NewTypeError("expects user id to be valid identifier"))
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs
+}
+
+func groupInviteJsCallerID(r *goja.Runtime, caller goja.Value) uuid.UUID {
+	if goja.IsUndefined(caller) || goja.IsNull(caller) {
+		return uuid.Nil
+	}
+	callerID, err := uuid.FromString(getJsString(r, caller))
+	if err != nil {
+		panic(r.NewTypeError("expects caller id to be valid identifier"))
+	}
+	return callerID
+}
+
+// @group groups
+// @summary Accept an invite to join a group on behalf of the invited user.
+// @param groupId(type=string) The ID of the group the invite is for.
+// @param userId(type=string) The ID of the invited user.
+// @param username(type=string) The username of the invited user.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupInviteAccept(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		userIDStr := getJsString(r, f.Argument(1))
+		userID, err := uuid.FromString(userIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		username := getJsString(r, f.Argument(2))
+		if username == "" {
+			panic(r.NewTypeError("expects username string"))
+		}
+
+		if err := GroupInviteAccept(n.ctx, n.logger, n.db, n.groupIndex, n.router, groupID, userID, username); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to accept group invite: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+// @group groups
+// @summary Decline an invite to join a group on behalf of the invited user.
+// @param groupId(type=string) The ID of the group the invite is for.
+// @param userId(type=string) The ID of the invited user.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupInviteDecline(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		userIDStr := getJsString(r, f.Argument(1))
+		userID, err := uuid.FromString(userIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		if err := GroupInviteDecline(n.ctx, n.logger, n.db, groupID, userID); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to decline group invite: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+// @group groups
+// @summary List pending invites to join groups received by a user.
+// @param userId(type=string) The ID of the user to list invites for.
+// @param limit(type=number, optional=true, default=100) The maximum number of invites to list. Between 1 and 100.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return invites(nkruntime.GroupInviteList) A list of pending invites and an optional next page cursor.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupInvitesList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userIDStr := getJsString(r, f.Argument(0))
+		userID, err := uuid.FromString(userIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		limit, cursor := groupInviteJsListArgs(r, f.Argument(1), f.Argument(2))
+
+		list, err := GroupInvitesList(n.ctx, n.logger, n.db, userID, limit, cursor)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list group invites: %v", err.Error())))
+		}
+
+		return r.ToValue(groupInviteListToJs(r, list))
+	}
+}
+
+// @group groups
+// @summary List pending invites sent by a group.
+// @param groupId(type=string) The ID of the group to list invites for.
+// @param limit(type=number, optional=true, default=100) The maximum number of invites to list. Between 1 and 100.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return invites(nkruntime.GroupInviteList) A list of pending invites and an optional next page cursor.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupUserInvitesList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		limit, cursor := groupInviteJsListArgs(r, f.Argument(1), f.Argument(2))
+
+		list, err := GroupUserInvitesList(n.ctx, n.logger, n.db, groupID, limit, cursor)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list group invites: %v", err.Error())))
+		}
+
+		return r.ToValue(groupInviteListToJs(r, list))
+	}
+}
+
+func groupInviteJsListArgs(r *goja.Runtime, limitArg, cursorArg goja.Value) (int, string) {
+	limit := 100
+	if !goja.IsUndefined(limitArg) && !goja.IsNull(limitArg) {
+		limit = int(getJsInt(r, limitArg))
+		if limit < 1 || limit > 100 {
+			panic(r.NewTypeError("expects limit to be 1-100"))
+		}
+	}
+
+	cursor := ""
+	if !goja.IsUndefined(cursorArg) && !goja.IsNull(cursorArg) {
+		cursor = getJsString(r, cursorArg)
+	}
+	return limit, cursor
+}
+
+func groupInviteListToJs(r *goja.Runtime, list *apigrpc.GroupInviteList) map[string]interface{} {
+	invites := make([]interface{}, 0, len(list.Invites))
+	for _, invite := range list.Invites {
+		group, err := groupToJsObject(invite.Group)
+		if err != nil {
+			panic(r.NewGoError(err))
+		}
+		invites = append(invites, map[string]interface{}{
+			"group":      group,
+			"userId":     invite.UserId,
+			"inviterId":  invite.InviterId,
+			"createTime": invite.CreateTime.Seconds,
+			"expireTime": invite.ExpireTime.Seconds,
+		})
+	}
+
+	result := make(map[string]interface{}, 2)
+	result["invites"] = invites
+	if list.Cursor == "" {
+		result["cursor"] = nil
+	} else {
+		result["cursor"] = list.Cursor
+	}
+	return result
+}
+
+// @group groups
+// @summary List the activity feed of a group, newest first. Events record joins, leaves, kicks, bans, promotions, demotions and group updates along with the user who performed them.
+// @param groupId(type=string) The ID of the group to list events for.
+// @param limit(type=number, optional=true, default=100) The maximum number of events to list. Between 1 and 100.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return events(nkruntime.GroupEventList) A list of group events and an optional next page cursor.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupEventsList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		limit, cursor := groupInviteJsListArgs(r, f.Argument(1), f.Argument(2))
+
+		list, err := GroupEventsList(n.ctx, n.logger, n.db, groupID, limit, cursor)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list group events: %v", err.Error())))
+		}
+
+		events := make([]interface{}, 0, len(list.Events))
+		for _, event := range list.Events {
+			data := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(event.Data), &data); err != nil {
+				panic(r.NewGoError(fmt.Errorf("failed to convert group event data to json: %s", err.Error())))
+			}
+			events = append(events, map[string]interface{}{
+				"id":         event.Id,
+				"groupId":    event.GroupId,
+				"type":       event.Type,
+				"actorId":    event.ActorId,
+				"targetId":   event.TargetId,
+				"data":       data,
+				"createTime": event.CreateTime.Seconds,
+			})
+		}
+
+		result := make(map[string]interface{}, 2)
+		result["events"] = events
+		if list.Cursor == "" {
+			result["cursor"] = nil
+		} else {
+			result["cursor"] = list.Cursor
+		}
+		return r.ToValue(result)
+	}
+}
+
+// @group groups
+// @summary List all members, admins and superadmins which belong to a group. This also list incoming join requests.
+// @param groupId(type=string) The ID of the group to list members for.
+// @param limit(type=int, optional=true, default=100) The maximum number of entries in the listing.
+// @param state(type=int, optional=true, default=null) The state of the user within the group. If unspecified this returns users in all states.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return groupUsers(nkruntime.GroupUserList) The user information for members, admins and superadmins for the group. Also users who sent a join request.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupUsersList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		groupIDStr := getJsString(r, f.Argument(0))
+		groupID, err := uuid.FromString(groupIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects group ID to be a valid identifier"))
+		}
+
+		limit := 100
+		if !goja.IsUndefined(f.Argument(1)) && !goja.IsNull(f.Argument(1)) {
+			limit = int(getJsInt(r, f.Argument(1)))
+			if limit < 1 || limit > 10000 {
+				panic(r.NewTypeError("expects limit to be 1-10000"))
+			}
+		}
+
+		var stateWrapper *wrapperspb.Int32Value
+		if !goja.IsUndefined(f.Argument(2)) && !goja.IsNull(f.Argument(2)) {
+			state := getJsInt(r, f.Argument(2))
+			if state != -1 {
+				if state < 0 || state > 4 {
+					panic(r.NewTypeError("expects state to be 0-4"))
+				}
+				stateWrapper = &wrapperspb.Int32Value{Value: int32(state)}
+			}
+		}
+
+		cursor := ""
+		if !goja.IsUndefined(f.Argument(3)) && !goja.IsNull(f.Argument(3)) {
+			cursor = getJsString(r, f.Argument(3))
+		}
+
+		res, err := ListGroupUsers(n.ctx, n.logger, n.db, n.statusRegistry, groupID, limit, stateWrapper, cursor)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list users in a group: %v", err.Error())))
+		}
+
+		groupUsers := make([]interface{}, 0, len(res.GroupUsers))
+		for _, gu := range res.GroupUsers {
+			u := gu.User
+
+			guMap := make(map[string]interface{}, 18)
+
+			guMap["userId"] = u.Id
+			guMap["username"] = u.Username
+			guMap["displayName"] = u.DisplayName
+			guMap["avatarUrl"] = u.AvatarUrl
+			guMap["langTag"] = u.LangTag
+			guMap["location"] = u.Location
+			guMap["timezone"] = u.Timezone
+			if u.AppleId != "" {
+				guMap["appleId"] = u.AppleId
+			}
+			if u.FacebookId != "" {
+				guMap["facebookId"] = u.FacebookId
+			}
+			if u.FacebookInstantGameId != "" {
+				guMap["facebookInstantGameId"] = u.FacebookInstantGameId
+			}
+			if u.GoogleId != "" {
+				guMap["googleId"] = u.GoogleId
+			}
+			if u.GamecenterId != "" {
+				guMap["gamecenterId"] = u.GamecenterId
+			}
+			if u.SteamId != "" {
+				guMap["steamId"] = u.SteamId
+			}
+			guMap["online"] = u.Online
+			guMap["edgeCount"] = u.EdgeCount
+			guMap["createTime"] = u.CreateTime.Seconds
+			guMap["updateTime"] = u.UpdateTime.Seconds
+
+			metadataMap := make(map[string]interface{})
+			err = json.Unmarshal([]byte(u.Metadata), &metadataMap)
+			if err != nil {
+				panic(r.NewGoError(fmt.Errorf("failed to convert metadata to json: %s", err.Error())))
+			}
+			pointerizeSlices(metadataMap)
+			guMap["metadata"] = metadataMap
+
+			groupUsers = append(groupUsers, map[string]interface{}{
+				"user":  guMap,
+				"state": gu.State.Value,
+			})
+		}
+
+		result := make(map[string]interface{}, 2)
+		result["groupUsers"] = groupUsers
+
+		if res.Cursor == "" {
+			result["cursor"] = nil
+		} else {
+			result["cursor"] = res.Cursor
+		}
+
+		return r.ToValue(result)
+	}
+}
+
+// @group groups
+// @summary List all groups which a user belongs to and whether they've been accepted or if it's an invite.
+// @param userId(type=string) The ID of the user to list groups for.
+// @return userGroups(nkruntime.UserGroupList) A table of groups with their fields.
+// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any).
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) userGroupsList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userIDStr := getJsString(r, f.Argument(0))
+		userID, err := uuid.FromString(userIDStr)
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		limit := 100
+		if !goja.IsUndefined(f.Argument(1)) && !goja.IsNull(f.Argument(1)) {
+			limit = int(getJsInt(r, f.Argument(1)))
+			if limit < 1 || limit > 100 {
+				panic(r.NewTypeError("expects limit to be 1-100"))
+			}
+		}
+
+		var stateWrapper *wrapperspb.Int32Value
+		if !goja.IsUndefined(f.Argument(2)) && !goja.IsNull(f.Argument(2)) {
+			state := getJsInt(r, f.Argument(2))
+			if state != -1 {
+				if state < 0 || state > 4 {
+					panic(r.NewTypeError("expects state to be 0-4"))
+				}
+				stateWrapper = &wrapperspb.Int32Value{Value: int32(state)}
+			}
+		}
+
+		cursor := ""
+		if !goja.IsUndefined(f.Argument(3)) && !goja.IsNull(f.Argument(3)) {
+			cursor = getJsString(r, f.Argument(3))
+		}
+
+		res, err := ListUserGroups(n.ctx, n.logger, n.db, userID, limit, stateWrapper, cursor)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list groups for a user: %v", err.Error())))
+		}
+
+		userGroups := make([]interface{}, 0, len(res.UserGroups))
+		for _, ug := range res.UserGroups {
 			g := ug.Group
 
 			ugMap := make(map[string]interface{}, 12)
@@ -7719,12 +8397,134 @@ func (n *RuntimeJavascriptNakamaModule) friendsOfFriendsList(r *goja.Runtime) fu
 	}
 }
 
+// @group friends
+// @summary List users recommended as friends for a user, ranked by mutual friends, shared groups, recent matches played together and signals set with friendRecommendationSignalSet. Existing friends, pending invites and blocked users are never recommended.
+// @param userId(type=string) The ID of the user to list recommendations for.
+// @param limit(type=number, optional=true, default=10) The number of recommendations to list. Between 1 and 100.
+// @return recommendations(nkruntime.FriendRecommendation[]) The recommended users, best matches first, with the score and signals each recommendation is based on.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) friendRecommendationsList(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userID, err := uuid.FromString(getJsString(r, f.Argument(0)))
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		limit := 10
+		if !goja.IsUndefined(f.Argument(1)) && !goja.IsNull(f.Argument(1)) {
+			limit = int(getJsInt(r, f.Argument(1)))
+			if limit < 1 || limit > friendRecommendationMaxCandidates {
+				panic(r.NewTypeError("expects limit to be 1-100"))
+			}
+		}
+
+		list, err := FriendRecommendationsList(n.ctx, n.logger, n.db, n.statusRegistry, n.config.GetFriend(), userID, limit)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to list friend recommendations: %v", err.Error())))
+		}
+
+		recommendations := make([]interface{}, 0, len(list.Recommendations))
+		for _, recommendation := range list.Recommendations {
+			user, err := userToJsObject(recommendation.User)
+			if err != nil {
+				panic(r.NewGoError(err))
+			}
+			recommendations = append(recommendations, map[string]interface{}{
+				"user":              user,
+				"score":             recommendation.Score,
+				"mutualFriendCount": recommendation.MutualFriendCount,
+				"sharedGroupCount":  recommendation.SharedGroupCount,
+				"recentMatchCount":  recommendation.RecentMatchCount,
+				"signalScore":       recommendation.SignalScore,
+			})
+		}
+
+		return r.ToValue(recommendations)
+	}
+}
+
+// @group friends
+// @summary Set a signal recommending one user to another as a friend. Signals from different sources are added to the recommendation score, replacing any earlier signal from the same source.
+// @param userId(type=string) The ID of the user the recommendation is for.
+// @param candidateId(type=string) The ID of the user being recommended.
+// @param source(type=string) A name for the source of the signal. The 'match' source is reserved by the server.
+// @param weight(type=number) The score the signal adds to the recommendation.
+// @param expirySec(type=number, optional=true, default=0) Number of seconds until the signal expires. 0 for no expiry.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) friendRecommendationSignalSet(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userID, err := uuid.FromString(getJsString(r, f.Argument(0)))
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		candidateID, err := uuid.FromString(getJsString(r, f.Argument(1)))
+		if err != nil {
+			panic(r.NewTypeError("expects candidate ID to be a valid identifier"))
+		}
+		if candidateID == userID {
+			panic(r.NewTypeError("expects candidate ID to be a different user"))
+		}
+
+		source := getJsString(r, f.Argument(2))
+		if source == "" || len(source) > 32 {
+			panic(r.NewTypeError("expects source to be 1-32 characters"))
+		}
+		if source == FriendRecommendationSourceMatch {
+			panic(r.NewTypeError("expects source not to be reserved 'match' source"))
+		}
+
+		weight := getJsFloat(r, f.Argument(3))
+
+		expirySec := 0
+		if !goja.IsUndefined(f.Argument(4)) && !goja.IsNull(f.Argument(4)) {
+			expirySec = int(getJsInt(r, f.Argument(4)))
+			if expirySec < 0 {
+				panic(r.NewTypeError("expects expiry seconds to be >= 0"))
+			}
+		}
+
+		if err := FriendRecommendationSignalSet(n.ctx, n.logger, n.db, userID, candidateID, source, weight, expirySec); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to set friend recommendation signal: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+// @group friends
+// @summary Remove a signal recommending one user to another as a friend.
+// @param userId(type=string) The ID of the user the recommendation is for.
+// @param candidateId(type=string) The ID of the user being recommended.
+// @param source(type=string) The source of the signal to remove.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) friendRecommendationSignalDelete(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userID, err := uuid.FromString(getJsString(r, f.Argument(0)))
+		if err != nil {
+			panic(r.NewTypeError("expects user ID to be a valid identifier"))
+		}
+
+		candidateID, err := uuid.FromString(getJsString(r, f.Argument(1)))
+		if err != nil {
+			panic(r.NewTypeError("expects candidate ID to be a valid identifier"))
+		}
+
+		if err := FriendRecommendationSignalDelete(n.ctx, n.logger, n.db, userID, candidateID, getJsString(r, f.Argument(2))); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error while trying to delete friend recommendation signal: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
 // @group friends
 // @summary Add friends to a user.
 // @param userId(type=string) The ID of the user to whom you want to add friends.
 // @param username(type=string) The name of the user to whom you want to add friends.
 // @param ids(type=[]string) Table array of IDs of the users you want to add as friends.
 // @param usernames(type=[]string) Table array of usernames of the users you want to add as friends.
+// @param message(type=string, optional=true, default="") A short message included in the notification for any new friend invites.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeJavascriptNakamaModule) friendsAdd(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return func(f goja.FunctionCall) goja.Value {
@@ -7771,6 +8571,11 @@ func (n *RuntimeJavascriptNakamaModule) friendsAdd(r *goja.Runtime) func(goja.Fu
 			}
 		}
 
+		message := ""
+		if f.Argument(4) != goja.Undefined() && f.Argument(4) != goja.Null() {
+			message = getJsString(r, f.Argument(4))
+		}
+
 		if userIDs == nil && usernames == nil {
 			return goja.Undefined()
 		}
@@ -7789,7 +8594,7 @@ func (n *RuntimeJavascriptNakamaModule) friendsAdd(r *goja.Runtime) func(goja.Fu
 		allIDs = append(allIDs, userIDs...)
 		allIDs = append(allIDs, fetchIDs...)
 
-		err = AddFriends(n.ctx, n.logger, n.db, n.tracker, n.router, userID, username, allIDs)
+		err = AddFriends(n.ctx, n.logger, n.db, n.tracker, n.router, n.config.GetFriend(), userID, username, allIDs, message)
 		if err != nil {
 			panic(r.NewTypeError(err.Error()))
 		}
@@ -7988,7 +8793,7 @@ func (n *RuntimeJavascriptNakamaModule) groupUserJoin(r *goja.Runtime) func(goja
 			panic(r.NewTypeError("expects a username string"))
 		}
 
-		if err := JoinGroup(n.ctx, n.logger, n.db, n.tracker, n.router, groupID, userID, username); err != nil {
+		if err := JoinGroup(n.ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, groupID, userID, username); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to join group: %v", err.Error())))
 		}
 
@@ -8027,7 +8832,7 @@ func (n *RuntimeJavascriptNakamaModule) groupUserLeave(r *goja.Runtime) func(goj
 			panic(r.NewTypeError("expects a username string"))
 		}
 
-		if err := LeaveGroup(n.ctx, n.logger, n.db, n.tracker, n.router, n.streamManager, groupID, userID, username); err != nil {
+		if err := LeaveGroup(n.ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, groupID, userID, username); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to leave group: %v", err.Error())))
 		}
 
@@ -8086,7 +8891,7 @@ func (n *RuntimeJavascriptNakamaModule) groupUsersAdd(r *goja.Runtime) func(goja
 			callerID = cid
 		}
 
-		if err := AddGroupUsers(n.ctx, n.logger, n.db, n.tracker, n.router, callerID, groupID, uids); err != nil {
+		if err := AddGroupUsers(n.ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, callerID, groupID, uids); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to add users into group: %v", err.Error())))
 		}
 
@@ -8145,7 +8950,7 @@ func (n *RuntimeJavascriptNakamaModule) groupUsersBan(r *goja.Runtime) func(goja
 			callerID = cid
 		}
 
-		if err := BanGroupUsers(n.ctx, n.logger, n.db, n.tracker, n.router, n.streamManager, callerID, groupID, uids); err != nil {
+		if err := BanGroupUsers(n.ctx, n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, callerID, groupID, uids); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to ban users from group: %v", err.Error())))
 		}
 
@@ -8204,7 +9009,7 @@ func (n *RuntimeJavascriptNakamaModule) groupUsersPromote(r *goja.Runtime) func(
 			callerID = cid
 		}
 
-		if err := PromoteGroupUsers(n.ctx, n.logger, n.db, n.router, callerID, groupID, uids); err != nil {
+		if err := PromoteGroupUsers(n.ctx, n.logger, n.db, n.groupIndex, n.router, callerID, groupID, uids); err != nil {
 			panic(r.NewGoError(fmt.Errorf("error while trying to promote users in a group: %v", err.Error())))
 		}
 
@@ -8346,6 +9151,70 @@ func (n *RuntimeJavascriptNakamaModule) groupsList(r *goja.Runtime) func(goja.Fu
 	}
 }
 
+// @group groups
+// @summary Search groups with a query over group fields and metadata, using the same query syntax as storage indices.
+// @param query(type=string) Query to match groups against. Group fields are name, lang_tag, open, edge_count, max_count, create_time and update_time, metadata fields are prefixed with "metadata.".
+// @param limit(type=number, optional=true, default=100) Return only the required number of groups denoted by this limit value.
+// @param order(type=string[], optional=true) The fields to sort by, prefix a field with "-" to sort in descending order.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return groups(nkruntime.GroupList) A list of groups matching the query.
+// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any). Will be set to "" or null when fetching last available page.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) groupsSearch(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		query := getJsString(r, f.Argument(0))
+
+		limit := 100
+		if !goja.IsUndefined(f.Argument(1)) && !goja.IsNull(f.Argument(1)) {
+			limit = int(getJsInt(r, f.Argument(1)))
+			if limit < 1 || limit > 100 {
+				panic(r.NewTypeError("limit must be 1-100"))
+			}
+		}
+
+		var err error
+		order := make([]string, 0)
+		orderIn := f.Argument(2)
+		if !goja.IsUndefined(orderIn) && !goja.IsNull(orderIn) {
+			order, err = exportToSlice[[]string](orderIn)
+			if err != nil {
+				panic(r.NewTypeError("expects an array of strings"))
+			}
+		}
+
+		var cursor string
+		if !goja.IsUndefined(f.Argument(3)) && !goja.IsNull(f.Argument(3)) {
+			cursor = getJsString(r, f.Argument(3))
+		}
+
+		groups, err := n.groupIndex.List(n.ctx, query, limit, order, cursor)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error searching groups: %s", err.Error())))
+		}
+
+		groupsSlice := make([]interface{}, 0, len(groups.Groups))
+		for _, g := range groups.Groups {
+			groupData, err := groupToJsObject(g)
+			if err != nil {
+				panic(r.NewGoError(err))
+			}
+
+			groupsSlice = append(groupsSlice, groupData)
+		}
+
+		result := make(map[string]interface{}, 2)
+		result["groups"] = groupsSlice
+
+		if groups.Cursor == "" {
+			result["cursor"] = nil
+		} else {
+			result["cursor"] = groups.Cursor
+		}
+
+		return r.ToValue(result)
+	}
+}
+
 // @group groups
 // @summary Fetch one or more groups randomly.
 // @param count(type=number) The number of groups to fetch.
@@ -8537,7 +9406,7 @@ func (n *RuntimeJavascriptNakamaModule) channelMessageSend(r *goja.Runtime) func
 			panic(r.NewTypeError(err.Error()))
 		}
 
-		ack, err := ChannelMessageSend(n.ctx, n.logger, n.db, n.router, channelIdToStreamResult.Stream, channelId, contentStr, senderId.String(), senderUsername, persist)
+		ack, err := ChannelMessageSend(n.ctx, n.logger, n.db, n.router, nil, channelIdToStreamResult.Stream, channelId, contentStr, senderId.String(), senderUsername, persist)
 		if err != nil {
 			panic(r.NewGoError(fmt.Errorf("failed to send channel message: %s", err.Error())))
 		}
@@ -8615,7 +9484,7 @@ func (n *RuntimeJavascriptNakamaModule) channelMessageUpdate(r *goja.Runtime) fu
 			panic(r.NewTypeError(err.Error()))
 		}
 
-		ack, err := ChannelMessageUpdate(n.ctx, n.logger, n.db, n.router, channelIdToStreamResult.Stream, channelId, messageId, contentStr, senderId.String(), senderUsername, persist)
+		ack, err := ChannelMessageUpdate(n.ctx, n.logger, n.db, n.router, nil, channelIdToStreamResult.Stream, channelId, messageId, contentStr, senderId.String(), senderUsername, persist)
 		if err != nil {
 			panic(r.NewGoError(fmt.Errorf("failed to update channel message: %s", err.Error())))
 		}
@@ -8802,6 +9671,90 @@ func (n *RuntimeJavascriptNakamaModule) channelIdBuild(r *goja.Runtime) func(goj
 	}
 }
 
+// @group chat
+// @summary Override the configured message retention policy for a channel. Zero values keep messages indefinitely.
+// @param channelId(type=string) The ID of the channel to set the retention policy for.
+// @param maxAgeSec(type=number) Remove persisted messages older than this many seconds.
+// @param maxMessages(type=number) Keep at most this many of the most recent persisted messages.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) channelRetentionSet(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		channelId := getJsString(r, f.Argument(0))
+
+		maxAgeSec := getJsInt(r, f.Argument(1))
+		if maxAgeSec < 0 {
+			panic(r.NewTypeError("expects max age to be 0 or greater"))
+		}
+
+		maxMessages := getJsInt(r, f.Argument(2))
+		if maxMessages < 0 {
+			panic(r.NewTypeError("expects max messages to be 0 or greater"))
+		}
+
+		channelIdToStreamResult, err := ChannelIdToStream(channelId)
+		if err != nil {
+			panic(r.NewTypeError(err.Error()))
+		}
+
+		if err := ChannelRetentionSet(n.ctx, n.logger, n.db, channelIdToStreamResult.Stream, &ChannelRetentionPolicy{MaxAgeSec: int(maxAgeSec), MaxMessages: int(maxMessages)}); err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to set channel retention policy: %s", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
+// @group chat
+// @summary Get the message retention policy override for a channel.
+// @param channelId(type=string) The ID of the channel to get the retention policy for.
+// @return policy(nkruntime.ChannelRetentionPolicy) The retention policy override, or null if the channel uses the configured policy.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) channelRetentionGet(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		channelId := getJsString(r, f.Argument(0))
+
+		channelIdToStreamResult, err := ChannelIdToStream(channelId)
+		if err != nil {
+			panic(r.NewTypeError(err.Error()))
+		}
+
+		policy, err := ChannelRetentionGet(n.ctx, n.logger, n.db, channelIdToStreamResult.Stream)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to get channel retention policy: %s", err.Error())))
+		}
+		if policy == nil {
+			return goja.Null()
+		}
+
+		return r.ToValue(map[string]any{
+			"channelId":   channelId,
+			"maxAgeSec":   policy.MaxAgeSec,
+			"maxMessages": policy.MaxMessages,
+		})
+	}
+}
+
+// @group chat
+// @summary Remove the message retention policy override for a channel, so the configured policy applies again.
+// @param channelId(type=string) The ID of the channel to remove the retention policy override for.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeJavascriptNakamaModule) channelRetentionDelete(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		channelId := getJsString(r, f.Argument(0))
+
+		channelIdToStreamResult, err := ChannelIdToStream(channelId)
+		if err != nil {
+			panic(r.NewTypeError(err.Error()))
+		}
+
+		if err := ChannelRetentionDelete(n.ctx, n.logger, n.db, channelIdToStreamResult.Stream); err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to delete channel retention policy: %s", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
 func (n *RuntimeJavascriptNakamaModule) satoriConstructor(r *goja.Runtime) (*goja.Object, error) {
 	mappings := map[string]func(goja.FunctionCall) goja.Value{
 		"authenticate":     n.satoriAuthenticate(r),
@@ -47,6 +47,7 @@ import (
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"github.com/heroiclabs/nakama/v3/internal/cronexpr"
 	lua "github.com/heroiclabs/nakama/v3/internal/gopher-lua"
 	"github.com/heroiclabs/nakama/v3/internal/satori"
@@ -78,12 +79,14 @@ type RuntimeLuaNakamaModule struct {
 	tracker              Tracker
 	metrics              Metrics
 	storageIndex         StorageIndex
+	groupIndex           GroupIndex
 	streamManager        StreamManager
 	router               MessageRouter
 	once                 *sync.Once
 	localCache           *RuntimeLuaLocalCache
 	registerCallbackFn   func(RuntimeExecutionMode, string, *lua.LFunction)
 	announceCallbackFn   func(RuntimeExecutionMode, string)
+	rpcRateLimits        *MapOf[string, *RuntimeRpcRateLimit]
 	httpClient           *http.Client
 	httpClientInsecure   *http.Client
 
@@ -94,7 +97,7 @@ type RuntimeLuaNakamaModule struct {
 	satori runtime.Satori
 }
 
-func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, once *sync.Once, localCache *RuntimeLuaLocalCache, storageIndex StorageIndex, matchCreateFn RuntimeMatchCreateFunction, eventFn RuntimeEventCustomFunction, registerCallbackFn func(RuntimeExecutionMode, string, *lua.LFunction), announceCallbackFn func(RuntimeExecutionMode, string)) *RuntimeLuaNakamaModule {
+func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, once *sync.Once, localCache *RuntimeLuaLocalCache, storageIndex StorageIndex, groupIndex GroupIndex, matchCreateFn RuntimeMatchCreateFunction, eventFn RuntimeEventCustomFunction, registerCallbackFn func(RuntimeExecutionMode, string, *lua.LFunction), announceCallbackFn func(RuntimeExecutionMode, string), rpcRateLimits *MapOf[string, *RuntimeRpcRateLimit]) *RuntimeLuaNakamaModule {
 	return &RuntimeLuaNakamaModule{
 		logger:               logger,
 		db:                   db,
@@ -117,8 +120,10 @@ func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshale
 		once:                 once,
 		localCache:           localCache,
 		storageIndex:         storageIndex,
+		groupIndex:           groupIndex,
 		registerCallbackFn:   registerCallbackFn,
 		announceCallbackFn:   announceCallbackFn,
+		rpcRateLimits:        rpcRateLimits,
 		httpClient:           &http.Client{},
 		httpClientInsecure:   &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}},
 
@@ -207,6 +212,7 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"accounts_get_id":                    n.accountsGetId,
 		"account_update_id":                  n.accountUpdateId,
 		"account_delete_id":                  n.accountDeleteId,
+		"account_merge":                      n.accountMerge,
 		"account_export_id":                  n.accountExportId,
 		"users_get_id":                       n.usersGetId,
 		"users_get_username":                 n.usersGetUsername,
@@ -249,6 +255,8 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"match_list":                         n.matchList,
 		"match_signal":                       n.matchSignal,
 		"notification_send":                  n.notificationSend,
+		"notification_schedule":              n.notificationSchedule,
+		"notification_schedule_cancel":       n.notificationScheduleCancel,
 		"notifications_send":                 n.notificationsSend,
 		"notification_send_all":              n.notificationSendAll,
 		"notifications_list":                 n.notificationsList,
@@ -310,11 +318,29 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"group_users_demote":                        n.groupUsersDemote,
 		"group_users_list":                          n.groupUsersList,
 		"group_users_kick":                          n.groupUsersKick,
+		"group_role_set":                            n.groupRoleSet,
+		"group_role_delete":                         n.groupRoleDelete,
+		"group_roles_list":                          n.groupRolesList,
+		"group_user_role_assign":                    n.groupUserRoleAssign,
+		"group_user_role_unassign":                  n.groupUserRoleUnassign,
+		"group_user_roles_list":                     n.groupUserRolesList,
+		"group_user_permission_check":               n.groupUserPermissionCheck,
+		"group_invites_send":                        n.groupInvitesSend,
+		"group_invites_revoke":                      n.groupInvitesRevoke,
+		"group_invite_accept":                       n.groupInviteAccept,
+		"group_invite_decline":                      n.groupInviteDecline,
+		"group_invites_list":                        n.groupInvitesList,
+		"group_user_invites_list":                   n.groupUserInvitesList,
+		"group_events_list":                         n.groupEventsList,
 		"groups_list":                               n.groupsList,
+		"groups_search":                             n.groupsSearch,
 		"groups_get_random":                         n.groupsGetRandom,
 		"user_groups_list":                          n.userGroupsList,
 		"friends_list":                              n.friendsList,
 		"friends_of_friends_list":                   n.friendsOfFriendsList,
+		"friend_recommendations_list":               n.friendRecommendationsList,
+		"friend_recommendation_signal_set":          n.friendRecommendationSignalSet,
+		"friend_recommendation_signal_delete":       n.friendRecommendationSignalDelete,
 		"friends_add":                               n.friendsAdd,
 		"friends_delete":                            n.friendsDelete,
 		"friends_block":                             n.friendsBlock,
@@ -324,6 +350,9 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"channel_message_remove":                    n.channelMessageRemove,
 		"channel_messages_list":                     n.channelMessagesList,
 		"channel_id_build":                          n.channelIdBuild,
+		"channel_retention_set":                     n.channelRetentionSet,
+		"channel_retention_get":                     n.channelRetentionGet,
+		"channel_retention_delete":                  n.channelRetentionDelete,
 		"storage_index_list":                        n.storageIndexList,
 		"get_config":                                n.getConfig,
 		"get_satori":                                n.getSatori,
@@ -339,6 +368,7 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 // @summary Registers a function for use with client RPC to the server.
 // @param fn(type=function) A function reference which will be executed on each RPC message.
 // @param id(type=string) The unique identifier used to register the function for RPC.
+// @param rateLimit(type=table, optional=true) Overrides the configured API rate limit for this RPC, with 'rate' requests per second and a 'burst' size.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeLuaNakamaModule) registerRPC(l *lua.LState) int {
 	fn := l.CheckFunction(1)
@@ -351,6 +381,22 @@ func (n *RuntimeLuaNakamaModule) registerRPC(l *lua.LState) int {
 
 	id = strings.ToLower(id)
 
+	if rateLimitTable := l.OptTable(3, nil); rateLimitTable != nil {
+		rate, ok := rateLimitTable.RawGetString("rate").(lua.LNumber)
+		if !ok || rate < 0 {
+			l.ArgError(3, "expects rate limit rate to be a number 0 or greater")
+			return 0
+		}
+		burst, ok := rateLimitTable.RawGetString("burst").(lua.LNumber)
+		if !ok || burst < 1 {
+			l.ArgError(3, "expects rate limit burst to be a number 1 or greater")
+			return 0
+		}
+		if n.rpcRateLimits != nil {
+			n.rpcRateLimits.Store(id, &RuntimeRpcRateLimit{Rate: float64(rate), Burst: int(burst)})
+		}
+	}
+
 	if n.registerCallbackFn != nil {
 		n.registerCallbackFn(RuntimeExecutionModeRPC, id, fn)
 	}
@@ -2273,8 +2319,8 @@ func (n *RuntimeLuaNakamaModule) authenticateTokenGenerate(l *lua.LState) int {
 
 	tokenId := uuid.Must(uuid.NewV4()).String()
 	tokenIssuedAt := time.Now().Unix()
-	token, exp := generateTokenWithExpiry(n.config.GetSession().EncryptionKey, tokenId, tokenIssuedAt, userIDString, username, varsMap, exp)
-	n.sessionCache.Add(uid, exp, tokenId, 0, "")
+	token, exp := generateTokenWithExpiry(n.config.GetSession(), tokenId, tokenIssuedAt, userIDString, username, varsMap, exp)
+	n.sessionCache.Add(uid, exp, tokenId, 0, "", &SessionDevice{AuthMethod: "runtime"})
 
 	l.Push(lua.LString(token))
 	l.Push(lua.LNumber(exp))
@@ -3078,7 +3124,7 @@ func (n *RuntimeLuaNakamaModule) usersBanId(l *lua.LState) int {
 }
 
 // @group users
-// @summary Unban one or more users by ID.
+// @summary Unban one or more users by ID. Fails if any of the users is scheduled for deletion, those accounts must be restored instead.
 // @param userIds(type=table) A table of user IDs to unban.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeLuaNakamaModule) usersUnbanId(l *lua.LState) int {
@@ -5008,6 +5054,7 @@ func (n *RuntimeLuaNakamaModule) matchList(l *lua.LState) int {
 // @param code(type=number) Notification code to use. Must be equal or greater than 0.
 // @param sender(type=string, optional=true) The sender of this notification. If left empty, it will be assumed that it is a system notification.
 // @param persistent(type=bool, optional=true, default=false) Whether to record this in the database for later listing.
+// @param expirySec(type=number, optional=true, default=0) Number of seconds after which a persistent notification expires and is no longer listed. 0 means it never expires.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeLuaNakamaModule) notificationSend(l *lua.LState) int {
 	u := l.CheckString(1)
@@ -5050,6 +5097,16 @@ func (n *RuntimeLuaNakamaModule) notificationSend(l *lua.LState) int {
 
 	persistent := l.OptBool(6, false)
 
+	expirySec := l.OptInt(7, 0)
+	if expirySec < 0 {
+		l.ArgError(7, "expects expiry_sec to be 0 or a positive integer")
+		return 0
+	}
+	var expiryTime time.Time
+	if expirySec > 0 {
+		expiryTime = time.Now().Add(time.Duration(expirySec) * time.Second)
+	}
+
 	nots := []*api.Notification{{
 		Id:         uuid.Must(uuid.NewV4()).String(),
 		Subject:    subject,
@@ -5063,13 +5120,111 @@ func (n *RuntimeLuaNakamaModule) notificationSend(l *lua.LState) int {
 		userID: nots,
 	}
 
-	if err := NotificationSend(l.Context(), n.logger, n.db, n.tracker, n.router, notifications); err != nil {
+	if err := NotificationSendWithExpiry(l.Context(), n.logger, n.db, n.tracker, n.router, notifications, expiryTime); err != nil {
 		l.RaiseError("failed to send notifications: %s", err.Error())
 	}
 
 	return 0
 }
 
+// @group notifications
+// @summary Schedule one in-app notification to be sent to a user at a future time.
+// @param userId(type=string) The user ID of the user to be sent the notification.
+// @param subject(type=string) Notification subject.
+// @param content(type=table) Notification content. Must be set but can be an empty table.
+// @param code(type=number) Notification code to use. Must be equal or greater than 0.
+// @param sendTime(type=number) The time to send the notification at, in UTC seconds since epoch.
+// @param sender(type=string, optional=true) The sender of this notification. If left empty, it will be assumed that it is a system notification.
+// @param persistent(type=bool, optional=true, default=false) Whether to record this in the database for later listing.
+// @param expirySec(type=number, optional=true, default=0) Number of seconds after sending that a persistent notification expires and is no longer listed. 0 means it never expires.
+// @return id(string) The ID of the scheduled notification, which is also used as the ID of the notification once sent.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) notificationSchedule(l *lua.LState) int {
+	u := l.CheckString(1)
+	userID, err := uuid.FromString(u)
+	if err != nil {
+		l.ArgError(1, "expects user_id to be a valid UUID")
+		return 0
+	}
+
+	subject := l.CheckString(2)
+	if subject == "" {
+		l.ArgError(2, "expects subject to be a non-empty string")
+		return 0
+	}
+
+	contentMap := RuntimeLuaConvertLuaTable(l.CheckTable(3))
+	contentBytes, err := json.Marshal(contentMap)
+	if err != nil {
+		l.ArgError(3, fmt.Sprintf("failed to convert content: %s", err.Error()))
+		return 0
+	}
+
+	code := l.CheckInt(4)
+	if code <= 0 {
+		l.ArgError(4, "expects code number to be a positive integer")
+		return 0
+	}
+
+	sendTime := l.CheckInt64(5)
+	if sendTime <= 0 {
+		l.ArgError(5, "expects send_time to be a positive UTC seconds timestamp")
+		return 0
+	}
+
+	s := l.OptString(6, "")
+	senderID := uuid.Nil.String()
+	if s != "" {
+		suid, err := uuid.FromString(s)
+		if err != nil {
+			l.ArgError(6, "expects sender_id to either be not set, empty string or a valid UUID")
+			return 0
+		}
+		senderID = suid.String()
+	}
+
+	persistent := l.OptBool(7, false)
+
+	expirySec := l.OptInt(8, 0)
+	if expirySec < 0 {
+		l.ArgError(8, "expects expiry_sec to be 0 or a positive integer")
+		return 0
+	}
+
+	id, err := NotificationSchedule(l.Context(), n.logger, n.db, userID, &api.Notification{
+		Subject:    subject,
+		Content:    string(contentBytes),
+		Code:       int32(code),
+		SenderId:   senderID,
+		Persistent: persistent,
+	}, expirySec, time.Unix(sendTime, 0))
+	if err != nil {
+		l.RaiseError("failed to schedule notification: %s", err.Error())
+		return 0
+	}
+
+	l.Push(lua.LString(id))
+	return 1
+}
+
+// @group notifications
+// @summary Cancel a scheduled notification that has not been sent yet.
+// @param id(type=string) The ID of the scheduled notification.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) notificationScheduleCancel(l *lua.LState) int {
+	id, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects id to be a valid UUID")
+		return 0
+	}
+
+	if err := NotificationScheduleCancel(l.Context(), n.logger, n.db, id); err != nil {
+		l.RaiseError("failed to cancel scheduled notification: %s", err.Error())
+	}
+
+	return 0
+}
+
 // @group notifications
 // @summary Send one or more in-app notifications to a user.
 // @param notifications(type=table) A list of notifications to be sent together.
@@ -5301,7 +5456,7 @@ func (n *RuntimeLuaNakamaModule) notificationsList(l *lua.LState) int {
 
 	cursor := l.OptString(3, "")
 
-	list, err := NotificationList(l.Context(), n.logger, n.db, userID, limit, cursor, false)
+	list, err := NotificationList(l.Context(), n.logger, n.db, userID, limit, cursor, false, nil)
 	if err != nil {
 		l.RaiseError("failed to list notifications: %s", err.Error())
 		return 0
@@ -5427,7 +5582,7 @@ func (n *RuntimeLuaNakamaModule) notificationsDelete(l *lua.LState) int {
 	}
 
 	for uid, notificationIDs := range notifications {
-		if err := NotificationDelete(l.Context(), n.logger, n.db, uid, notificationIDs); err != nil {
+		if err := NotificationDelete(l.Context(), n.logger, n.db, n.router, uid, notificationIDs); err != nil {
 			l.RaiseError("failed to delete notifications: %s", err.Error())
 		}
 	}
@@ -5625,7 +5780,7 @@ func (n *RuntimeLuaNakamaModule) notificationsDeleteId(l *lua.LState) int {
 
 	userId := l.OptString(2, "")
 
-	if err := NotificationsDeleteId(l.Context(), n.logger, n.db, userId, notifIds...); err != nil {
+	if err := NotificationsDeleteId(l.Context(), n.logger, n.db, n.router, userId, notifIds...); err != nil {
 		l.RaiseError("failed to delete notifications: %s", err.Error())
 	}
 
@@ -8957,7 +9112,7 @@ func (n *RuntimeLuaNakamaModule) groupCreate(l *lua.LState) int {
 		return 0
 	}
 
-	group, err := CreateGroup(l.Context(), n.logger, n.db, userID, creatorID, name, lang, desc, avatarURL, metadataStr, open, maxCount)
+	group, err := CreateGroup(l.Context(), n.logger, n.db, n.groupIndex, userID, creatorID, name, lang, desc, avatarURL, metadataStr, open, maxCount)
 	if err != nil {
 		l.RaiseError("error while trying to create group: %v", err.Error())
 		return 0
@@ -9075,7 +9230,7 @@ func (n *RuntimeLuaNakamaModule) groupUpdate(l *lua.LState) int {
 
 	maxCount := l.OptInt(10, 0)
 
-	if err = UpdateGroup(l.Context(), n.logger, n.db, groupID, userID, creatorID, name, lang, desc, avatarURL, metadata, open, maxCount); err != nil {
+	if err = UpdateGroup(l.Context(), n.logger, n.db, n.groupIndex, groupID, userID, creatorID, name, lang, desc, avatarURL, metadata, open, maxCount); err != nil {
 		l.RaiseError("error while trying to update group: %v", err.Error())
 		return 0
 	}
@@ -9094,7 +9249,7 @@ func (n *RuntimeLuaNakamaModule) groupDelete(l *lua.LState) int {
 		return 0
 	}
 
-	if err = DeleteGroup(l.Context(), n.logger, n.db, n.tracker, groupID, uuid.Nil); err != nil {
+	if err = DeleteGroup(l.Context(), n.logger, n.db, n.groupIndex, n.tracker, groupID, uuid.Nil); err != nil {
 		l.RaiseError("error while trying to delete group: %v", err.Error())
 		return 0
 	}
@@ -9127,7 +9282,7 @@ func (n *RuntimeLuaNakamaModule) groupUserJoin(l *lua.LState) int {
 		return 0
 	}
 
-	if err := JoinGroup(l.Context(), n.logger, n.db, n.tracker, n.router, groupID, userID, username); err != nil {
+	if err := JoinGroup(l.Context(), n.logger, n.db, n.groupIndex, n.tracker, n.router, groupID, userID, username); err != nil {
 		l.RaiseError("error while trying to join a group: %v", err.Error())
 		return 0
 	}
@@ -9159,7 +9314,7 @@ func (n *RuntimeLuaNakamaModule) groupUserLeave(l *lua.LState) int {
 		return 0
 	}
 
-	if err := LeaveGroup(l.Context(), n.logger, n.db, n.tracker, n.router, n.streamManager, groupID, userID, username); err != nil {
+	if err := LeaveGroup(l.Context(), n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, groupID, userID, username); err != nil {
 		l.RaiseError("error while trying to leave a group: %v", err.Error())
 	}
 	return 0
@@ -9222,7 +9377,7 @@ func (n *RuntimeLuaNakamaModule) groupUsersAdd(l *lua.LState) int {
 		}
 	}
 
-	if err := AddGroupUsers(l.Context(), n.logger, n.db, n.tracker, n.router, callerID, groupID, userIDs); err != nil {
+	if err := AddGroupUsers(l.Context(), n.logger, n.db, n.groupIndex, n.tracker, n.router, callerID, groupID, userIDs); err != nil {
 		l.RaiseError("error while trying to add users into a group: %v", err.Error())
 	}
 	return 0
@@ -9285,7 +9440,7 @@ func (n *RuntimeLuaNakamaModule) groupUsersBan(l *lua.LState) int {
 		}
 	}
 
-	if err := BanGroupUsers(l.Context(), n.logger, n.db, n.tracker, n.router, n.streamManager, callerID, groupID, userIDs); err != nil {
+	if err := BanGroupUsers(l.Context(), n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, callerID, groupID, userIDs); err != nil {
 		l.RaiseError("error while trying to add users into a group: %v", err.Error())
 	}
 	return 0
@@ -9348,7 +9503,7 @@ func (n *RuntimeLuaNakamaModule) groupUsersPromote(l *lua.LState) int {
 		}
 	}
 
-	if err := PromoteGroupUsers(l.Context(), n.logger, n.db, n.router, callerID, groupID, userIDs); err != nil {
+	if err := PromoteGroupUsers(l.Context(), n.logger, n.db, n.groupIndex, n.router, callerID, groupID, userIDs); err != nil {
 		l.RaiseError("error while trying to promote users in a group: %v", err.Error())
 	}
 	return 0
@@ -9474,151 +9629,722 @@ func (n *RuntimeLuaNakamaModule) groupUsersKick(l *lua.LState) int {
 		}
 	}
 
-	if err := KickGroupUsers(l.Context(), n.logger, n.db, n.tracker, n.router, n.streamManager, callerID, groupID, userIDs, false); err != nil {
+	if err := KickGroupUsers(l.Context(), n.logger, n.db, n.groupIndex, n.tracker, n.router, n.streamManager, callerID, groupID, userIDs, false); err != nil {
 		l.RaiseError("error while trying to kick users from a group: %v", err.Error())
 	}
 	return 0
 }
 
 // @group groups
-// @summary Find groups based on the entered criteria.
-// @param name(type=string) Search for groups that contain this value in their name.
-// @param langTag(type=string, optional=true) Filter based upon the entered language tag.
-// @param members(type=number) Search by number of group members.
-// @param open(type=bool) Filter based on whether groups are Open or Closed.
-// @param limit(type=number, optional=true, default=100) Return only the required number of groups denoted by this limit value.
-// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
-// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any). Will be set to "" or nil when fetching last available page.
+// @summary Create a custom role in a group, or replace the permissions of an existing role with the same name.
+// @param groupId(type=string) The ID of the group to create the role in.
+// @param name(type=string) The name of the role, between 1 and 64 characters.
+// @param permissions(type=table) Table of permission names granted by the role: "kick", "ban", "invite", "promote", "edit_metadata", "manage_chat" or "spend_wallet".
 // @return error(error) An optional error value if an error occurred.
-func (n *RuntimeLuaNakamaModule) groupsList(l *lua.LState) int {
-	name := l.OptString(1, "")
+func (n *RuntimeLuaNakamaModule) groupRoleSet(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
 
-	langTag := l.OptString(2, "")
+	name := l.CheckString(2)
 
-	var open *bool
-	if v := l.Get(3); v.Type() != lua.LTNil {
-		if v.Type() != lua.LTBool {
-			l.ArgError(2, "expects open true/false or nil")
-			return 0
+	permissionsTable := l.CheckTable(3)
+	if permissionsTable == nil {
+		l.ArgError(3, "expects permissions to be a table")
+		return 0
+	}
+	names := make([]string, 0, permissionsTable.Len())
+	conversionError := false
+	permissionsTable.ForEach(func(k lua.LValue, v lua.LValue) {
+		if v.Type() != lua.LTString {
+			l.ArgError(3, "expects each permission to be a string")
+			conversionError = true
+			return
 		}
-		open = new(bool)
-		*open = lua.LVAsBool(v)
+		names = append(names, v.String())
+	})
+	if conversionError {
+		return 0
+	}
+	permissions, err := GroupPermissionsParse(names)
+	if err != nil {
+		l.ArgError(3, err.Error())
+		return 0
 	}
 
-	edgeCount := l.OptInt(4, -1)
+	if err := GroupRoleSet(l.Context(), n.logger, n.db, groupID, name, permissions); err != nil {
+		l.RaiseError("error while trying to set group role: %v", err.Error())
+	}
+	return 0
+}
 
-	limit := l.OptInt(5, 100)
+// @group groups
+// @summary Delete a custom role from a group, unassigning it from any users that held it.
+// @param groupId(type=string) The ID of the group to delete the role from.
+// @param name(type=string) The name of the role to delete.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupRoleDelete(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
 
-	cursor := l.OptString(6, "")
+	name := l.CheckString(2)
 
-	groups, err := ListGroups(l.Context(), n.logger, n.db, name, langTag, open, edgeCount, limit, cursor)
+	if err := GroupRoleDelete(l.Context(), n.logger, n.db, groupID, name); err != nil {
+		l.RaiseError("error while trying to delete group role: %v", err.Error())
+	}
+	return 0
+}
+
+// @group groups
+// @summary List the custom roles defined in a group.
+// @param groupId(type=string) The ID of the group to list roles for.
+// @return roles(table) A list of roles with their names and permissions.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupRolesList(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
 	if err != nil {
-		l.RaiseError("error listing groups: %v", err.Error())
+		l.ArgError(1, "expects group ID to be a valid identifier")
 		return 0
 	}
 
-	groupUsers := l.CreateTable(len(groups.Groups), 0)
-	for i, group := range groups.Groups {
-		gt, err := groupToLuaTable(l, group)
-		if err != nil {
-			l.RaiseError("error converting groups: %s", err.Error())
-			return 0
+	roles, err := GroupRolesList(l.Context(), n.logger, n.db, groupID)
+	if err != nil {
+		l.RaiseError("error while trying to list group roles: %v", err.Error())
+		return 0
+	}
+
+	rolesTable := l.CreateTable(len(roles), 0)
+	for i, role := range roles {
+		names := role.Permissions.Names()
+		permissionsTable := l.CreateTable(len(names), 0)
+		for j, name := range names {
+			permissionsTable.RawSetInt(j+1, lua.LString(name))
 		}
 
-		groupUsers.RawSetInt(i+1, gt)
+		roleTable := l.CreateTable(0, 4)
+		roleTable.RawSetString("name", lua.LString(role.Name))
+		roleTable.RawSetString("permissions", permissionsTable)
+		roleTable.RawSetString("createTime", lua.LNumber(role.CreateTime.Unix()))
+		roleTable.RawSetString("updateTime", lua.LNumber(role.UpdateTime.Unix()))
+		rolesTable.RawSetInt(i+1, roleTable)
 	}
 
-	l.Push(groupUsers)
-	if groups.Cursor == "" {
-		l.Push(lua.LNil)
-	} else {
-		l.Push(lua.LString(groups.Cursor))
+	l.Push(rolesTable)
+	return 1
+}
+
+// @group groups
+// @summary Assign a custom role to a group member, replacing any role they already held.
+// @param groupId(type=string) The ID of the group.
+// @param userId(type=string) The ID of the member to assign the role to.
+// @param name(type=string) The name of the role to assign.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupUserRoleAssign(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
 	}
-	return 2
+
+	userID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	name := l.CheckString(3)
+
+	if err := GroupUserRoleAssign(l.Context(), n.logger, n.db, groupID, userID, name); err != nil {
+		l.RaiseError("error while trying to assign group role: %v", err.Error())
+	}
+	return 0
 }
 
 // @group groups
-// @summary Fetch one or more groups randomly.
-// @param count(type=int) The number of groups to fetch.
-// @return users(table) A list of group record objects.
+// @summary Remove the custom role held by a group member, if any.
+// @param groupId(type=string) The ID of the group.
+// @param userId(type=string) The ID of the member to remove the role from.
 // @return error(error) An optional error value if an error occurred.
-func (n *RuntimeLuaNakamaModule) groupsGetRandom(l *lua.LState) int {
-	count := l.OptInt(1, 0)
+func (n *RuntimeLuaNakamaModule) groupUserRoleUnassign(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
 
-	if count < 0 || count > 1000 {
-		l.ArgError(1, "count must be 0-1000")
+	userID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects user ID to be a valid identifier")
 		return 0
 	}
 
-	groups, err := GetRandomGroups(l.Context(), n.logger, n.db, count)
+	if err := GroupUserRoleUnassign(l.Context(), n.logger, n.db, groupID, userID); err != nil {
+		l.RaiseError("error while trying to unassign group role: %v", err.Error())
+	}
+	return 0
+}
+
+// @group groups
+// @summary List the custom roles assigned to members of a group.
+// @param groupId(type=string) The ID of the group.
+// @return userRoles(table) A list of user IDs and the name of the role each holds.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupUserRolesList(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
 	if err != nil {
-		l.RaiseError("failed to get groups: %s", err.Error())
+		l.ArgError(1, "expects group ID to be a valid identifier")
 		return 0
 	}
 
-	// Convert and push the values.
-	groupsTable := l.CreateTable(len(groups), 0)
-	for i, group := range groups {
-		userTable, err := groupToLuaTable(l, group)
-		if err != nil {
-			l.RaiseError("error converting groups: %s", err.Error())
-			return 0
-		}
-		groupsTable.RawSetInt(i+1, userTable)
+	userRoles, err := GroupUserRolesList(l.Context(), n.logger, n.db, groupID)
+	if err != nil {
+		l.RaiseError("error while trying to list group user roles: %v", err.Error())
+		return 0
 	}
 
-	l.Push(groupsTable)
+	userRolesTable := l.CreateTable(len(userRoles), 0)
+	for i, userRole := range userRoles {
+		userRoleTable := l.CreateTable(0, 2)
+		userRoleTable.RawSetString("userId", lua.LString(userRole.UserID.String()))
+		userRoleTable.RawSetString("role", lua.LString(userRole.Role))
+		userRolesTable.RawSetInt(i+1, userRoleTable)
+	}
+
+	l.Push(userRolesTable)
 	return 1
 }
 
 // @group groups
-// @summary List all members, admins and superadmins which belong to a group. This also list incoming join requests.
-// @param groupId(type=string) The ID of the group to list members for.
-// @param limit(type=int, optional=true, default=100) The maximum number of entries in the listing.
-// @param state(type=int, optional=true, default=null) The state of the user within the group. If unspecified this returns users in all states.
-// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
-// @return groupUsers(table) The user information for members, admins and superadmins for the group. Also users who sent a join request.
+// @summary Check whether a user may perform an action in a group, either as an admin or through a custom role.
+// @param groupId(type=string) The ID of the group.
+// @param userId(type=string) The ID of the user to check.
+// @param permission(type=string) The permission to check: "kick", "ban", "invite", "promote", "edit_metadata", "manage_chat" or "spend_wallet".
+// @return allowed(bool) True if the user holds the permission.
 // @return error(error) An optional error value if an error occurred.
-func (n *RuntimeLuaNakamaModule) groupUsersList(l *lua.LState) int {
+func (n *RuntimeLuaNakamaModule) groupUserPermissionCheck(l *lua.LState) int {
 	groupID, err := uuid.FromString(l.CheckString(1))
 	if err != nil {
 		l.ArgError(1, "expects group ID to be a valid identifier")
 		return 0
 	}
 
-	limit := l.OptInt(2, 100)
-	if limit < 1 || limit > 10000 {
-		l.ArgError(2, "expects limit to be 1-10000")
+	userID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects user ID to be a valid identifier")
 		return 0
 	}
 
-	state := l.OptInt(3, -1)
-	var stateWrapper *wrapperspb.Int32Value
-	if state != -1 {
-		if state < 0 || state > 4 {
-			l.ArgError(3, "expects state to be 0-4")
-			return 0
-		}
-		stateWrapper = &wrapperspb.Int32Value{Value: int32(state)}
+	permission, err := GroupPermissionParse(l.CheckString(3))
+	if err != nil {
+		l.ArgError(3, err.Error())
+		return 0
 	}
 
-	cursor := l.OptString(4, "")
-
-	res, err := ListGroupUsers(l.Context(), n.logger, n.db, n.statusRegistry, groupID, limit, stateWrapper, cursor)
+	allowed, err := GroupUserPermissionCheck(l.Context(), n.logger, n.db, groupID, userID, permission)
 	if err != nil {
-		l.RaiseError("error while trying to list users in a group: %v", err.Error())
+		l.RaiseError("error while trying to check group permission: %v", err.Error())
 		return 0
 	}
 
-	groupUsers := l.CreateTable(len(res.GroupUsers), 0)
-	for i, ug := range res.GroupUsers {
-		u := ug.User
+	l.Push(lua.LBool(allowed))
+	return 1
+}
 
-		ut := l.CreateTable(0, 18)
-		ut.RawSetString("user_id", lua.LString(u.Id))
-		ut.RawSetString("username", lua.LString(u.Username))
-		ut.RawSetString("display_name", lua.LString(u.DisplayName))
-		ut.RawSetString("avatar_url", lua.LString(u.AvatarUrl))
+// @group groups
+// @summary Invite users to join a group. Invited users become members once they accept the invite.
+// @param groupId(type=string) The ID of the group to invite users to.
+// @param userIds(type=table) Table of user IDs to invite.
+// @param callerId(type=string, optional=true) User ID of the caller, will apply permissions checks of the user. If empty defaults to system user and permission checks are bypassed.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupInvitesSend(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
+
+	userIDs := n.groupInviteUserIDs(l, 2)
+	if userIDs == nil {
+		return 0
+	}
+
+	callerID := uuid.Nil
+	callerIDStr := l.OptString(3, "")
+	if callerIDStr != "" {
+		callerID, err = uuid.FromString(callerIDStr)
+		if err != nil {
+			l.ArgError(3, "expects caller ID to be empty or a valid identifier")
+			return 0
+		}
+	}
+
+	if err := GroupInvitesSend(l.Context(), n.logger, n.db, n.tracker, n.router, n.config.GetGroup(), callerID, groupID, userIDs); err != nil {
+		l.RaiseError("error while trying to invite users to a group: %v", err.Error())
+	}
+	return 0
+}
+
+// @group groups
+// @summary Revoke pending invites sent by a group.
+// @param groupId(type=string) The ID of the group the invites were sent from.
+// @param userIds(type=table) Table of user IDs whose invites to revoke.
+// @param callerId(type=string, optional=true) User ID of the caller, will apply permissions checks of the user. If empty defaults to system user and permission checks are bypassed.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupInvitesRevoke(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
+
+	userIDs := n.groupInviteUserIDs(l, 2)
+	if userIDs == nil {
+		return 0
+	}
+
+	callerID := uuid.Nil
+	callerIDStr := l.OptString(3, "")
+	if callerIDStr != "" {
+		callerID, err = uuid.FromString(callerIDStr)
+		if err != nil {
+			l.ArgError(3, "expects caller ID to be empty or a valid identifier")
+			return 0
+		}
+	}
+
+	if err := GroupInvitesRevoke(l.Context(), n.logger, n.db, callerID, groupID, userIDs); err != nil {
+		l.RaiseError("error while trying to revoke group invites: %v", err.Error())
+	}
+	return 0
+}
+
+func (n *RuntimeLuaNakamaModule) groupInviteUserIDs(l *lua.LState, index int) []uuid.UUID {
+	users := l.CheckTable(index)
+	if users == nil {
+		l.ArgError(index, "expects user IDs to be a table")
+		return nil
+	}
+
+	userIDs := make([]uuid.UUID, 0, users.Len())
+	conversionError := false
+	users.ForEach(func(k lua.LValue, v lua.LValue) {
+		if v.Type() != lua.LTString {
+			l.ArgError(index, "expects each user ID to be a string")
+			conversionError = true
+			return
+		}
+		userID, err := uuid.FromString(v.String())
+		if err != nil || userID == uuid.Nil {
+			l.ArgError(index, "expects each user ID to be a valid identifier")
+			conversionError = true
+			return
+		}
+		userIDs = append(userIDs, userID)
+	})
+	if conversionError {
+		return nil
+	}
+	return userIDs
+}
+
+// @group groups
+// @summary Accept an invite to join a group on behalf of the invited user.
+// @param groupId(type=string) The ID of the group the invite is for.
+// @param userId(type=string) The ID of the invited user.
+// @param username(type=string) The username of the invited user.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupInviteAccept(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
+
+	userID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	username := l.CheckString(3)
+	if username == "" {
+		l.ArgError(3, "expects username string")
+		return 0
+	}
+
+	if err := GroupInviteAccept(l.Context(), n.logger, n.db, n.groupIndex, n.router, groupID, userID, username); err != nil {
+		l.RaiseError("error while trying to accept group invite: %v", err.Error())
+	}
+	return 0
+}
+
+// @group groups
+// @summary Decline an invite to join a group on behalf of the invited user.
+// @param groupId(type=string) The ID of the group the invite is for.
+// @param userId(type=string) The ID of the invited user.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupInviteDecline(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
+
+	userID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	if err := GroupInviteDecline(l.Context(), n.logger, n.db, groupID, userID); err != nil {
+		l.RaiseError("error while trying to decline group invite: %v", err.Error())
+	}
+	return 0
+}
+
+// @group groups
+// @summary List pending invites to join groups received by a user.
+// @param userId(type=string) The ID of the user to list invites for.
+// @param limit(type=number, optional=true, default=100) The maximum number of invites to list. Between 1 and 100.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return invites(table) A list of pending invites.
+// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any).
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupInvitesList(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(2, "expects limit to be 1-100")
+		return 0
+	}
+
+	list, err := GroupInvitesList(l.Context(), n.logger, n.db, userID, limit, l.OptString(3, ""))
+	if err != nil {
+		l.RaiseError("error while trying to list group invites: %v", err.Error())
+		return 0
+	}
+
+	return n.pushGroupInviteList(l, list)
+}
+
+// @group groups
+// @summary List pending invites sent by a group.
+// @param groupId(type=string) The ID of the group to list invites for.
+// @param limit(type=number, optional=true, default=100) The maximum number of invites to list. Between 1 and 100.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return invites(table) A list of pending invites.
+// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any).
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupUserInvitesList(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(2, "expects limit to be 1-100")
+		return 0
+	}
+
+	list, err := GroupUserInvitesList(l.Context(), n.logger, n.db, groupID, limit, l.OptString(3, ""))
+	if err != nil {
+		l.RaiseError("error while trying to list group invites: %v", err.Error())
+		return 0
+	}
+
+	return n.pushGroupInviteList(l, list)
+}
+
+func (n *RuntimeLuaNakamaModule) pushGroupInviteList(l *lua.LState, list *apigrpc.GroupInviteList) int {
+	invites := l.CreateTable(len(list.Invites), 0)
+	for i, invite := range list.Invites {
+		groupTable, err := groupToLuaTable(l, invite.Group)
+		if err != nil {
+			l.RaiseError("failed to convert group to table: %s", err.Error())
+			return 0
+		}
+
+		inviteTable := l.CreateTable(0, 5)
+		inviteTable.RawSetString("group", groupTable)
+		inviteTable.RawSetString("user_id", lua.LString(invite.UserId))
+		inviteTable.RawSetString("inviter_id", lua.LString(invite.InviterId))
+		inviteTable.RawSetString("create_time", lua.LNumber(invite.CreateTime.Seconds))
+		inviteTable.RawSetString("expire_time", lua.LNumber(invite.ExpireTime.Seconds))
+		invites.RawSetInt(i+1, inviteTable)
+	}
+
+	l.Push(invites)
+	if list.Cursor == "" {
+		l.Push(lua.LNil)
+	} else {
+		l.Push(lua.LString(list.Cursor))
+	}
+	return 2
+}
+
+// @group groups
+// @summary List the activity feed of a group, newest first. Events record joins, leaves, kicks, bans, promotions, demotions and group updates along with the user who performed them.
+// @param groupId(type=string) The ID of the group to list events for.
+// @param limit(type=number, optional=true, default=100) The maximum number of events to list. Between 1 and 100.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return events(table) A list of group events.
+// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any).
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupEventsList(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(2, "expects limit to be 1-100")
+		return 0
+	}
+
+	list, err := GroupEventsList(l.Context(), n.logger, n.db, groupID, limit, l.OptString(3, ""))
+	if err != nil {
+		l.RaiseError("error while trying to list group events: %v", err.Error())
+		return 0
+	}
+
+	events := l.CreateTable(len(list.Events), 0)
+	for i, event := range list.Events {
+		dataMap := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(event.Data), &dataMap); err != nil {
+			l.RaiseError("failed to convert group event data to json: %s", err.Error())
+			return 0
+		}
+
+		eventTable := l.CreateTable(0, 7)
+		eventTable.RawSetString("id", lua.LString(event.Id))
+		eventTable.RawSetString("group_id", lua.LString(event.GroupId))
+		eventTable.RawSetString("type", lua.LString(event.Type))
+		eventTable.RawSetString("actor_id", lua.LString(event.ActorId))
+		eventTable.RawSetString("target_id", lua.LString(event.TargetId))
+		eventTable.RawSetString("data", RuntimeLuaConvertMap(l, dataMap))
+		eventTable.RawSetString("create_time", lua.LNumber(event.CreateTime.Seconds))
+		events.RawSetInt(i+1, eventTable)
+	}
+
+	l.Push(events)
+	if list.Cursor == "" {
+		l.Push(lua.LNil)
+	} else {
+		l.Push(lua.LString(list.Cursor))
+	}
+	return 2
+}
+
+// @group groups
+// @summary Find groups based on the entered criteria.
+// @param name(type=string) Search for groups that contain this value in their name.
+// @param langTag(type=string, optional=true) Filter based upon the entered language tag.
+// @param members(type=number) Search by number of group members.
+// @param open(type=bool) Filter based on whether groups are Open or Closed.
+// @param limit(type=number, optional=true, default=100) Return only the required number of groups denoted by this limit value.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any). Will be set to "" or nil when fetching last available page.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupsList(l *lua.LState) int {
+	name := l.OptString(1, "")
+
+	langTag := l.OptString(2, "")
+
+	var open *bool
+	if v := l.Get(3); v.Type() != lua.LTNil {
+		if v.Type() != lua.LTBool {
+			l.ArgError(2, "expects open true/false or nil")
+			return 0
+		}
+		open = new(bool)
+		*open = lua.LVAsBool(v)
+	}
+
+	edgeCount := l.OptInt(4, -1)
+
+	limit := l.OptInt(5, 100)
+
+	cursor := l.OptString(6, "")
+
+	groups, err := ListGroups(l.Context(), n.logger, n.db, name, langTag, open, edgeCount, limit, cursor)
+	if err != nil {
+		l.RaiseError("error listing groups: %v", err.Error())
+		return 0
+	}
+
+	groupUsers := l.CreateTable(len(groups.Groups), 0)
+	for i, group := range groups.Groups {
+		gt, err := groupToLuaTable(l, group)
+		if err != nil {
+			l.RaiseError("error converting groups: %s", err.Error())
+			return 0
+		}
+
+		groupUsers.RawSetInt(i+1, gt)
+	}
+
+	l.Push(groupUsers)
+	if groups.Cursor == "" {
+		l.Push(lua.LNil)
+	} else {
+		l.Push(lua.LString(groups.Cursor))
+	}
+	return 2
+}
+
+// @group groups
+// @summary Search groups with a query over group fields and metadata, using the same query syntax as storage indices.
+// @param query(type=string) Query to match groups against. Group fields are name, lang_tag, open, edge_count, max_count, create_time and update_time, metadata fields are prefixed with "metadata.".
+// @param limit(type=number, optional=true, default=100) Return only the required number of groups denoted by this limit value.
+// @param order(type=table, optional=true) The fields to sort by, prefix a field with "-" to sort in descending order.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return groups(table) A list of groups matching the query.
+// @return cursor(string) An optional next page cursor that can be used to retrieve the next page of records (if any). Will be set to "" or nil when fetching last available page.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupsSearch(l *lua.LState) int {
+	query := l.CheckString(1)
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(2, "invalid limit: expects value 1-100")
+		return 0
+	}
+
+	order := make([]string, 0)
+	if orderTable := l.OptTable(3, nil); orderTable != nil {
+		orderTable.ForEach(func(k, v lua.LValue) {
+			if v.Type() != lua.LTString {
+				l.ArgError(3, "expects each field to be string")
+				return
+			}
+			order = append(order, v.String())
+		})
+	}
+
+	cursor := l.OptString(4, "")
+
+	groups, err := n.groupIndex.List(l.Context(), query, limit, order, cursor)
+	if err != nil {
+		l.RaiseError("error searching groups: %v", err.Error())
+		return 0
+	}
+
+	groupsTable := l.CreateTable(len(groups.Groups), 0)
+	for i, group := range groups.Groups {
+		gt, err := groupToLuaTable(l, group)
+		if err != nil {
+			l.RaiseError("error converting groups: %s", err.Error())
+			return 0
+		}
+
+		groupsTable.RawSetInt(i+1, gt)
+	}
+
+	l.Push(groupsTable)
+	if groups.Cursor == "" {
+		l.Push(lua.LNil)
+	} else {
+		l.Push(lua.LString(groups.Cursor))
+	}
+	return 2
+}
+
+// @group groups
+// @summary Fetch one or more groups randomly.
+// @param count(type=int) The number of groups to fetch.
+// @return users(table) A list of group record objects.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupsGetRandom(l *lua.LState) int {
+	count := l.OptInt(1, 0)
+
+	if count < 0 || count > 1000 {
+		l.ArgError(1, "count must be 0-1000")
+		return 0
+	}
+
+	groups, err := GetRandomGroups(l.Context(), n.logger, n.db, count)
+	if err != nil {
+		l.RaiseError("failed to get groups: %s", err.Error())
+		return 0
+	}
+
+	// Convert and push the values.
+	groupsTable := l.CreateTable(len(groups), 0)
+	for i, group := range groups {
+		userTable, err := groupToLuaTable(l, group)
+		if err != nil {
+			l.RaiseError("error converting groups: %s", err.Error())
+			return 0
+		}
+		groupsTable.RawSetInt(i+1, userTable)
+	}
+
+	l.Push(groupsTable)
+	return 1
+}
+
+// @group groups
+// @summary List all members, admins and superadmins which belong to a group. This also list incoming join requests.
+// @param groupId(type=string) The ID of the group to list members for.
+// @param limit(type=int, optional=true, default=100) The maximum number of entries in the listing.
+// @param state(type=int, optional=true, default=null) The state of the user within the group. If unspecified this returns users in all states.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from previous result. Don't set to start fetching from the beginning.
+// @return groupUsers(table) The user information for members, admins and superadmins for the group. Also users who sent a join request.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) groupUsersList(l *lua.LState) int {
+	groupID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects group ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 10000 {
+		l.ArgError(2, "expects limit to be 1-10000")
+		return 0
+	}
+
+	state := l.OptInt(3, -1)
+	var stateWrapper *wrapperspb.Int32Value
+	if state != -1 {
+		if state < 0 || state > 4 {
+			l.ArgError(3, "expects state to be 0-4")
+			return 0
+		}
+		stateWrapper = &wrapperspb.Int32Value{Value: int32(state)}
+	}
+
+	cursor := l.OptString(4, "")
+
+	res, err := ListGroupUsers(l.Context(), n.logger, n.db, n.statusRegistry, groupID, limit, stateWrapper, cursor)
+	if err != nil {
+		l.RaiseError("error while trying to list users in a group: %v", err.Error())
+		return 0
+	}
+
+	groupUsers := l.CreateTable(len(res.GroupUsers), 0)
+	for i, ug := range res.GroupUsers {
+		u := ug.User
+
+		ut := l.CreateTable(0, 18)
+		ut.RawSetString("user_id", lua.LString(u.Id))
+		ut.RawSetString("username", lua.LString(u.Username))
+		ut.RawSetString("display_name", lua.LString(u.DisplayName))
+		ut.RawSetString("avatar_url", lua.LString(u.AvatarUrl))
 		ut.RawSetString("lang_tag", lua.LString(u.LangTag))
 		ut.RawSetString("location", lua.LString(u.Location))
 		ut.RawSetString("timezone", lua.LString(u.Timezone))
@@ -9841,13 +10567,45 @@ func (n *RuntimeLuaNakamaModule) accountDeleteId(l *lua.LState) int {
 
 	recorded := l.OptBool(2, false)
 
-	if err := DeleteAccount(l.Context(), n.logger, n.db, n.config, n.leaderboardCache, n.rankCache, n.sessionRegistry, n.sessionCache, n.tracker, userID, recorded); err != nil {
+	if err := DeleteAccount(l.Context(), n.logger, n.db, n.config, n.leaderboardCache, n.rankCache, n.groupIndex, n.sessionRegistry, n.sessionCache, n.tracker, userID, recorded); err != nil {
 		l.RaiseError("error while trying to delete account: %v", err.Error())
 	}
 
 	return 0
 }
 
+// @group accounts
+// @summary Merge a duplicate account into another account. Linked identities, storage objects, wallet balances and ledger, friends, group memberships and leaderboard records move to the target account, and the source account is deleted and recorded.
+// @param sourceUserId(type=string) User ID for the account to merge and delete. Must be valid UUID.
+// @param targetUserId(type=string) User ID for the account to merge into. Must be valid UUID.
+// @param storagePolicy(type=string, optional=true, default="target") How to handle storage objects with the same collection and key in both accounts: "target" keeps the target account's object, "source" keeps the source account's object, and "fail" aborts the merge.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) accountMerge(l *lua.LState) int {
+	sourceID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects source user ID to be a valid identifier")
+		return 0
+	}
+
+	targetID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects target user ID to be a valid identifier")
+		return 0
+	}
+
+	storagePolicy, ok := accountMergeStoragePolicies[l.OptString(3, "target")]
+	if !ok {
+		l.ArgError(3, "expects storage policy to be one of 'target', 'source' or 'fail'")
+		return 0
+	}
+
+	if err := MergeAccounts(l.Context(), n.logger, n.db, n.config, n.leaderboardCache, n.rankCache, n.sessionRegistry, n.sessionCache, n.tracker, n.storageIndex, n.groupIndex, sourceID, targetID, storagePolicy); err != nil {
+		l.RaiseError("error while trying to merge accounts: %v", err.Error())
+	}
+
+	return 0
+}
+
 // @group accounts
 // @summary Export account information for a specified user ID.
 // @param userId(type=string) User ID for the account to be exported. Must be valid UUID.
@@ -10000,12 +10758,134 @@ func (n *RuntimeLuaNakamaModule) friendsOfFriendsList(l *lua.LState) int {
 	return 2
 }
 
+// @group friends
+// @summary List users recommended as friends for a user, ranked by mutual friends, shared groups, recent matches played together and signals set with friend_recommendation_signal_set. Existing friends, pending invites and blocked users are never recommended.
+// @param userId(type=string) The ID of the user to list recommendations for.
+// @param limit(type=number, optional=true, default=10) The number of recommendations to list. Between 1 and 100.
+// @return recommendations(table) The recommended users, best matches first, with the score and signals each recommendation is based on.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) friendRecommendationsList(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(2, 10)
+	if limit < 1 || limit > friendRecommendationMaxCandidates {
+		l.ArgError(2, "expects limit to be 1-100")
+		return 0
+	}
+
+	list, err := FriendRecommendationsList(l.Context(), n.logger, n.db, n.statusRegistry, n.config.GetFriend(), userID, limit)
+	if err != nil {
+		l.RaiseError("error while trying to list friend recommendations: %v", err.Error())
+		return 0
+	}
+
+	recommendations := l.CreateTable(len(list.Recommendations), 0)
+	for i, recommendation := range list.Recommendations {
+		userTable, err := userToLuaTable(l, recommendation.User)
+		if err != nil {
+			l.RaiseError("failed to convert user data to lua table: %s", err.Error())
+			return 0
+		}
+
+		rt := l.CreateTable(0, 6)
+		rt.RawSetString("user", userTable)
+		rt.RawSetString("score", lua.LNumber(recommendation.Score))
+		rt.RawSetString("mutual_friend_count", lua.LNumber(recommendation.MutualFriendCount))
+		rt.RawSetString("shared_group_count", lua.LNumber(recommendation.SharedGroupCount))
+		rt.RawSetString("recent_match_count", lua.LNumber(recommendation.RecentMatchCount))
+		rt.RawSetString("signal_score", lua.LNumber(recommendation.SignalScore))
+		recommendations.RawSetInt(i+1, rt)
+	}
+
+	l.Push(recommendations)
+	return 1
+}
+
+// @group friends
+// @summary Set a signal recommending one user to another as a friend. Signals from different sources are added to the recommendation score, replacing any earlier signal from the same source.
+// @param userId(type=string) The ID of the user the recommendation is for.
+// @param candidateId(type=string) The ID of the user being recommended.
+// @param source(type=string) A name for the source of the signal. The 'match' source is reserved by the server.
+// @param weight(type=number) The score the signal adds to the recommendation.
+// @param expirySec(type=number, optional=true, default=0) Number of seconds until the signal expires. 0 for no expiry.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) friendRecommendationSignalSet(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	candidateID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects candidate ID to be a valid identifier")
+		return 0
+	}
+	if candidateID == userID {
+		l.ArgError(2, "expects candidate ID to be a different user")
+		return 0
+	}
+
+	source := l.CheckString(3)
+	if source == "" || len(source) > 32 {
+		l.ArgError(3, "expects source to be 1-32 characters")
+		return 0
+	}
+	if source == FriendRecommendationSourceMatch {
+		l.ArgError(3, "expects source not to be reserved 'match' source")
+		return 0
+	}
+
+	weight := float64(l.CheckNumber(4))
+
+	expirySec := l.OptInt(5, 0)
+	if expirySec < 0 {
+		l.ArgError(5, "expects expiry seconds to be >= 0")
+		return 0
+	}
+
+	if err := FriendRecommendationSignalSet(l.Context(), n.logger, n.db, userID, candidateID, source, weight, expirySec); err != nil {
+		l.RaiseError("error while trying to set friend recommendation signal: %v", err.Error())
+	}
+	return 0
+}
+
+// @group friends
+// @summary Remove a signal recommending one user to another as a friend.
+// @param userId(type=string) The ID of the user the recommendation is for.
+// @param candidateId(type=string) The ID of the user being recommended.
+// @param source(type=string) The source of the signal to remove.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) friendRecommendationSignalDelete(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	candidateID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects candidate ID to be a valid identifier")
+		return 0
+	}
+
+	if err := FriendRecommendationSignalDelete(l.Context(), n.logger, n.db, userID, candidateID, l.CheckString(3)); err != nil {
+		l.RaiseError("error while trying to delete friend recommendation signal: %v", err.Error())
+	}
+	return 0
+}
+
 // @group friends
 // @summary Add friends to a user.
 // @param userId(type=string) The ID of the user to whom you want to add friends.
 // @param username(type=string) The name of the user to whom you want to add friends.
 // @param ids(type=table) The IDs of the users you want to add as friends.
 // @param usernames(type=table) The usernames of the users you want to add as friends.
+// @param message(type=string, optional=true, default="") A short message included in the notification for any new friend invites.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeLuaNakamaModule) friendsAdd(l *lua.LState) int {
 	userID, err := uuid.FromString(l.CheckString(1))
@@ -10071,6 +10951,8 @@ func (n *RuntimeLuaNakamaModule) friendsAdd(l *lua.LState) int {
 		usernames = usernameStrings
 	}
 
+	message := l.OptString(5, "")
+
 	if len(userIDs) == 0 && len(usernames) == 0 {
 		return 0
 	}
@@ -10091,7 +10973,7 @@ func (n *RuntimeLuaNakamaModule) friendsAdd(l *lua.LState) int {
 	allIDs = append(allIDs, userIDs...)
 	allIDs = append(allIDs, fetchIDs...)
 
-	err = AddFriends(l.Context(), n.logger, n.db, n.tracker, n.router, userID, username, allIDs)
+	err = AddFriends(l.Context(), n.logger, n.db, n.tracker, n.router, n.config.GetFriend(), userID, username, allIDs, message)
 	if err != nil {
 		l.RaiseError("error adding friends: %s", err.Error())
 		return 0
@@ -10380,7 +11262,7 @@ func (n *RuntimeLuaNakamaModule) channelMessageSend(l *lua.LState) int {
 		return 0
 	}
 
-	ack, err := ChannelMessageSend(l.Context(), n.logger, n.db, n.router, channelIdToStreamResult.Stream, channelId, contentStr, senderID, senderUsername, persist)
+	ack, err := ChannelMessageSend(l.Context(), n.logger, n.db, n.router, nil, channelIdToStreamResult.Stream, channelId, contentStr, senderID, senderUsername, persist)
 	if err != nil {
 		l.RaiseError("failed to send channel message: %v", err.Error())
 		return 0
@@ -10455,7 +11337,7 @@ func (n *RuntimeLuaNakamaModule) channelMessageUpdate(l *lua.LState) int {
 		return 0
 	}
 
-	ack, err := ChannelMessageUpdate(l.Context(), n.logger, n.db, n.router, channelIdToStreamResult.Stream, channelId, messageId, contentStr, senderID, senderUsername, persist)
+	ack, err := ChannelMessageUpdate(l.Context(), n.logger, n.db, n.router, nil, channelIdToStreamResult.Stream, channelId, messageId, contentStr, senderID, senderUsername, persist)
 	if err != nil {
 		l.RaiseError("failed to send channel message: %v", err.Error())
 		return 0
@@ -10649,6 +11531,95 @@ func (n *RuntimeLuaNakamaModule) channelIdBuild(l *lua.LState) int {
 	return 1
 }
 
+// @group chat
+// @summary Override the configured message retention policy for a channel. Zero values keep messages indefinitely.
+// @param channelId(type=string) The ID of the channel to set the retention policy for.
+// @param maxAgeSec(type=number) Remove persisted messages older than this many seconds.
+// @param maxMessages(type=number) Keep at most this many of the most recent persisted messages.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) channelRetentionSet(l *lua.LState) int {
+	channelId := l.CheckString(1)
+
+	maxAgeSec := l.CheckInt(2)
+	if maxAgeSec < 0 {
+		l.ArgError(2, "expects max age to be 0 or greater")
+		return 0
+	}
+
+	maxMessages := l.CheckInt(3)
+	if maxMessages < 0 {
+		l.ArgError(3, "expects max messages to be 0 or greater")
+		return 0
+	}
+
+	channelIdToStreamResult, err := ChannelIdToStream(channelId)
+	if err != nil {
+		l.RaiseError("error converting channel identifier to stream: %s", err.Error())
+		return 0
+	}
+
+	if err := ChannelRetentionSet(l.Context(), n.logger, n.db, channelIdToStreamResult.Stream, &ChannelRetentionPolicy{MaxAgeSec: maxAgeSec, MaxMessages: maxMessages}); err != nil {
+		l.RaiseError("failed to set channel retention policy: %v", err.Error())
+		return 0
+	}
+
+	return 0
+}
+
+// @group chat
+// @summary Get the message retention policy override for a channel.
+// @param channelId(type=string) The ID of the channel to get the retention policy for.
+// @return policy(table) The retention policy override, or nil if the channel uses the configured policy.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) channelRetentionGet(l *lua.LState) int {
+	channelId := l.CheckString(1)
+
+	channelIdToStreamResult, err := ChannelIdToStream(channelId)
+	if err != nil {
+		l.RaiseError("error converting channel identifier to stream: %s", err.Error())
+		return 0
+	}
+
+	policy, err := ChannelRetentionGet(l.Context(), n.logger, n.db, channelIdToStreamResult.Stream)
+	if err != nil {
+		l.RaiseError("failed to get channel retention policy: %v", err.Error())
+		return 0
+	}
+	if policy == nil {
+		l.Push(lua.LNil)
+		return 1
+	}
+
+	policyTable := l.CreateTable(0, 3)
+	policyTable.RawSetString("channelId", lua.LString(channelId))
+	policyTable.RawSetString("maxAgeSec", lua.LNumber(policy.MaxAgeSec))
+	policyTable.RawSetString("maxMessages", lua.LNumber(policy.MaxMessages))
+
+	l.Push(policyTable)
+	return 1
+}
+
+// @group chat
+// @summary Remove the message retention policy override for a channel, so the configured policy applies again.
+// @param channelId(type=string) The ID of the channel to remove the retention policy override for.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) channelRetentionDelete(l *lua.LState) int {
+	channelId := l.CheckString(1)
+
+	channelIdToStreamResult, err := ChannelIdToStream(channelId)
+	if err != nil {
+		l.RaiseError("error converting channel identifier to stream: %s", err.Error())
+		return 0
+	}
+
+	if err := ChannelRetentionDelete(l.Context(), n.logger, n.db, channelIdToStreamResult.Stream); err != nil {
+		l.RaiseError("failed to delete channel retention policy: %v", err.Error())
+		return 0
+	}
+
+	return 0
+}
+
 // @group storage
 // @summary List storage index entries
 // @param indexName(type=string) Name of the index to list entries from.
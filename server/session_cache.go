@@ -15,7 +15,10 @@
 package server
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,8 +32,9 @@ type SessionCache interface {
 	IsValidSession(userID uuid.UUID, exp int64, tokenId string) bool
 	// Check if a given user, expiry, and refresh token combination is valid.
 	IsValidRefresh(userID uuid.UUID, exp int64, tokenId string) bool
-	// Add a valid session and/or refresh token for a given user.
-	Add(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string)
+	// Add a valid session and/or refresh token for a given user. Tokens issued with device details are tracked as an
+	// active session, adding a token ID that is already tracked records a refresh of that session.
+	Add(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string, device *SessionDevice)
 	// Remove a session and/or refresh token for a given user.
 	Remove(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string)
 	// Remove all of a user's session and refresh tokens.
@@ -39,12 +43,38 @@ type SessionCache interface {
 	Ban(userIDs []uuid.UUID)
 	// Unban a set of users.
 	Unban(userIDs []uuid.UUID)
+	// List a user's active sessions, most recently issued first.
+	List(userID uuid.UUID) []*ActiveSession
+	// Record a refresh of an active session that issued a new refresh token, identified by newRefreshId, in place of
+	// the presented one. Returns false without changes if the presented refresh token was already rotated.
+	Rotate(userID uuid.UUID, sessionExp int64, tokenId string, refreshExp int64, refreshId, newRefreshId string, device *SessionDevice) bool
+}
+
+// SessionDevice describes the client a session was issued to.
+type SessionDevice struct {
+	AuthMethod string
+	ClientIP   string
+	UserAgent  string
+}
+
+// ActiveSession is a session and refresh token pair issued to a user that has neither expired nor been revoked.
+// Both tokens share the same token ID.
+type ActiveSession struct {
+	SessionDevice
+
+	TokenID       string
+	RefreshID     string
+	IssueTime     int64
+	RefreshTime   int64
+	SessionExpiry int64
+	RefreshExpiry int64
 }
 
 type sessionCacheUser struct {
 	lastInvalidation int64
 	sessionTokens    map[string]int64
 	refreshTokens    map[string]int64
+	activeSessions   map[string]*ActiveSession
 }
 
 type LocalSessionCache struct {
@@ -60,6 +90,10 @@ type LocalSessionCache struct {
 }
 
 func NewLocalSessionCache(tokenExpirySec, refreshTokenExpirySec int64) SessionCache {
+	return newLocalSessionCache(tokenExpirySec, refreshTokenExpirySec)
+}
+
+func newLocalSessionCache(tokenExpirySec, refreshTokenExpirySec int64) *LocalSessionCache {
 	ctx, ctxCancelFn := context.WithCancel(context.Background())
 
 	s := &LocalSessionCache{
@@ -93,7 +127,12 @@ func NewLocalSessionCache(tokenExpirySec, refreshTokenExpirySec int64) SessionCa
 							delete(cache.refreshTokens, token)
 						}
 					}
-					if len(cache.sessionTokens) == 0 && len(cache.refreshTokens) == 0 && (cache.lastInvalidation == 0 || (cache.lastInvalidation < ts-tokenExpirySec && cache.lastInvalidation < ts-refreshTokenExpirySec)) {
+					for tokenID, active := range cache.activeSessions {
+						if max(active.SessionExpiry, active.RefreshExpiry) <= ts {
+							delete(cache.activeSessions, tokenID)
+						}
+					}
+					if len(cache.sessionTokens) == 0 && len(cache.refreshTokens) == 0 && len(cache.activeSessions) == 0 && (cache.lastInvalidation == 0 || (cache.lastInvalidation < ts-tokenExpirySec && cache.lastInvalidation < ts-refreshTokenExpirySec)) {
 						delete(s.cache, userID)
 					}
 				}
@@ -153,26 +192,70 @@ func (s *LocalSessionCache) IsValidRefresh(userID uuid.UUID, exp int64, tokenId
 	return true
 }
 
-func (s *LocalSessionCache) Add(userID uuid.UUID, sessionExp int64, tokenId string, refreshExp int64, refreshTokenId string) {
-	// No-op, blacklist only.
+func (s *LocalSessionCache) Add(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string, device *SessionDevice) {
+	if device == nil || sessionTokenId == "" {
+		// Only sessions issued with device details are tracked, the cache is otherwise a blacklist only.
+		return
+	}
+
+	s.Lock()
+	s.track(userID, sessionExp, sessionTokenId, refreshExp, device)
+	s.Unlock()
 }
 
-func (s *LocalSessionCache) Remove(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string) {
+func (s *LocalSessionCache) Rotate(userID uuid.UUID, sessionExp int64, tokenId string, refreshExp int64, refreshId, newRefreshId string, device *SessionDevice) bool {
 	s.Lock()
-	cache, found := s.cache[userID]
-	if !found {
-		cache = &sessionCacheUser{
-			lastInvalidation: 0,
-			sessionTokens:    make(map[string]int64),
-			refreshTokens:    make(map[string]int64),
+	if cache, found := s.cache[userID]; found {
+		if active, found := cache.activeSessions[tokenId]; found && active.RefreshID != refreshId {
+			s.Unlock()
+			return false
 		}
-		s.cache[userID] = cache
 	}
+	// Sessions not already tracked, for example after a restart with a local cache, start a new chain here.
+	s.track(userID, sessionExp, tokenId, refreshExp, device).RefreshID = newRefreshId
+	s.Unlock()
+	return true
+}
+
+// Create or refresh an active session entry, the caller must hold the write lock.
+func (s *LocalSessionCache) track(userID uuid.UUID, sessionExp int64, tokenId string, refreshExp int64, device *SessionDevice) *ActiveSession {
+	ts := time.Now().UTC().Unix()
+
+	cache := s.cacheUser(userID)
+	active, found := cache.activeSessions[tokenId]
+	if found {
+		// A refresh keeps the original authentication method and issue time.
+		active.ClientIP = device.ClientIP
+		active.UserAgent = device.UserAgent
+		active.RefreshTime = ts
+		active.SessionExpiry = sessionExp
+		active.RefreshExpiry = refreshExp
+	} else {
+		active = &ActiveSession{
+			SessionDevice: *device,
+			TokenID:       tokenId,
+			IssueTime:     ts,
+			SessionExpiry: sessionExp,
+			RefreshExpiry: refreshExp,
+		}
+		cache.activeSessions[tokenId] = active
+	}
+	return active
+}
+
+func (s *LocalSessionCache) Remove(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string) {
+	s.Lock()
+	cache := s.cacheUser(userID)
 	if sessionTokenId != "" {
 		cache.sessionTokens[sessionTokenId] = sessionExp + 1
+		// A session issued without a refresh token ends with its session token.
+		if active, found := cache.activeSessions[sessionTokenId]; found && active.RefreshExpiry == 0 {
+			delete(cache.activeSessions, sessionTokenId)
+		}
 	}
 	if refreshTokenId != "" {
 		cache.refreshTokens[refreshTokenId] = refreshExp + 1
+		delete(cache.activeSessions, refreshTokenId)
 	}
 	s.Unlock()
 }
@@ -181,20 +264,13 @@ func (s *LocalSessionCache) RemoveAll(userID uuid.UUID) {
 	ts := time.Now().UTC().Unix()
 
 	s.Lock()
-	cache, found := s.cache[userID]
-	if !found {
-		cache = &sessionCacheUser{
-			lastInvalidation: 0,
-			sessionTokens:    make(map[string]int64),
-			refreshTokens:    make(map[string]int64),
-		}
-		s.cache[userID] = cache
-	}
+	cache := s.cacheUser(userID)
 	if ts > cache.lastInvalidation {
 		cache.lastInvalidation = ts
 		cache.sessionTokens = make(map[string]int64)
 		cache.refreshTokens = make(map[string]int64)
 	}
+	cache.activeSessions = make(map[string]*ActiveSession)
 	s.Unlock()
 }
 
@@ -203,20 +279,57 @@ func (s *LocalSessionCache) Ban(userIDs []uuid.UUID) {
 
 	s.Lock()
 	for _, userID := range userIDs {
-		cache, found := s.cache[userID]
-		if !found {
-			cache = &sessionCacheUser{
-				lastInvalidation: 0,
-				sessionTokens:    make(map[string]int64),
-				refreshTokens:    make(map[string]int64),
-			}
-			s.cache[userID] = cache
-		}
+		cache := s.cacheUser(userID)
 		if ts > cache.lastInvalidation {
 			cache.lastInvalidation = ts
 		}
+		cache.activeSessions = make(map[string]*ActiveSession)
 	}
 	s.Unlock()
 }
 
 func (s *LocalSessionCache) Unban(userIDs []uuid.UUID) {}
+
+func (s *LocalSessionCache) List(userID uuid.UUID) []*ActiveSession {
+	ts := time.Now().UTC().Unix()
+
+	s.RLock()
+	cache, found := s.cache[userID]
+	if !found {
+		s.RUnlock()
+		return []*ActiveSession{}
+	}
+	sessions := make([]*ActiveSession, 0, len(cache.activeSessions))
+	for _, active := range cache.activeSessions {
+		if max(active.SessionExpiry, active.RefreshExpiry) <= ts {
+			// Expired but not yet pruned.
+			continue
+		}
+		a := *active
+		sessions = append(sessions, &a)
+	}
+	s.RUnlock()
+
+	slices.SortFunc(sessions, func(a, b *ActiveSession) int {
+		if c := cmp.Compare(b.IssueTime, a.IssueTime); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TokenID, b.TokenID)
+	})
+	return sessions
+}
+
+// Get or create the entry for a user, the caller must hold the write lock.
+func (s *LocalSessionCache) cacheUser(userID uuid.UUID) *sessionCacheUser {
+	cache, found := s.cache[userID]
+	if !found {
+		cache = &sessionCacheUser{
+			lastInvalidation: 0,
+			sessionTokens:    make(map[string]int64),
+			refreshTokens:    make(map[string]int64),
+			activeSessions:   make(map[string]*ActiveSession),
+		}
+		s.cache[userID] = cache
+	}
+	return cache
+}
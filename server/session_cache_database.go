@@ -0,0 +1,281 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// Session cache implementations selectable with the session.cache config option.
+const (
+	SessionCacheLocal    = "local"
+	SessionCacheDatabase = "database"
+)
+
+// DatabaseSessionCache keeps the same in-memory view of invalidated tokens and active sessions as LocalSessionCache,
+// and also writes every change to the database. Both are loaded back into memory on startup, so tokens that were
+// revoked before a restart are not accepted again and active sessions remain listed.
+type DatabaseSessionCache struct {
+	*LocalSessionCache
+
+	logger *zap.Logger
+	db     *sql.DB
+}
+
+func NewDatabaseSessionCache(ctx context.Context, logger *zap.Logger, db *sql.DB, tokenExpirySec, refreshTokenExpirySec int64) (SessionCache, error) {
+	s := &DatabaseSessionCache{
+		LocalSessionCache: newLocalSessionCache(tokenExpirySec, refreshTokenExpirySec),
+
+		logger: logger,
+		db:     db,
+	}
+
+	if err := s.load(ctx); err != nil {
+		s.Stop()
+		return nil, err
+	}
+
+	go func() {
+		ticker := time.NewTicker(2 * time.Duration(tokenExpirySec) * time.Second)
+		for {
+			select {
+			case <-s.ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				s.prune()
+			}
+		}
+	}()
+
+	return s, nil
+}
+
+func (s *DatabaseSessionCache) Add(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string, device *SessionDevice) {
+	s.LocalSessionCache.Add(userID, sessionExp, sessionTokenId, refreshExp, refreshTokenId, device)
+	if device == nil || sessionTokenId == "" {
+		return
+	}
+
+	query := `
+INSERT INTO session_active (user_id, token_id, auth_method, client_ip, user_agent, session_expiry_time, refresh_expiry_time, expiry_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (user_id, token_id)
+DO UPDATE SET client_ip = $4, user_agent = $5, refresh_time = now(), session_expiry_time = $6, refresh_expiry_time = $7, expiry_time = $8`
+	params := []any{userID, sessionTokenId, device.AuthMethod, device.ClientIP, device.UserAgent, time.Unix(sessionExp, 0).UTC(), time.Unix(refreshExp, 0).UTC(), time.Unix(max(sessionExp, refreshExp), 0).UTC()}
+	if _, err := s.db.ExecContext(s.ctx, query, params...); err != nil {
+		s.logger.Error("Could not persist active session.", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+}
+
+func (s *DatabaseSessionCache) Rotate(userID uuid.UUID, sessionExp int64, tokenId string, refreshExp int64, refreshId, newRefreshId string, device *SessionDevice) bool {
+	if !s.LocalSessionCache.Rotate(userID, sessionExp, tokenId, refreshExp, refreshId, newRefreshId, device) {
+		return false
+	}
+
+	query := `
+INSERT INTO session_active (user_id, token_id, refresh_id, auth_method, client_ip, user_agent, session_expiry_time, refresh_expiry_time, expiry_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (user_id, token_id)
+DO UPDATE SET refresh_id = $3, client_ip = $5, user_agent = $6, refresh_time = now(), session_expiry_time = $7, refresh_expiry_time = $8, expiry_time = $9`
+	params := []any{userID, tokenId, newRefreshId, device.AuthMethod, device.ClientIP, device.UserAgent, time.Unix(sessionExp, 0).UTC(), time.Unix(refreshExp, 0).UTC(), time.Unix(max(sessionExp, refreshExp), 0).UTC()}
+	if _, err := s.db.ExecContext(s.ctx, query, params...); err != nil {
+		s.logger.Error("Could not persist rotated refresh token.", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+	return true
+}
+
+func (s *DatabaseSessionCache) Remove(userID uuid.UUID, sessionExp int64, sessionTokenId string, refreshExp int64, refreshTokenId string) {
+	s.LocalSessionCache.Remove(userID, sessionExp, sessionTokenId, refreshExp, refreshTokenId)
+
+	activeQuery := `
+DELETE FROM session_active
+WHERE user_id = $1 AND ((token_id = $2 AND refresh_expiry_time = '1970-01-01 00:00:00 UTC') OR token_id = $3)`
+	if _, err := s.db.ExecContext(s.ctx, activeQuery, userID, sessionTokenId, refreshTokenId); err != nil {
+		s.logger.Error("Could not remove active session.", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+
+	query := `
+INSERT INTO session_revoked_token (user_id, token_id, refresh, expiry_time)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, token_id, refresh) DO NOTHING`
+	if sessionTokenId != "" {
+		if _, err := s.db.ExecContext(s.ctx, query, userID, sessionTokenId, false, time.Unix(sessionExp+1, 0).UTC()); err != nil {
+			s.logger.Error("Could not persist revoked session token.", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+	}
+	if refreshTokenId != "" {
+		if _, err := s.db.ExecContext(s.ctx, query, userID, refreshTokenId, true, time.Unix(refreshExp+1, 0).UTC()); err != nil {
+			s.logger.Error("Could not persist revoked refresh token.", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+	}
+}
+
+func (s *DatabaseSessionCache) RemoveAll(userID uuid.UUID) {
+	s.LocalSessionCache.RemoveAll(userID)
+	s.invalidate([]uuid.UUID{userID})
+}
+
+func (s *DatabaseSessionCache) Ban(userIDs []uuid.UUID) {
+	s.LocalSessionCache.Ban(userIDs)
+	s.invalidate(userIDs)
+}
+
+// Record a full invalidation for each user. The record is kept until every token issued before it has expired.
+func (s *DatabaseSessionCache) invalidate(userIDs []uuid.UUID) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	ts := time.Now().UTC()
+	expiry := ts.Add(time.Duration(max(s.tokenExpirySec, s.refreshTokenExpirySec)) * time.Second)
+
+	query := `
+INSERT INTO session_user_invalidation (user_id, invalidation_time, expiry_time)
+SELECT unnest($1::UUID[]), $2, $3
+ON CONFLICT (user_id) DO UPDATE SET invalidation_time = $2, expiry_time = $3`
+	if _, err := s.db.ExecContext(s.ctx, query, userIDs, ts, expiry); err != nil {
+		s.logger.Error("Could not persist session invalidation.", zap.Error(err), zap.Int("count", len(userIDs)))
+		return
+	}
+
+	if _, err := s.db.ExecContext(s.ctx, "DELETE FROM session_active WHERE user_id = ANY($1::UUID[])", userIDs); err != nil {
+		s.logger.Error("Could not remove active sessions.", zap.Error(err), zap.Int("count", len(userIDs)))
+	}
+
+	// Individually revoked tokens issued before the invalidation are now covered by it.
+	if _, err := s.db.ExecContext(s.ctx, "DELETE FROM session_revoked_token WHERE user_id = ANY($1::UUID[])", userIDs); err != nil {
+		s.logger.Warn("Could not remove revoked tokens covered by session invalidation.", zap.Error(err), zap.Int("count", len(userIDs)))
+	}
+}
+
+// Populate the in-memory cache with all invalidations and active sessions that have not yet expired.
+func (s *DatabaseSessionCache) load(ctx context.Context) error {
+	t := time.Now()
+
+	rows, err := s.db.QueryContext(ctx, "SELECT user_id, invalidation_time FROM session_user_invalidation WHERE expiry_time > now()")
+	if err != nil {
+		s.logger.Error("Could not load session invalidations.", zap.Error(err))
+		return err
+	}
+	var invalidationCount int
+	s.Lock()
+	for rows.Next() {
+		var dbUserID uuid.UUID
+		var dbInvalidationTime pgtype.Timestamptz
+		if err := rows.Scan(&dbUserID, &dbInvalidationTime); err != nil {
+			s.Unlock()
+			_ = rows.Close()
+			s.logger.Error("Could not parse session invalidations.", zap.Error(err))
+			return err
+		}
+		s.cacheUser(dbUserID).lastInvalidation = dbInvalidationTime.Time.Unix()
+		invalidationCount++
+	}
+	s.Unlock()
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		s.logger.Error("Could not load session invalidations.", zap.Error(err))
+		return err
+	}
+
+	rows, err = s.db.QueryContext(ctx, "SELECT user_id, token_id, refresh, expiry_time FROM session_revoked_token WHERE expiry_time > now()")
+	if err != nil {
+		s.logger.Error("Could not load revoked tokens.", zap.Error(err))
+		return err
+	}
+	var tokenCount int
+	s.Lock()
+	for rows.Next() {
+		var dbUserID uuid.UUID
+		var dbTokenID string
+		var dbRefresh bool
+		var dbExpiryTime pgtype.Timestamptz
+		if err := rows.Scan(&dbUserID, &dbTokenID, &dbRefresh, &dbExpiryTime); err != nil {
+			s.Unlock()
+			_ = rows.Close()
+			s.logger.Error("Could not parse revoked tokens.", zap.Error(err))
+			return err
+		}
+		cache := s.cacheUser(dbUserID)
+		if dbRefresh {
+			cache.refreshTokens[dbTokenID] = dbExpiryTime.Time.Unix()
+		} else {
+			cache.sessionTokens[dbTokenID] = dbExpiryTime.Time.Unix()
+		}
+		tokenCount++
+	}
+	s.Unlock()
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		s.logger.Error("Could not load revoked tokens.", zap.Error(err))
+		return err
+	}
+
+	rows, err = s.db.QueryContext(ctx, "SELECT user_id, token_id, refresh_id, auth_method, client_ip, user_agent, create_time, refresh_time, session_expiry_time, refresh_expiry_time FROM session_active WHERE expiry_time > now()")
+	if err != nil {
+		s.logger.Error("Could not load active sessions.", zap.Error(err))
+		return err
+	}
+	var activeCount int
+	s.Lock()
+	for rows.Next() {
+		var dbUserID uuid.UUID
+		var dbCreateTime, dbRefreshTime, dbSessionExpiryTime, dbRefreshExpiryTime pgtype.Timestamptz
+		active := &ActiveSession{}
+		if err := rows.Scan(&dbUserID, &active.TokenID, &active.RefreshID, &active.AuthMethod, &active.ClientIP, &active.UserAgent, &dbCreateTime, &dbRefreshTime, &dbSessionExpiryTime, &dbRefreshExpiryTime); err != nil {
+			s.Unlock()
+			_ = rows.Close()
+			s.logger.Error("Could not parse active sessions.", zap.Error(err))
+			return err
+		}
+		active.IssueTime = dbCreateTime.Time.Unix()
+		active.RefreshTime = dbRefreshTime.Time.Unix()
+		active.SessionExpiry = dbSessionExpiryTime.Time.Unix()
+		active.RefreshExpiry = dbRefreshExpiryTime.Time.Unix()
+		s.cacheUser(dbUserID).activeSessions[active.TokenID] = active
+		activeCount++
+	}
+	s.Unlock()
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		s.logger.Error("Could not load active sessions.", zap.Error(err))
+		return err
+	}
+
+	s.logger.Info("Session cache loaded.", zap.Int("invalidations", invalidationCount), zap.Int("revoked_tokens", tokenCount), zap.Int("active_sessions", activeCount), zap.Int64("elapsed_time_ms", time.Since(t).Milliseconds()))
+
+	return nil
+}
+
+// Remove persisted invalidations that no longer affect any token that could still be presented, and sessions whose
+// tokens have all expired.
+func (s *DatabaseSessionCache) prune() {
+	if _, err := s.db.ExecContext(s.ctx, "DELETE FROM session_active WHERE expiry_time <= now()"); err != nil {
+		s.logger.Warn("Could not prune active sessions.", zap.Error(err))
+	}
+	if _, err := s.db.ExecContext(s.ctx, "DELETE FROM session_revoked_token WHERE expiry_time <= now()"); err != nil {
+		s.logger.Warn("Could not prune revoked tokens.", zap.Error(err))
+	}
+	if _, err := s.db.ExecContext(s.ctx, "DELETE FROM session_user_invalidation WHERE expiry_time <= now()"); err != nil {
+		s.logger.Warn("Could not prune session invalidations.", zap.Error(err))
+	}
+}
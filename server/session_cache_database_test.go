@@ -0,0 +1,91 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseSessionCacheReload(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB(t)
+	defer db.Close()
+
+	sc, err := NewDatabaseSessionCache(ctx, logger, db, 3_600, 7_200)
+	require.NoError(t, err)
+
+	revoked, invalidated, pruned := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	InsertUser(t, db, revoked)
+	InsertUser(t, db, invalidated)
+	InsertUser(t, db, pruned)
+	now := time.Now().UTC().Unix()
+	device := &SessionDevice{AuthMethod: "device", ClientIP: "10.0.0.1", UserAgent: "phone"}
+
+	sc.Add(revoked, now+3_600, "revoked", now+7_200, "revoked", device)
+	sc.Add(revoked, now+3_600, "kept", now+7_200, "kept", device)
+	sc.Remove(revoked, now+3_600, "revoked", now+7_200, "revoked")
+
+	// Tokens issued before the invalidation.
+	sc.Add(invalidated, now+3_590, "before", now+7_190, "before", device)
+	sc.RemoveAll(invalidated)
+	sc.Stop()
+
+	// A new cache, as on restart, loads revocations, invalidations and active sessions back.
+	sc, err = NewDatabaseSessionCache(ctx, logger, db, 3_600, 7_200)
+	require.NoError(t, err)
+	defer sc.Stop()
+
+	assert.False(t, sc.IsValidSession(revoked, now+3_600, "revoked"))
+	assert.False(t, sc.IsValidRefresh(revoked, now+7_200, "revoked"))
+	assert.True(t, sc.IsValidSession(revoked, now+3_600, "kept"))
+	assert.True(t, sc.IsValidRefresh(revoked, now+7_200, "kept"))
+	sessions := sc.List(revoked)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "kept", sessions[0].TokenID)
+	assert.Equal(t, "10.0.0.1", sessions[0].ClientIP)
+
+	assert.False(t, sc.IsValidSession(invalidated, now+3_590, "before"))
+	assert.False(t, sc.IsValidRefresh(invalidated, now+7_190, "before"))
+	assert.True(t, sc.IsValidSession(invalidated, now+3_610, "after"), "tokens issued after the invalidation")
+	assert.Empty(t, sc.List(invalidated))
+
+	// Records are pruned once every token they refer to has expired.
+	sc.Add(pruned, now-20, "expired", now-10, "expired", device)
+	sc.Remove(pruned, now-20, "removed", now-10, "removed")
+	_, err = db.ExecContext(ctx, "INSERT INTO session_user_invalidation (user_id, invalidation_time, expiry_time) VALUES ($1, now() - INTERVAL '2 hours', now() - INTERVAL '1 hour')", pruned)
+	require.NoError(t, err)
+	count := func(userID uuid.UUID) int {
+		var count int
+		query := `
+SELECT (SELECT count(*) FROM session_active WHERE user_id = $1) +
+	(SELECT count(*) FROM session_revoked_token WHERE user_id = $1) +
+	(SELECT count(*) FROM session_user_invalidation WHERE user_id = $1)`
+		require.NoError(t, db.QueryRowContext(ctx, query, userID).Scan(&count))
+		return count
+	}
+	assert.Equal(t, 4, count(pruned))
+	assert.Equal(t, 3, count(revoked))
+
+	sc.(*DatabaseSessionCache).prune()
+	assert.Zero(t, count(pruned))
+	assert.Equal(t, 3, count(revoked))
+	assert.Equal(t, 1, count(invalidated))
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionCacheActiveSessions(t *testing.T) {
+	sc := NewLocalSessionCache(3_600, 7_200)
+	defer sc.Stop()
+
+	userID := uuid.Must(uuid.NewV4())
+	now := time.Now().UTC().Unix()
+
+	// Tokens issued without device details are not tracked.
+	sc.Add(userID, now+3_600, "untracked", now+7_200, "untracked", nil)
+	assert.Empty(t, sc.List(userID))
+
+	sc.Add(userID, now+3_600, "phone", now+7_200, "phone", &SessionDevice{AuthMethod: "device", ClientIP: "10.0.0.1", UserAgent: "phone"})
+	sc.Add(userID, now+3_600, "laptop", now+7_200, "laptop", &SessionDevice{AuthMethod: "email", ClientIP: "10.0.0.2", UserAgent: "laptop"})
+	sessions := sc.List(userID)
+	require.Len(t, sessions, 2)
+
+	// A refresh keeps the session's authentication method but records the client it came from.
+	sc.Add(userID, now+4_000, "phone", now+8_000, "phone", &SessionDevice{AuthMethod: "refresh", ClientIP: "10.0.0.3", UserAgent: "phone"})
+	for _, s := range sc.List(userID) {
+		if s.TokenID == "phone" {
+			assert.Equal(t, "device", s.AuthMethod)
+			assert.Equal(t, "10.0.0.3", s.ClientIP)
+			assert.Equal(t, now+8_000, s.RefreshExpiry)
+		}
+	}
+
+	// Revoking one session leaves the other active, and invalidates both of its tokens.
+	require.NoError(t, SessionRevoke(sc, userID, "phone"))
+	sessions = sc.List(userID)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "laptop", sessions[0].TokenID)
+	assert.False(t, sc.IsValidSession(userID, now+4_000, "phone"))
+	assert.False(t, sc.IsValidRefresh(userID, now+8_000, "phone"))
+	assert.True(t, sc.IsValidSession(userID, now+3_600, "laptop"))
+	assert.ErrorIs(t, SessionRevoke(sc, userID, "phone"), ErrActiveSessionNotFound)
+
+	sc.RemoveAll(userID)
+	assert.Empty(t, sc.List(userID))
+}
+
+func TestSessionCacheRotate(t *testing.T) {
+	sc := NewLocalSessionCache(3_600, 7_200)
+	defer sc.Stop()
+
+	userID := uuid.Must(uuid.NewV4())
+	now := time.Now().UTC().Unix()
+	device := &SessionDevice{AuthMethod: "device", ClientIP: "10.0.0.1"}
+	sc.Add(userID, now+3_600, "session", now+7_200, "session", device)
+
+	// The refresh token issued at authentication has no refresh ID, each rotation replaces the current one.
+	assert.True(t, sc.Rotate(userID, now+3_700, "session", now+7_300, "", "first", device))
+	assert.True(t, sc.Rotate(userID, now+3_800, "session", now+7_400, "first", "second", device))
+
+	// Presenting an earlier refresh token again is rejected.
+	assert.False(t, sc.Rotate(userID, now+3_900, "session", now+7_500, "first", "third", device))
+	assert.False(t, sc.Rotate(userID, now+3_900, "session", now+7_500, "", "third", device))
+
+	sessions := sc.List(userID)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "second", sessions[0].RefreshID)
+	assert.Equal(t, "device", sessions[0].AuthMethod)
+	assert.Equal(t, now+7_400, sessions[0].RefreshExpiry)
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// Algorithms session tokens can be signed with.
+const (
+	SessionSigningMethodHS256 = "HS256"
+	SessionSigningMethodRS256 = "RS256"
+	SessionSigningMethodES256 = "ES256"
+	SessionSigningMethodEdDSA = "EdDSA"
+)
+
+// SessionSigningKeys holds the private key session tokens are signed with when an asymmetric signing method is
+// configured, and the public keys of every key tokens are still accepted from, indexed by key ID. Key IDs are the
+// RFC 7638 thumbprints of the public keys, so all nodes derive the same IDs from the same key files.
+type SessionSigningKeys struct {
+	method     jwt.SigningMethod
+	kid        string
+	privateKey crypto.PrivateKey
+	publicKeys map[string]crypto.PublicKey
+	jwks       []byte
+}
+
+type sessionJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// LoadSessionSigningKeys reads the PEM encoded private key used to sign new session tokens, and any keys previously
+// used to sign tokens that should still be accepted. Verification key files may hold either public or private keys.
+func LoadSessionSigningKeys(method, signingKeyFile string, verificationKeyFiles []string) (*SessionSigningKeys, error) {
+	k := &SessionSigningKeys{
+		publicKeys: make(map[string]crypto.PublicKey, len(verificationKeyFiles)+1),
+	}
+	switch method {
+	case SessionSigningMethodRS256:
+		k.method = jwt.SigningMethodRS256
+	case SessionSigningMethodES256:
+		k.method = jwt.SigningMethodES256
+	case SessionSigningMethodEdDSA:
+		k.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported session token signing method: %q", method)
+	}
+
+	if signingKeyFile == "" {
+		return nil, errors.New("a signing key file is required")
+	}
+	b, err := os.ReadFile(signingKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key file: %w", err)
+	}
+	privateKey, publicKey, err := sessionParsePrivateKey(method, b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key file %q: %w", signingKeyFile, err)
+	}
+	k.privateKey = privateKey
+
+	jwks := make([]*sessionJWK, 0, len(verificationKeyFiles)+1)
+	jwk, err := sessionPublicJWK(method, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	k.kid = jwk.Kid
+	k.publicKeys[jwk.Kid] = publicKey
+	jwks = append(jwks, jwk)
+
+	for _, file := range verificationKeyFiles {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read verification key file: %w", err)
+		}
+		publicKey, err := sessionParsePublicKey(method, b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse verification key file %q: %w", file, err)
+		}
+		jwk, err := sessionPublicJWK(method, publicKey)
+		if err != nil {
+			return nil, err
+		}
+		if _, found := k.publicKeys[jwk.Kid]; found {
+			// Same key listed more than once.
+			continue
+		}
+		k.publicKeys[jwk.Kid] = publicKey
+		jwks = append(jwks, jwk)
+	}
+
+	if k.jwks, err = json.Marshal(map[string]any{"keys": jwks}); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+func (k *SessionSigningKeys) sign(claims jwt.Claims) string {
+	token := jwt.NewWithClaims(k.method, claims)
+	token.Header["kid"] = k.kid
+	signedToken, _ := token.SignedString(k.privateKey)
+	return signedToken
+}
+
+func (k *SessionSigningKeys) keyFunc(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() != k.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	kid, _ := token.Header["kid"].(string)
+	publicKey, found := k.publicKeys[kid]
+	if !found {
+		return nil, fmt.Errorf("unknown signing key: %v", token.Header["kid"])
+	}
+	return publicKey, nil
+}
+
+// Sign a session token with the configured signing method.
+func sessionTokenSign(cfg *SessionConfig, claims jwt.Claims) string {
+	if cfg.SigningKeys != nil {
+		return cfg.SigningKeys.sign(claims)
+	}
+	signedToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(cfg.EncryptionKey))
+	return signedToken
+}
+
+// Resolve the key to verify a session token with for the configured signing method.
+func sessionTokenKeyFunc(cfg *SessionConfig) jwt.Keyfunc {
+	if cfg.SigningKeys != nil {
+		return cfg.SigningKeys.keyFunc
+	}
+	return hmacKeyFunc([]byte(cfg.EncryptionKey))
+}
+
+func hmacKeyFunc(hmacSecretByte []byte) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		if s, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.Hash != crypto.SHA256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return hmacSecretByte, nil
+	}
+}
+
+// NewSessionJWKSHandler serves the public keys session tokens can be verified with as a JSON Web Key Set. The set
+// is empty when session tokens are signed with a shared secret.
+func NewSessionJWKSHandler(config Config) http.HandlerFunc {
+	jwks := []byte(`{"keys":[]}`)
+	if keys := config.GetSession().SigningKeys; keys != nil {
+		jwks = keys.jwks
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(jwks)
+	}
+}
+
+func sessionParsePrivateKey(method string, b []byte) (crypto.PrivateKey, crypto.PublicKey, error) {
+	switch method {
+	case SessionSigningMethodRS256:
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		return key, &key.PublicKey, nil
+	case SessionSigningMethodES256:
+		key, err := jwt.ParseECPrivateKeyFromPEM(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		if key.Curve != elliptic.P256() {
+			return nil, nil, errors.New("ES256 requires a P-256 key")
+		}
+		return key, &key.PublicKey, nil
+	case SessionSigningMethodEdDSA:
+		key, err := jwt.ParseEdPrivateKeyFromPEM(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		return key, key.(ed25519.PrivateKey).Public(), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported session token signing method: %q", method)
+	}
+}
+
+func sessionParsePublicKey(method string, b []byte) (crypto.PublicKey, error) {
+	var publicKey crypto.PublicKey
+	var err error
+	switch method {
+	case SessionSigningMethodRS256:
+		publicKey, err = jwt.ParseRSAPublicKeyFromPEM(b)
+	case SessionSigningMethodES256:
+		var key *ecdsa.PublicKey
+		if key, err = jwt.ParseECPublicKeyFromPEM(b); err == nil && key.Curve != elliptic.P256() {
+			return nil, errors.New("ES256 requires a P-256 key")
+		}
+		publicKey = key
+	case SessionSigningMethodEdDSA:
+		publicKey, err = jwt.ParseEdPublicKeyFromPEM(b)
+	default:
+		return nil, fmt.Errorf("unsupported session token signing method: %q", method)
+	}
+	if err != nil {
+		// Previous signing keys may be given as the private key files themselves.
+		if _, publicKey, err := sessionParsePrivateKey(method, b); err == nil {
+			return publicKey, nil
+		}
+		return nil, err
+	}
+	return publicKey, nil
+}
+
+func sessionPublicJWK(method string, publicKey crypto.PublicKey) (*sessionJWK, error) {
+	var jwk *sessionJWK
+	var thumbprint []byte
+	var err error
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		jwk = &sessionJWK{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}
+		thumbprint, err = json.Marshal(map[string]string{"e": jwk.E, "kty": jwk.Kty, "n": jwk.N})
+	case *ecdsa.PublicKey:
+		x, y := make([]byte, 32), make([]byte, 32)
+		jwk = &sessionJWK{
+			Kty: "EC",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(x)),
+			Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(y)),
+		}
+		thumbprint, err = json.Marshal(map[string]string{"crv": jwk.Crv, "kty": jwk.Kty, "x": jwk.X, "y": jwk.Y})
+	case ed25519.PublicKey:
+		jwk = &sessionJWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+		}
+		thumbprint, err = json.Marshal(map[string]string{"crv": jwk.Crv, "kty": jwk.Kty, "x": jwk.X})
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Map keys are marshalled in sorted order, as the thumbprint requires.
+	sum := sha256.Sum256(thumbprint)
+	jwk.Kid = base64.RawURLEncoding.EncodeToString(sum[:])
+	jwk.Use = "sig"
+	jwk.Alg = method
+	return jwk, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionSigningKeys(t *testing.T) {
+	newKey := func(method string) crypto.Signer {
+		var key crypto.Signer
+		var err error
+		switch method {
+		case SessionSigningMethodRS256:
+			key, err = rsa.GenerateKey(rand.Reader, 2048)
+		case SessionSigningMethodES256:
+			key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		case SessionSigningMethodEdDSA:
+			_, key, err = ed25519.GenerateKey(rand.Reader)
+		}
+		require.NoError(t, err)
+		return key
+	}
+	writeKey := func(name string, key any) string {
+		block := &pem.Block{Type: "PUBLIC KEY"}
+		var err error
+		if signer, isPrivate := key.(crypto.Signer); isPrivate {
+			block.Type = "PRIVATE KEY"
+			block.Bytes, err = x509.MarshalPKCS8PrivateKey(signer)
+		} else {
+			block.Bytes, err = x509.MarshalPKIXPublicKey(key)
+		}
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0600))
+		return path
+	}
+
+	for _, method := range []string{SessionSigningMethodRS256, SessionSigningMethodES256, SessionSigningMethodEdDSA} {
+		t.Run(method, func(t *testing.T) {
+			oldKey := newKey(method)
+			newKey := newKey(method)
+
+			oldKeys, err := LoadSessionSigningKeys(method, writeKey("old.pem", oldKey), nil)
+			require.NoError(t, err)
+			// After rotation the old key is only used to verify tokens, given here by its public key.
+			keys, err := LoadSessionSigningKeys(method, writeKey("new.pem", newKey), []string{writeKey("old.pub.pem", oldKey.Public())})
+			require.NoError(t, err)
+			assert.NotEqual(t, oldKeys.kid, keys.kid)
+
+			userID := uuid.Must(uuid.NewV4())
+			exp := time.Now().UTC().Add(time.Minute).Unix()
+			oldToken, _ := generateTokenWithExpiry(&SessionConfig{SigningKeys: oldKeys}, "old", 0, userID.String(), "user", nil, exp)
+			newToken, _ := generateTokenWithExpiry(&SessionConfig{SigningKeys: keys}, "new", 0, userID.String(), "user", nil, exp)
+
+			for _, token := range []string{oldToken, newToken} {
+				parsedUserID, _, _, _, _, _, ok := parseToken(sessionTokenKeyFunc(&SessionConfig{SigningKeys: keys}), token)
+				require.True(t, ok)
+				assert.Equal(t, userID, parsedUserID)
+			}
+
+			// Tokens signed with the shared secret are no longer accepted.
+			hmacToken, _ := generateTokenWithExpiry(&SessionConfig{EncryptionKey: "secret"}, "hmac", 0, userID.String(), "user", nil, exp)
+			_, _, _, _, _, _, ok := parseToken(sessionTokenKeyFunc(&SessionConfig{SigningKeys: keys}), hmacToken)
+			assert.False(t, ok)
+			// Tokens signed by the new key are not accepted by nodes that only know the old key.
+			_, _, _, _, _, _, ok = parseToken(sessionTokenKeyFunc(&SessionConfig{SigningKeys: oldKeys}), newToken)
+			assert.False(t, ok)
+
+			config := NewConfig(logger)
+			config.GetSession().SigningKeys = keys
+			w := httptest.NewRecorder()
+			NewSessionJWKSHandler(config)(w, httptest.NewRequest("GET", "/.well-known/jwks.json", nil))
+			var jwks struct {
+				Keys []sessionJWK `json:"keys"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &jwks))
+			require.Len(t, jwks.Keys, 2)
+			assert.Equal(t, keys.kid, jwks.Keys[0].Kid)
+			assert.Equal(t, oldKeys.kid, jwks.Keys[1].Kid)
+			assert.Equal(t, method, jwks.Keys[0].Alg)
+		})
+	}
+}
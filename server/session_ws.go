@@ -65,6 +65,7 @@ type sessionWS struct {
 	metrics         Metrics
 	pipeline        *Pipeline
 	runtime         *Runtime
+	rateLimiter     *sessionRateLimiter
 
 	stopped                bool
 	conn                   *websocket.Conn
@@ -87,6 +88,11 @@ func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, sessi
 		wsMessageType = websocket.BinaryMessage
 	}
 
+	var channelRateLimiter *keyedRateLimiter
+	if pipeline != nil {
+		channelRateLimiter = pipeline.channelRateLimiter
+	}
+
 	return &sessionWS{
 		logger:     sessionLogger,
 		config:     config,
@@ -117,6 +123,7 @@ func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, sessi
 		metrics:         metrics,
 		pipeline:        pipeline,
 		runtime:         runtime,
+		rateLimiter:     newSessionRateLimiter(config.GetRateLimit().Realtime, channelRateLimiter),
 
 		stopped:                false,
 		conn:                   conn,
@@ -243,6 +250,22 @@ IncomingLoop:
 			break
 		}
 
+		if s.rateLimiter != nil {
+			if category, allowed := s.rateLimiter.allow(request, time.Now()); !allowed {
+				s.metrics.CountRealtimeRateLimited(category, 1)
+				if s.rateLimiter.exceeded() {
+					s.logger.Info("Closing session after repeatedly exceeding rate limit", zap.String("category", category))
+					reason = "rate limit exceeded"
+					break
+				}
+				_ = s.Send(&rtapi.Envelope{Cid: request.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+					Code:    RealtimeErrorRateLimited,
+					Message: "Rate limit exceeded.",
+				}}}, true)
+				continue
+			}
+		}
+
 		switch request.Cid {
 		case "":
 			if !s.pipeline.ProcessRequest(s.logger, s, request) {
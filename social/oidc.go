@@ -0,0 +1,296 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+// OIDCProvider describes a generic OpenID Connect identity provider whose ID tokens can be verified. Claim names
+// default to the standard OpenID Connect claims when empty.
+type OIDCProvider struct {
+	Issuer           string
+	ClientIDs        []string
+	JWKSURL          string
+	SubjectClaim     string
+	EmailClaim       string
+	DisplayNameClaim string
+	AvatarURLClaim   string
+}
+
+// OIDCProfile is an abbreviated version of a user authenticated through an OpenID Connect provider. The email is only
+// set if the provider reports it as verified.
+type OIDCProfile struct {
+	Subject     string
+	Email       string
+	DisplayName string
+	AvatarURL   string
+}
+
+type oidcKeySet struct {
+	keys      map[string]crypto.PublicKey
+	fetchAt   int64
+	refreshAt int64
+}
+
+type oidcJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// CheckOIDCToken verifies an ID token issued by the given OpenID Connect provider and extracts the user's profile.
+func (c *Client) CheckOIDCToken(ctx context.Context, provider *OIDCProvider, idToken string) (*OIDCProfile, error) {
+	c.logger.Debug("Checking OpenID Connect ID token", zap.String("issuer", provider.Issuer), zap.String("idToken", idToken))
+
+	keys, err := c.getOIDCKeys(ctx, provider.JWKSURL, false)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &jwt.Parser{UseJSONNumber: true}
+	token, err := parser.Parse(idToken, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, found := keys[kid]
+		if !found {
+			// The provider may have rotated its keys since they were last fetched.
+			if keys, err = c.getOIDCKeys(ctx, provider.JWKSURL, true); err != nil {
+				return nil, err
+			}
+			if key, found = keys[kid]; !found {
+				return nil, fmt.Errorf("invalid kid claim: %v", token.Header["kid"])
+			}
+		}
+
+		// Check the token signing algorithm matches the type of key.
+		switch key.(type) {
+		case *rsa.PublicKey:
+			switch token.Method.(type) {
+			case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+				return key, nil
+			}
+		case *ecdsa.PublicKey:
+			if _, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+				return key, nil
+			}
+		case ed25519.PublicKey:
+			if _, ok := token.Method.(*jwt.SigningMethodEd25519); ok {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid alg: %v", token.Header["alg"])
+	})
+
+	// Check if verification attempt has failed.
+	if err != nil {
+		return nil, fmt.Errorf("oidc id token invalid: %s", err.Error())
+	} else if token == nil {
+		return nil, errors.New("oidc id token invalid")
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	if !claims.VerifyIssuer(provider.Issuer, true) {
+		return nil, fmt.Errorf("unexpected issuer: %v", claims["iss"])
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("oidc id token exp field missing or expired")
+	}
+	var audienceFound bool
+	for _, clientID := range provider.ClientIDs {
+		if claims.VerifyAudience(clientID, true) {
+			audienceFound = true
+			break
+		}
+	}
+	if !audienceFound {
+		return nil, fmt.Errorf("unexpected audience: %v", claims["aud"])
+	}
+
+	// Extract the claims we need now that we know the token is valid.
+	profile := &OIDCProfile{}
+	subjectClaim := oidcClaimName(provider.SubjectClaim, "sub")
+	if profile.Subject, err = oidcStringClaim(claims, subjectClaim); err != nil {
+		return nil, err
+	} else if profile.Subject == "" {
+		return nil, fmt.Errorf("oidc id token %v field missing", subjectClaim)
+	}
+	if profile.DisplayName, err = oidcStringClaim(claims, oidcClaimName(provider.DisplayNameClaim, "name")); err != nil {
+		return nil, err
+	}
+	if profile.AvatarURL, err = oidcStringClaim(claims, oidcClaimName(provider.AvatarURLClaim, "picture")); err != nil {
+		return nil, err
+	}
+	email, err := oidcStringClaim(claims, oidcClaimName(provider.EmailClaim, "email"))
+	if err != nil {
+		return nil, err
+	}
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		if v {
+			profile.Email = email
+		}
+	case string:
+		if verified, _ := strconv.ParseBool(v); verified {
+			profile.Email = email
+		}
+	}
+
+	return profile, nil
+}
+
+// Get the keys in a provider's JSON Web Key Set, indexed by key ID. Key sets are cached for an hour, and a refresh
+// may be forced at most once a minute when a token is signed by a key that is not in the cached set.
+func (c *Client) getOIDCKeys(ctx context.Context, jwksURL string, force bool) (map[string]crypto.PublicKey, error) {
+	c.oidcMutex.Lock()
+	defer c.oidcMutex.Unlock()
+
+	now := time.Now().UTC().Unix()
+	keySet, found := c.oidcKeys[jwksURL]
+	if found && keySet.refreshAt >= now && (!force || keySet.fetchAt+60 > now) {
+		return keySet.keys, nil
+	}
+
+	var jwks struct {
+		Keys []*oidcJWK `json:"keys"`
+	}
+	if err := c.request(ctx, "oidc jwks", jwksURL, nil, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			// Encryption key, skip it.
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Invalid or unsupported key, skip it.
+			c.logger.Debug("Skipping OpenID Connect JWK", zap.String("url", jwksURL), zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("error finding valid oidc jwk")
+	}
+
+	if c.oidcKeys == nil {
+		c.oidcKeys = make(map[string]*oidcKeySet)
+	}
+	c.oidcKeys[jwksURL] = &oidcKeySet{
+		keys:      keys,
+		fetchAt:   now,
+		refreshAt: now + int64((60 * time.Minute).Seconds()),
+	}
+	return keys, nil
+}
+
+func (k *oidcJWK) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid rsa key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %v", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("invalid ec key")
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve: %v", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %v", k.Kty)
+	}
+}
+
+func oidcClaimName(name, defaultName string) string {
+	if name == "" {
+		return defaultName
+	}
+	return name
+}
+
+// Read a claim that should hold a string. Numeric claims are accepted, as some providers use numeric user IDs.
+func oidcStringClaim(claims jwt.MapClaims, name string) (string, error) {
+	switch v := claims[name].(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("oidc id token %v field invalid", name)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCheckOIDCToken(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// The EC key is only published after the first fetch, as if the provider rotated its keys.
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys := []map[string]string{{
+			"kty": "RSA",
+			"kid": "rsa",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+		}}
+		if fetches.Add(1) > 1 {
+			keys = append(keys, map[string]string{
+				"kty": "EC",
+				"kid": "ec",
+				"crv": "P-256",
+				"x":   base64.RawURLEncoding.EncodeToString(ecKey.X.FillBytes(make([]byte, 32))),
+				"y":   base64.RawURLEncoding.EncodeToString(ecKey.Y.FillBytes(make([]byte, 32))),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	defer server.Close()
+
+	client := NewClient(zap.NewNop(), 5*time.Second, nil)
+	provider := &OIDCProvider{
+		Issuer:       "https://id.example.com",
+		ClientIDs:    []string{"web", "mobile"},
+		JWKSURL:      server.URL,
+		SubjectClaim: "uid",
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+	claims := func(overrides jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss":            "https://id.example.com",
+			"aud":            []string{"other", "mobile"},
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"uid":            12345,
+			"email":          "player@example.com",
+			"email_verified": true,
+			"name":           "Player One",
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+	ctx := context.Background()
+
+	profile, err := client.CheckOIDCToken(ctx, provider, sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, &OIDCProfile{Subject: "12345", Email: "player@example.com", DisplayName: "Player One"}, profile)
+
+	profile, err = client.CheckOIDCToken(ctx, provider, sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(jwt.MapClaims{"email_verified": false})))
+	require.NoError(t, err)
+	assert.Empty(t, profile.Email)
+
+	// A token signed by a key the server has not seen yet forces the key set to be fetched again, at most once a minute.
+	_, err = client.CheckOIDCToken(ctx, provider, sign(jwt.SigningMethodES256, "ec", ecKey, claims(nil)))
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, fetches.Load())
+	client.oidcKeys[server.URL].fetchAt -= 60
+	profile, err = client.CheckOIDCToken(ctx, provider, sign(jwt.SigningMethodES256, "ec", ecKey, claims(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, "12345", profile.Subject)
+	assert.EqualValues(t, 2, fetches.Load())
+
+	for name, token := range map[string]string{
+		"issuer":       sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(jwt.MapClaims{"iss": "https://evil.example.com"})),
+		"audience":     sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(jwt.MapClaims{"aud": "other"})),
+		"expired":      sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()})),
+		"subject":      sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(jwt.MapClaims{"uid": nil})),
+		"key mismatch": sign(jwt.SigningMethodES256, "rsa", ecKey, claims(nil)),
+		"hmac":         sign(jwt.SigningMethodHS256, "rsa", []byte("secret"), claims(nil)),
+	} {
+		_, err := client.CheckOIDCToken(ctx, provider, token)
+		assert.Error(t, err, name)
+	}
+}
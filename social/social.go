@@ -58,6 +58,9 @@ type Client struct {
 	appleCerts          map[string]*JwksCert
 	appleCertsRefreshAt int64
 
+	oidcMutex sync.Mutex
+	oidcKeys  map[string]*oidcKeySet
+
 	config *oauth2.Config
 }
 